@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/fake"
+	"github.com/projectbeskar/virtrigaud/internal/version"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+	"github.com/projectbeskar/virtrigaud/sdk/provider/middleware"
+	"github.com/projectbeskar/virtrigaud/sdk/provider/server"
+)
+
+func main() {
+	// Handle --version flag before any other flag parsing
+	if len(os.Args) > 1 && os.Args[1] == "--version" {
+		fmt.Printf("virtrigaud-provider-fake %s\n", version.String())
+		os.Exit(0)
+	}
+
+	var latency time.Duration
+	var failures string
+	flag.DurationVar(&latency, "latency", getEnvDuration("FAKE_LATENCY", 0), "Delay added to every RPC")
+	flag.StringVar(&failures, "failures", os.Getenv("FAKE_FAILURES"),
+		"Comma-separated injected failures as RPC=code[:count], e.g. Create=transient:2,Delete=not-found (RPC * matches all)")
+	flag.Parse()
+
+	// Create logger
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: getLogLevel(),
+	}))
+
+	provider := fake.NewProvider(fake.WithLatency(latency))
+	if err := injectFailures(provider, failures); err != nil {
+		logger.Error("Invalid --failures", "error", err)
+		os.Exit(1)
+	}
+
+	// Create server configuration
+	config := server.DefaultConfig()
+	config.Logger = logger
+	config.Middleware = &middleware.Config{
+		Logging: &middleware.LoggingConfig{
+			Enabled: true,
+			Logger:  logger,
+		},
+		Recovery: &middleware.RecoveryConfig{
+			Enabled: true,
+			Logger:  logger,
+		},
+	}
+
+	// Create server
+	srv, err := server.New(config)
+	if err != nil {
+		logger.Error("Failed to create server", "error", err)
+		os.Exit(1)
+	}
+	srv.RegisterProvider(provider)
+
+	// Start server
+	logger.Info("Starting fake provider server", "version", version.String(), "port", config.Port,
+		"latency", latency.String(), "failures", failures)
+	if err := srv.Serve(context.Background()); err != nil {
+		logger.Error("Server failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// injectFailures parses RPC=code[:count] entries; code is an ErrorCode name without
+// its prefix (not-found, already-exists, quota-exceeded, invalid-spec, transient, ...)
+func injectFailures(provider *fake.Provider, spec string) error {
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		rpc, rest, ok := strings.Cut(entry, "=")
+		if !ok || rpc == "" {
+			return fmt.Errorf("entry %q is not RPC=code[:count]", entry)
+		}
+		name, countText, _ := strings.Cut(rest, ":")
+		code, ok := providerv1.ErrorCode_value["ERROR_CODE_"+strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+		if !ok {
+			return fmt.Errorf("entry %q: unknown error code %q", entry, name)
+		}
+		count := 0
+		if countText != "" {
+			n, err := strconv.Atoi(countText)
+			if err != nil || n < 1 {
+				return fmt.Errorf("entry %q: count must be a positive integer", entry)
+			}
+			count = n
+		}
+		provider.InjectFailure(rpc, fake.Error(providerv1.ErrorCode(code), "injected %s failure", name), count)
+	}
+	return nil
+}
+
+// getLogLevel returns the log level from environment variable.
+func getLogLevel() slog.Level {
+	switch os.Getenv("LOG_LEVEL") {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// getEnvDuration reads a duration from the environment, falling back to def
+func getEnvDuration(name string, def time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(name)); err == nil {
+		return value
+	}
+	return def
+}
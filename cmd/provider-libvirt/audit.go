@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/projectbeskar/virtrigaud/internal/obs/logging"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// callerMetadataKey is the request metadata a client without a client certificate can
+// name itself in. It is recorded as claimed and is not verified.
+const callerMetadataKey = "x-virtrigaud-caller"
+
+// Sources of the caller identity recorded in an audit entry
+const (
+	callerSourceMTLS      = "mtls"
+	callerSourceMetadata  = "metadata"
+	callerSourceAnonymous = "anonymous"
+)
+
+// auditedMethods lists the RPCs that change VM or host state; read-only RPCs are not audited
+var auditedMethods = map[string]bool{
+	"Create":               true,
+	"EnsureVM":             true,
+	"Delete":               true,
+	"Power":                true,
+	"Reconfigure":          true,
+	"HardwareUpgrade":      true,
+	"SnapshotCreate":       true,
+	"SnapshotDelete":       true,
+	"SnapshotRevert":       true,
+	"ConsolidateSnapshots": true,
+	"OpenConsole":          true,
+	"Clone":                true,
+	"ImagePrepare":         true,
+	"ExportDisk":           true,
+	"ImportDisk":           true,
+	"Migrate":              true,
+	"AdoptVM":              true,
+	"RebootVM":             true,
+	"ExecInGuest":          true,
+	"RenameVM":             true,
+	"InsertMedia":          true,
+	"EjectMedia":           true,
+	"SuspendVM":            true,
+	"ResumeVM":             true,
+	"SaveVM":               true,
+	"DiscardManagedSave":   true,
+	"CollectGarbage":       true,
+	"SetGuestHostname":     true,
+}
+
+// openAuditLog returns the writer audit entries go to: the file at path, opened for
+// appending, or stdout when no path is set. The returned function closes the file.
+func openAuditLog(path string) (io.Writer, func(), error) {
+	if path == "" {
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+// auditInterceptor writes a JSON audit entry for every state-changing unary RPC once it
+// has completed. Dry-run creates and garbage collections change nothing and are skipped.
+func auditInterceptor(w io.Writer) grpc.UnaryServerInterceptor {
+	audit := slog.New(slog.NewJSONHandler(w, nil))
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		action := path.Base(info.FullMethod)
+		if !auditedMethods[action] {
+			return handler(ctx, req)
+		}
+		if create, ok := req.(*providerv1.CreateRequest); ok && create.GetDryRun() {
+			return handler(ctx, req)
+		}
+		if gc, ok := req.(*providerv1.CollectGarbageRequest); ok && gc.GetDryRun() {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		writeAuditEntry(ctx, audit, action, auditVMName(req), start, err)
+		return resp, err
+	}
+}
+
+// auditStreamInterceptor writes a JSON audit entry for every state-changing streaming RPC
+// once the stream has ended. The VM is taken from the first message the client sent.
+func auditStreamInterceptor(w io.Writer) grpc.StreamServerInterceptor {
+	audit := slog.New(slog.NewJSONHandler(w, nil))
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		action := path.Base(info.FullMethod)
+		if !auditedMethods[action] {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		stream := &auditedStream{ServerStream: ss}
+		err := handler(srv, stream)
+		writeAuditEntry(ss.Context(), audit, action, stream.vm, start, err)
+		return err
+	}
+}
+
+// auditedStream records the VM named by the first message received on a stream
+type auditedStream struct {
+	grpc.ServerStream
+	received bool
+	vm       string
+}
+
+// RecvMsg receives a message, remembering the VM of the first one
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		s.vm = auditVMName(m)
+	}
+	return err
+}
+
+// writeAuditEntry writes the audit entry of a completed RPC
+func writeAuditEntry(ctx context.Context, audit *slog.Logger, action, vm string, start time.Time, err error) {
+	caller, source := auditCaller(ctx)
+	attrs := []slog.Attr{
+		slog.String("request_id", logging.CorrelationID(ctx)),
+		slog.String("action", action),
+		slog.String("vm", vm),
+		slog.String("caller", caller),
+		slog.String("caller_source", source),
+		slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+	if err != nil {
+		attrs = append(attrs,
+			slog.String("outcome", "failure"),
+			slog.String("code", status.Code(err).String()),
+			slog.String("error", err.Error()),
+		)
+	} else {
+		attrs = append(attrs, slog.String("outcome", "success"))
+	}
+	audit.LogAttrs(ctx, slog.LevelInfo, "audit", attrs...)
+}
+
+// auditCaller identifies the client of an RPC: the common name of its verified client
+// certificate, else the caller it claims in the request metadata
+func auditCaller(ctx context.Context) (string, string) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if chains := info.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+				return chains[0][0].Subject.CommonName, callerSourceMTLS
+			}
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(callerMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0], callerSourceMetadata
+		}
+	}
+	return "", callerSourceAnonymous
+}
+
+// auditVMName returns the VM a request acts on, or the name of the VM or volume it creates
+func auditVMName(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetId() string }:
+		return r.GetId()
+	case interface{ GetVmId() string }:
+		return r.GetVmId()
+	case interface{ GetSourceVmId() string }:
+		return r.GetSourceVmId()
+	case interface{ GetName() string }:
+		return r.GetName()
+	case interface{ GetTargetName() string }:
+		return r.GetTargetName()
+	case interface {
+		GetOpen() *providerv1.ConsoleOpen
+	}:
+		return r.GetOpen().GetVmId()
+	case interface {
+		GetSpec() *providerv1.CreateRequest
+	}:
+		return r.GetSpec().GetName()
+	}
+	return ""
+}
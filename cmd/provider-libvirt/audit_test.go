@@ -0,0 +1,108 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// readOnlyMethods are the RPCs that change neither VM nor host state
+var readOnlyMethods = map[string]bool{
+	"Validate":         true,
+	"Describe":         true,
+	"TaskStatus":       true,
+	"ListSnapshots":    true,
+	"GetCapabilities":  true,
+	"GetDiskInfo":      true,
+	"ListVMs":          true,
+	"GetCapacity":      true,
+	"GetVMStats":       true,
+	"ExportSpec":       true,
+	"GetConsoleLog":    true,
+	"ValidateSpec":     true,
+	"WatchEvents":      true,
+	"WatchOperation":   true,
+	"GetGuestHostname": true,
+	"GetRawXML":        true,
+}
+
+func TestEveryMutatingRPCIsAudited(t *testing.T) {
+	var methods []string
+	for _, method := range providerv1.Provider_ServiceDesc.Methods {
+		methods = append(methods, method.MethodName)
+	}
+	for _, stream := range providerv1.Provider_ServiceDesc.Streams {
+		methods = append(methods, stream.StreamName)
+	}
+
+	for _, method := range methods {
+		assert.True(t, auditedMethods[method] != readOnlyMethods[method],
+			"%s must be listed in exactly one of auditedMethods and readOnlyMethods", method)
+	}
+}
+
+// recvStream is a server stream delivering a single request
+type recvStream struct {
+	grpc.ServerStream
+	req *providerv1.ConsolidateSnapshotsRequest
+}
+
+func (s *recvStream) Context() context.Context { return context.Background() }
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(*providerv1.ConsolidateSnapshotsRequest), s.req)
+	return nil
+}
+
+func TestAuditStreamInterceptorRecordsStreamingRPCs(t *testing.T) {
+	var buf bytes.Buffer
+	interceptor := auditStreamInterceptor(&buf)
+	stream := &recvStream{req: &providerv1.ConsolidateSnapshotsRequest{VmId: "vm1"}}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/provider.v1.Provider/ConsolidateSnapshots"},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(&providerv1.ConsolidateSnapshotsRequest{})
+		})
+	require.NoError(t, err)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "ConsolidateSnapshots", entry["action"])
+	assert.Equal(t, "vm1", entry["vm"])
+	assert.Equal(t, "success", entry["outcome"])
+
+	buf.Reset()
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/provider.v1.Provider/WatchEvents"},
+		func(srv interface{}, ss grpc.ServerStream) error { return nil })
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestAuditVMNameOfConsoleRequest(t *testing.T) {
+	req := &providerv1.ConsoleRequest{Payload: &providerv1.ConsoleRequest_Open{Open: &providerv1.ConsoleOpen{VmId: "vm1"}}}
+	assert.Equal(t, "vm1", auditVMName(req))
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/libvirt"
+)
+
+// Configuration sources, from highest to lowest precedence
+const (
+	sourceFlag    = "flag"
+	sourceEnv     = "env"
+	sourceFile    = "file"
+	sourceDefault = "default"
+)
+
+// fileConfig is the structure of the --config file. JSON is accepted as well since
+// it is a subset of YAML.
+type fileConfig struct {
+	Connection struct {
+		// URI is the libvirt connection URI (e.g. qemu+ssh://user@host/system)
+		URI string `yaml:"uri"`
+	} `yaml:"connection"`
+	Credentials struct {
+		// UsernameFile and PasswordFile hold the connection login, typically keys of a mounted secret
+		UsernameFile string `yaml:"usernameFile"`
+		PasswordFile string `yaml:"passwordFile"`
+		// TLSCertFile, TLSKeyFile and TLSCAFile hold the client certificate of qemu+tls connections
+		TLSCertFile string `yaml:"tlsCertFile"`
+		TLSKeyFile  string `yaml:"tlsKeyFile"`
+		TLSCAFile   string `yaml:"tlsCAFile"`
+	} `yaml:"credentials"`
+	Storage struct {
+		// Pool is the storage pool volumes are created in; empty selects the active pool with the most free space
+		Pool string `yaml:"pool"`
+	} `yaml:"storage"`
+	Network struct {
+		// Default is the libvirt network used when an attachment names no network or bridge
+		Default string `yaml:"default"`
+		// Model is the interface model used when an attachment does not set one
+		Model string `yaml:"model"`
+	} `yaml:"network"`
+	Instance struct {
+		// ID identifies this provider in the ownership marker of the domains it manages
+		ID string `yaml:"id"`
+	} `yaml:"instance"`
+	Console struct {
+		// LogDir is the host directory serial console logs are written to
+		LogDir string `yaml:"logDir"`
+	} `yaml:"console"`
+	Domain struct {
+		// Template is a base <domain> definition every generated domain is merged into;
+		// per-VM elements take precedence over it (see libvirt.Options.DomainTemplate)
+		Template string `yaml:"template"`
+		// TemplateFile reads the template from a file instead
+		TemplateFile string `yaml:"templateFile"`
+	} `yaml:"domain"`
+	TLS struct {
+		Cert     string `yaml:"cert"`
+		Key      string `yaml:"key"`
+		ClientCA string `yaml:"clientCA"`
+	} `yaml:"tls"`
+}
+
+// loadConfigFile reads and validates a provider config file
+func loadConfigFile(path string) (*fileConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	cfg := &fileConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if cfg.Connection.URI == "" {
+		return nil, fmt.Errorf("config file %s: connection.uri is required", path)
+	}
+	if (cfg.TLS.Cert == "") != (cfg.TLS.Key == "") {
+		return nil, fmt.Errorf("config file %s: tls.cert and tls.key must be set together", path)
+	}
+	if cfg.TLS.ClientCA != "" && cfg.TLS.Cert == "" {
+		return nil, fmt.Errorf("config file %s: tls.clientCA requires tls.cert and tls.key", path)
+	}
+	if (cfg.Credentials.TLSCertFile == "") != (cfg.Credentials.TLSKeyFile == "") {
+		return nil, fmt.Errorf("config file %s: credentials.tlsCertFile and credentials.tlsKeyFile must be set together", path)
+	}
+	if cfg.Domain.TemplateFile != "" {
+		if cfg.Domain.Template != "" {
+			return nil, fmt.Errorf("config file %s: domain.template and domain.templateFile are mutually exclusive", path)
+		}
+		template, err := os.ReadFile(cfg.Domain.TemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("config file %s: failed to read domain.templateFile: %w", path, err)
+		}
+		cfg.Domain.Template = string(template)
+	}
+	if cfg.Domain.Template != "" {
+		if err := libvirt.ParseDomainTemplate(cfg.Domain.Template); err != nil {
+			return nil, fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+	return cfg, nil
+}
+
+// providerSettings holds the resolved provider configuration
+type providerSettings struct {
+	Endpoint        string
+	StoragePool     string
+	DefaultNetwork  string
+	DefaultNICModel string
+	InstanceID      string
+	ConsoleLogDir   string
+	DomainTemplate  string
+	TLSCert         string
+	TLSKey          string
+	TLSClientCA     string
+	CredentialFiles libvirt.CredentialFiles
+}
+
+// settingsResolver resolves settings with the precedence flag > env > file > default
+type settingsResolver struct {
+	logger   *slog.Logger
+	explicit map[string]bool
+}
+
+// newSettingsResolver records which flags were set on the command line
+func newSettingsResolver(logger *slog.Logger) *settingsResolver {
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	return &settingsResolver{logger: logger, explicit: explicit}
+}
+
+// resolve picks the value of a single setting; flagName and envName may be empty
+func (r *settingsResolver) resolve(name, flagName, flagValue, envName, fileValue, defaultValue string) string {
+	value, source := defaultValue, sourceDefault
+	switch {
+	case flagName != "" && r.explicit[flagName]:
+		value, source = flagValue, sourceFlag
+	case envName != "" && os.Getenv(envName) != "":
+		value, source = os.Getenv(envName), sourceEnv
+	case fileValue != "":
+		value, source = fileValue, sourceFile
+	}
+	r.logger.Debug("Resolved configuration setting", "setting", name, "source", source)
+	return value
+}
+
+// resolveSettings merges the config file (optional), environment and TLS flags
+func resolveSettings(logger *slog.Logger, cfg *fileConfig, tlsCert, tlsKey, tlsClientCA string) providerSettings {
+	if cfg == nil {
+		cfg = &fileConfig{}
+	}
+	r := newSettingsResolver(logger)
+	logger.Debug("Configuration precedence", "order", []string{sourceFlag, sourceEnv, sourceFile, sourceDefault})
+
+	return providerSettings{
+		Endpoint:        r.resolve("connection.uri", "", "", "PROVIDER_ENDPOINT", cfg.Connection.URI, ""),
+		StoragePool:     r.resolve("storage.pool", "", "", "LIBVIRT_STORAGE_POOL", cfg.Storage.Pool, ""),
+		DefaultNetwork:  r.resolve("network.default", "", "", "LIBVIRT_DEFAULT_NETWORK", cfg.Network.Default, ""),
+		DefaultNICModel: r.resolve("network.model", "", "", "LIBVIRT_DEFAULT_NIC_MODEL", cfg.Network.Model, "virtio"),
+		InstanceID:      r.resolve("instance.id", "", "", "PROVIDER_INSTANCE_ID", cfg.Instance.ID, libvirt.DefaultInstanceID),
+		ConsoleLogDir:   r.resolve("console.logDir", "", "", "PROVIDER_CONSOLE_LOG_DIR", cfg.Console.LogDir, libvirt.DefaultConsoleLogDir),
+		DomainTemplate:  r.resolve("domain.template", "", "", "", cfg.Domain.Template, ""),
+		TLSCert:         r.resolve("tls.cert", "tls-cert", tlsCert, "PROVIDER_TLS_CERT", cfg.TLS.Cert, ""),
+		TLSKey:          r.resolve("tls.key", "tls-key", tlsKey, "PROVIDER_TLS_KEY", cfg.TLS.Key, ""),
+		TLSClientCA:     r.resolve("tls.clientCA", "tls-client-ca", tlsClientCA, "PROVIDER_TLS_CLIENT_CA", cfg.TLS.ClientCA, ""),
+		CredentialFiles: libvirt.CredentialFiles{
+			UsernameFile: r.resolve("credentials.usernameFile", "", "", "LIBVIRT_USERNAME_FILE", cfg.Credentials.UsernameFile, ""),
+			PasswordFile: r.resolve("credentials.passwordFile", "", "", "LIBVIRT_PASSWORD_FILE", cfg.Credentials.PasswordFile, ""),
+			TLSCertFile:  r.resolve("credentials.tlsCertFile", "", "", "LIBVIRT_TLS_CERT_FILE", cfg.Credentials.TLSCertFile, ""),
+			TLSKeyFile:   r.resolve("credentials.tlsKeyFile", "", "", "LIBVIRT_TLS_KEY_FILE", cfg.Credentials.TLSKeyFile, ""),
+			TLSCAFile:    r.resolve("credentials.tlsCAFile", "", "", "LIBVIRT_TLS_CA_FILE", cfg.Credentials.TLSCAFile, ""),
+		},
+	}
+}
@@ -25,17 +25,35 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
+	grpchealth "google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/projectbeskar/virtrigaud/internal/obs/health"
+	"github.com/projectbeskar/virtrigaud/internal/obs/metrics"
+	"github.com/projectbeskar/virtrigaud/internal/obs/tracing"
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
 	"github.com/projectbeskar/virtrigaud/internal/providers/libvirt"
 	"github.com/projectbeskar/virtrigaud/internal/version"
 	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
 )
 
+// defaultMaxMsgBytes is the default gRPC message size limit (16 MiB)
+const defaultMaxMsgBytes = 16 * 1024 * 1024
+
+// Defaults for the limit on concurrent Create, Clone and Migrate operations
+const (
+	defaultMaxConcurrentOps = 8
+	defaultMaxQueuedOps     = 32
+)
+
 func main() {
 	// Handle --version flag before any other flag parsing
 	if len(os.Args) > 1 && os.Args[1] == "--version" {
@@ -45,80 +63,275 @@ func main() {
 
 	var port int
 	var healthPort int
+	var tlsCert, tlsKey, tlsClientCA string
+	var maxRecvMsgBytes, maxSendMsgBytes int
+	var enableIgnition bool
+	var allowGuestExec bool
+	var allowDebugRPCs bool
+	var allowQEMUPassthrough bool
+	var qemuPassthroughPrefixes string
+	var autoCreatePools bool
+	var shutdownTimeout time.Duration
+	var configFile string
+	var maxConcurrentOps, maxQueuedOps int
+	var rpcTimeout time.Duration
+	var vmLockWait time.Duration
+	var connectTimeout time.Duration
+	var auditLogPath string
+	var socketPath, healthSocketSuffix string
+	var macOUI string
+	var hosts string
+	var cpuOvercommit, memOvercommit float64
 	flag.IntVar(&port, "port", 9443, "gRPC server port")
 	flag.IntVar(&healthPort, "health-port", 8080, "Health check port")
+	flag.StringVar(&socketPath, "socket", "", "Serve gRPC on this Unix domain socket instead of TCP (e.g. for a sidecar sharing the pod)")
+	flag.StringVar(&healthSocketSuffix, "health-socket-suffix", "", "With --socket, serve the HTTP health endpoints on the socket path plus this suffix (e.g. .health) instead of --health-port")
+	flag.StringVar(&tlsCert, "tls-cert", "", "Path to the server TLS certificate")
+	flag.StringVar(&tlsKey, "tls-key", "", "Path to the server TLS private key")
+	flag.StringVar(&tlsClientCA, "tls-client-ca", "", "Path to the CA bundle used to verify client certificates (enables mTLS)")
+	flag.IntVar(&maxRecvMsgBytes, "max-recv-msg-bytes", getEnvInt("GRPC_MAX_RECV_BYTES", defaultMaxMsgBytes), "Maximum gRPC message size the server will receive")
+	flag.IntVar(&maxSendMsgBytes, "max-send-msg-bytes", getEnvInt("GRPC_MAX_SEND_BYTES", defaultMaxMsgBytes), "Maximum gRPC message size the server will send")
+	flag.BoolVar(&enableIgnition, "enable-ignition", false, "Accept Ignition configs and pass them to guests through fw_cfg (requires libvirt >= 6.5)")
+	flag.BoolVar(&allowGuestExec, "allow-guest-exec", false, "Allow ExecInGuest to run commands inside guests through the QEMU guest agent")
+	flag.BoolVar(&allowDebugRPCs, "allow-debug-rpcs", false, "Allow troubleshooting RPCs such as GetRawXML, which returns the domain XML with secrets redacted")
+	flag.BoolVar(&allowQEMUPassthrough, "allow-qemu-passthrough", false, "Accept qemu command-line arguments in create requests, limited to --qemu-passthrough-prefixes")
+	flag.StringVar(&qemuPassthroughPrefixes, "qemu-passthrough-prefixes", strings.Join(libvirt.DefaultQEMUPassthroughPrefixes, ","), "Comma-separated prefixes every qemu passthrough option (with its values) must start with")
+	flag.StringVar(&macOUI, "mac-oui", libvirt.DefaultMACOUI, "OUI prefix of the MAC addresses generated (from the VM UUID and NIC index) for interfaces without one")
+	flag.StringVar(&hosts, "hosts", os.Getenv("LIBVIRT_HOSTS"), "Comma-separated name=URI pairs of further libvirt hosts; Create places VMs on the host named by their placement")
+	flag.Float64Var(&cpuOvercommit, "cpu-overcommit", libvirt.DefaultOvercommitRatio, "Ratio of vCPUs to physical CPUs GetCapacity reports as schedulable (e.g. 4)")
+	flag.Float64Var(&memOvercommit, "mem-overcommit", libvirt.DefaultOvercommitRatio, "Ratio of VM memory to physical memory GetCapacity reports as schedulable (e.g. 1.5)")
+	flag.BoolVar(&autoCreatePools, "auto-create-pools", false, "Define and start missing storage pools named by a create request (directory pools, or LVM pools for /dev/<vg> paths)")
+	flag.DurationVar(&shutdownTimeout, "shutdown-timeout", defaultShutdownTimeout, "Maximum time to drain in-flight RPCs on shutdown before forcing the server to stop")
+	flag.IntVar(&maxConcurrentOps, "max-concurrent-ops", defaultMaxConcurrentOps, "Maximum concurrent Create, Clone and Migrate operations against libvirt (0 = unlimited)")
+	flag.IntVar(&maxQueuedOps, "max-queued-ops", defaultMaxQueuedOps, "Maximum operations waiting for a slot before new ones are rejected as transient errors")
+	flag.DurationVar(&rpcTimeout, "rpc-timeout", defaultRPCTimeout, "Deadline applied to unary RPCs whose client did not set one (0 = none)")
+	flag.DurationVar(&vmLockWait, "vm-lock-wait", defaultVMLockWait, "How long an RPC waits for another operation on the same VM before failing with a transient error")
+	flag.DurationVar(&connectTimeout, "connect-timeout", defaultConnectTimeout, "How long to wait for the libvirt connection at startup before exiting")
+	flag.StringVar(&auditLogPath, "audit-log-path", "", "File to append the JSON audit log of state-changing RPCs to (default: stdout)")
+	flag.StringVar(&configFile, "config", "", "Path to a YAML or JSON provider config file; environment variables and flags override its values")
 	flag.Parse()
 
-	// Create logger with configurable format
+	// Create logger with configurable format; the level can be reloaded with SIGHUP
+	logLevel := &slog.LevelVar{}
+	logLevel.Set(getLogLevel())
 	var handler slog.Handler
 	logFormat := os.Getenv("LOG_FORMAT")
 	if logFormat == "json" {
 		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: getLogLevel(),
+			Level: logLevel,
 		})
 	} else {
 		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level: getLogLevel(),
+			Level: logLevel,
 		})
 	}
 	logger := slog.New(handler)
 
+	// Load the optional config file and resolve settings (flag > env > file > default)
+	var cfg *fileConfig
+	if configFile != "" {
+		var err error
+		cfg, err = loadConfigFile(configFile)
+		if err != nil {
+			logger.Error("Invalid provider configuration", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("Loaded provider config file", "path", configFile)
+	}
+	settings := resolveSettings(logger, cfg, tlsCert, tlsKey, tlsClientCA)
+
 	// Create context that listens for the interrupt signal from the OS
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
+	go reloadLogLevelOnSIGHUP(ctx, logger, logLevel)
+
+	// Export build information and per-RPC metrics
+	metrics.SetupMetrics(version.Version, version.GitSHA, "provider-libvirt")
+
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxRecvMsgBytes),
+		grpc.MaxSendMsgSize(maxSendMsgBytes),
+	}
+	// Audit entries are kept apart from the operational log when a path is set
+	auditLog, closeAuditLog, err := openAuditLog(auditLogPath)
+	if err != nil {
+		logger.Error("Failed to open audit log", "path", auditLogPath, "error", err)
+		os.Exit(1)
+	}
+	defer closeAuditLog()
+
+	inflight := &inflightTracker{}
+	vmLocks := newVMLocks(vmLockWait)
+	interceptors := []grpc.UnaryServerInterceptor{
+		requestIDInterceptor(logger),
+		inflight.unaryInterceptor(),
+		deadlineInterceptor(rpcTimeout),
+		metrics.UnaryServerInterceptor("libvirt"),
+		auditInterceptor(auditLog),
+		vmLocks.unaryInterceptor(),
+	}
+
+	// Configure tracing; without OTEL_EXPORTER_OTLP_ENDPOINT no handler is installed
+	tracingConfig := tracing.OTLPConfigFromEnv(tracing.ServiceProviderLibvirt, version.Version)
+	shutdownTracing, err := tracing.Setup(ctx, tracingConfig)
+	if err != nil {
+		logger.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	defer shutdownTracing()
+	if tracingConfig.Enabled {
+		serverOpts = append(serverOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+		interceptors = append(interceptors, tracing.GRPCServerAttributesInterceptor("libvirt"))
+	}
+
+	// Configure transport security
+	creds, tlsMode, err := buildTransportCredentials(settings.TLSCert, settings.TLSKey, settings.TLSClientCA)
+	if err != nil {
+		logger.Error("Failed to configure TLS", "error", err)
+		os.Exit(1)
+	}
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	} else if socketPath == "" {
+		logger.Warn("TLS is not configured, serving gRPC without transport security")
+	}
+
+	// Create gRPC server; errors are translated last so metrics see the final status code
+	interceptors = append(interceptors, libvirt.UnaryErrorInterceptor())
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(requestIDStreamInterceptor(logger), inflight.streamInterceptor(),
+			auditStreamInterceptor(auditLog), vmLocks.streamInterceptor(), libvirt.StreamErrorInterceptor()),
+	)
+	server := grpc.NewServer(serverOpts...)
 
-	// Create gRPC server
-	server := grpc.NewServer()
+	// Create Libvirt provider with SDK pattern (credentials are read from environment)
+	providerImpl := libvirt.NewFromConfig(&libvirt.Config{
+		Endpoint:    settings.Endpoint,
+		StoragePool: settings.StoragePool,
 
-	// Create Libvirt provider with SDK pattern (reads config from environment)
-	providerImpl := libvirt.New()
+		CredentialFiles: settings.CredentialFiles,
+	})
+	if _, err := libvirt.ParseMACOUI(macOUI); err != nil {
+		logger.Error("Invalid --mac-oui", "error", err)
+		os.Exit(1)
+	}
+	if cpuOvercommit <= 0 || memOvercommit <= 0 {
+		logger.Error("Overcommit ratios must be positive", "cpu_overcommit", cpuOvercommit, "mem_overcommit", memOvercommit)
+		os.Exit(1)
+	}
+	namedHosts, err := parseHosts(hosts)
+	if err != nil {
+		logger.Error("Invalid --hosts", "error", err)
+		os.Exit(1)
+	}
+	providerImpl.SetOptions(libvirt.Options{
+		EnableIgnition:   enableIgnition,
+		DefaultNetwork:   settings.DefaultNetwork,
+		DefaultNICModel:  settings.DefaultNICModel,
+		MaxConcurrentOps: maxConcurrentOps,
+		MaxQueuedOps:     maxQueuedOps,
+		InstanceID:       settings.InstanceID,
+		ConsoleLogDir:    settings.ConsoleLogDir,
+		AllowGuestExec:   allowGuestExec,
+		AllowDebugRPCs:   allowDebugRPCs,
+		AutoCreatePools:  autoCreatePools,
+
+		AllowQEMUPassthrough:    allowQEMUPassthrough,
+		QEMUPassthroughPrefixes: splitList(qemuPassthroughPrefixes),
+		MACOUI:                  macOUI,
+		Hosts:                   namedHosts,
+		DomainTemplate:          settings.DomainTemplate,
+		CPUOvercommit:           cpuOvercommit,
+		MemoryOvercommit:        memOvercommit,
+	})
+	providerImpl.Start(context.Background())
 	provider := libvirt.NewServer(providerImpl)
 
 	// Register the provider service
 	providerv1.RegisterProviderServer(server, provider)
 
-	// Register health service
-	healthServer := health.NewServer()
+	// Register health service; it reports NOT_SERVING until the libvirt connection is verified
+	healthServer := grpchealth.NewServer()
 	grpc_health_v1.RegisterHealthServer(server, healthServer)
-	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 
 	// Start gRPC server
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	var lis net.Listener
+	if socketPath != "" {
+		lis, err = listenUnix(socketPath)
+	} else {
+		lis, err = net.Listen("tcp", fmt.Sprintf(":%d", port))
+	}
 	if err != nil {
 		logger.Error("Failed to listen", "error", err)
 		os.Exit(1)
 	}
 
+	// The health server shares the socket directory when asked to, and stays on TCP otherwise
+	var healthLis net.Listener
+	var healthSocketPath string
+	if socketPath != "" && healthSocketSuffix != "" {
+		healthSocketPath = socketPath + healthSocketSuffix
+		healthLis, err = listenUnix(healthSocketPath)
+	} else {
+		healthLis, err = net.Listen("tcp", fmt.Sprintf(":%d", healthPort))
+	}
+	if err != nil {
+		logger.Error("Failed to listen for health checks", "error", err)
+		os.Exit(1)
+	}
+
 	logger.Info("Starting Libvirt provider server",
 		"version", version.String(),
-		"log_level", getLogLevel().String(),
+		"log_level", logLevel.Level().String(),
 		"log_format", logFormat,
 		"port", port,
+		"socket", socketPath,
+		"instance_id", settings.InstanceID,
+		"console_log_dir", settings.ConsoleLogDir,
 		"health_port", healthPort,
-		"capabilities", []string{
-			"core", "snapshots", "linked-clones",
-			"online-reconfigure", "qemu-guest-agent",
-		},
-		"supported_platforms", []string{"kvm", "qemu", "libvirt"},
+		"health_socket", healthSocketPath,
+		"max_recv_msg_bytes", maxRecvMsgBytes,
+		"max_send_msg_bytes", maxSendMsgBytes,
+		"shutdown_timeout", shutdownTimeout.String(),
+		"connect_timeout", connectTimeout.String(),
+		"rpc_timeout", rpcTimeout.String(),
+		"max_concurrent_ops", maxConcurrentOps,
+		"max_queued_ops", maxQueuedOps,
+		"vm_lock_wait", vmLockWait.String(),
+		"mac_oui", macOUI,
+		"hosts", len(namedHosts),
+		"domain_template", settings.DomainTemplate != "",
+		"cpu_overcommit", cpuOvercommit,
+		"mem_overcommit", memOvercommit,
+		"capabilities", providerImpl.Capabilities(),
+		"tls_mode", tlsMode,
+		"audit_log_path", auditLogPath,
+		"tracing_enabled", tracingConfig.Enabled,
+		"supported_platforms", libvirt.SupportedPlatforms,
 	)
 
+	// Readiness probes the hypervisor connection when the provider supports it,
+	// and fails until the connection has been verified at startup
+	healthChecker := health.NewHealthChecker()
+	gate := &startupGate{}
+	healthChecker.RegisterCheck("startup", gate.check)
+	if hc, ok := any(providerImpl).(contracts.HealthChecker); ok {
+		healthChecker.RegisterCheck("libvirt", hc.CheckHealth)
+	}
+
 	// Create HTTP health server
 	healthMux := http.NewServeMux()
-	healthMux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("ok"))
-	})
-	healthMux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("ready"))
-	})
+	healthMux.HandleFunc("/healthz", healthChecker.LivenessHandler())
+	healthMux.HandleFunc("/readyz", healthChecker.ReadinessHandler())
+	healthMux.Handle("/metrics", promhttp.Handler())
 
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", healthPort),
 		Handler: healthMux,
 	}
 
-	logger.Info("Starting HTTP health server", "port", healthPort)
+	logger.Info("Starting HTTP health server", "address", healthLis.Addr().String())
 
 	// Start gRPC server in a goroutine
 	go func() {
@@ -130,20 +343,41 @@ func main() {
 
 	// Start HTTP health server in a goroutine
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := httpServer.Serve(healthLis); err != nil && err != http.ErrServerClosed {
 			logger.Error("Failed to serve HTTP health server", "error", err)
 			os.Exit(1)
 		}
 	}()
 
+	// Serve health only once libvirt is reachable; give up after the connect timeout
+	if err := waitForConnection(ctx, logger, providerImpl, connectTimeout); err != nil {
+		if ctx.Err() == nil {
+			logger.Error("Failed to connect to libvirt", "error", err)
+			os.Exit(1)
+		}
+	} else {
+		gate.ready.Store(true)
+		healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+		logger.Info("Provider ready")
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	<-ctx.Done()
 
-	logger.Info("Shutting down gRPC server...")
-	server.GracefulStop()
+	logger.Info("Shutting down gRPC server...", "inflight_rpcs", inflight.count.Load())
+	stopServer(logger, server, inflight, shutdownTimeout)
 
 	logger.Info("Shutting down HTTP health server...")
 	_ = httpServer.Shutdown(context.Background())
+
+	for _, path := range []string{socketPath, healthSocketPath} {
+		if path == "" {
+			continue
+		}
+		if err := removeSocket(path); err != nil {
+			logger.Warn("Failed to remove socket", "path", path, "error", err)
+		}
+	}
 }
 
 // getLogLevel returns the log level from LOG_LEVEL environment variable.
@@ -160,3 +394,61 @@ func getLogLevel() slog.Level {
 		return slog.LevelInfo
 	}
 }
+
+// reloadLogLevelOnSIGHUP re-reads LOG_LEVEL whenever the process receives SIGHUP
+func reloadLogLevelOnSIGHUP(ctx context.Context, logger *slog.Logger, level *slog.LevelVar) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			oldLevel := level.Level()
+			newLevel := getLogLevel()
+			level.Set(newLevel)
+			logger.Info("Reloaded log level", "old_level", oldLevel.String(), "new_level", newLevel.String())
+		}
+	}
+}
+
+// getEnvInt returns the integer value of the named environment variable,
+// or def if it is unset or not a valid integer.
+func getEnvInt(name string, def int) int {
+	if v := os.Getenv(name); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseHosts parses comma-separated name=URI pairs of named libvirt hosts
+func parseHosts(value string) (map[string]string, error) {
+	hosts := map[string]string{}
+	for _, item := range splitList(value) {
+		name, uri, ok := strings.Cut(item, "=")
+		name, uri = strings.TrimSpace(name), strings.TrimSpace(uri)
+		if !ok || name == "" || uri == "" {
+			return nil, fmt.Errorf("expected name=URI, got %q", item)
+		}
+		if _, dup := hosts[name]; dup {
+			return nil, fmt.Errorf("host %q is listed twice", name)
+		}
+		hosts[name] = uri
+	}
+	return hosts, nil
+}
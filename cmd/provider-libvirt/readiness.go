@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultConnectTimeout is how long startup waits for the libvirt connection
+	defaultConnectTimeout = 2 * time.Minute
+
+	// connectRetryInterval is the delay between connection attempts during startup
+	connectRetryInterval = 5 * time.Second
+)
+
+// connector establishes the hypervisor connection of a provider
+type connector interface {
+	Connect(ctx context.Context) error
+}
+
+// startupGate reports the provider as not ready until the hypervisor connection is verified
+type startupGate struct {
+	ready atomic.Bool
+}
+
+// check is a health check that fails until the gate is opened
+func (g *startupGate) check(context.Context) error {
+	if !g.ready.Load() {
+		return errors.New("waiting for the libvirt connection")
+	}
+	return nil
+}
+
+// waitForConnection retries Connect until it succeeds, the timeout elapses or ctx is done
+func waitForConnection(ctx context.Context, logger *slog.Logger, provider connector, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		err := provider.Connect(ctx)
+		if err == nil {
+			logger.Info("Libvirt connection established", "attempts", attempt)
+			return nil
+		}
+		logger.Warn("Libvirt connection not ready, retrying", "attempt", attempt, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("libvirt connection not established within %s: %w", timeout, err)
+		case <-time.After(connectRetryInterval):
+		}
+	}
+}
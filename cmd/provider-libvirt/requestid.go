@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/projectbeskar/virtrigaud/internal/obs/logging"
+)
+
+// maxRequestIDLength bounds client-supplied request IDs so they cannot bloat every log line
+const maxRequestIDLength = 128
+
+// requestID returns the correlation ID a client sent with an RPC. When there is none a new
+// one is generated and generated is true, so that it can be returned in the trailers.
+func requestID(ctx context.Context) (id string, generated bool) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logging.RequestIDMetadataKey); len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIDLength {
+			return values[0], false
+		}
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b), true
+}
+
+// withRequestLogger scopes a logger to an RPC's correlation ID and stores both in context
+func withRequestLogger(ctx context.Context, logger *slog.Logger, id, method string) context.Context {
+	ctx = logging.WithCorrelationID(ctx, id)
+	return logging.WithSlogger(ctx, logger.With("request_id", id, "method", method))
+}
+
+// requestIDInterceptor attaches the request ID and a request-scoped logger to unary RPCs.
+// Generated IDs are returned to the client in the response trailers.
+func requestIDInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id, generated := requestID(ctx)
+		if generated {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(logging.RequestIDMetadataKey, id))
+		}
+		ctx = withRequestLogger(ctx, logger, id, info.FullMethod)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			logging.Slogger(ctx).Warn("RPC failed",
+				"code", status.Code(err).String(),
+				"duration_ms", time.Since(start).Milliseconds(),
+				"error", err,
+			)
+		} else {
+			logging.Slogger(ctx).Debug("RPC finished", "duration_ms", time.Since(start).Milliseconds())
+		}
+		return resp, err
+	}
+}
+
+// requestIDStreamInterceptor attaches the request ID and a request-scoped logger to
+// streaming RPCs
+func requestIDStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id, generated := requestID(ss.Context())
+		if generated {
+			ss.SetTrailer(metadata.Pairs(logging.RequestIDMetadataKey, id))
+		}
+		ctx := withRequestLogger(ss.Context(), logger, id, info.FullMethod)
+
+		err := handler(srv, &requestScopedStream{ServerStream: ss, ctx: ctx})
+		logging.Slogger(ctx).Debug("Stream finished", "code", status.Code(err).String())
+		return err
+	}
+}
+
+// requestScopedStream overrides the context of a server stream
+type requestScopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the request-scoped context
+func (s *requestScopedStream) Context() context.Context {
+	return s.ctx
+}
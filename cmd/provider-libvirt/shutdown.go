@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// defaultShutdownTimeout bounds graceful shutdown, well inside the default pod grace period
+const defaultShutdownTimeout = 30 * time.Second
+
+// inflightTracker counts RPCs that are currently being served
+type inflightTracker struct {
+	count atomic.Int64
+}
+
+// unaryInterceptor tracks unary RPCs for the duration of the handler
+func (t *inflightTracker) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		t.count.Add(1)
+		defer t.count.Add(-1)
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor tracks streaming RPCs (consoles) until the stream ends
+func (t *inflightTracker) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		t.count.Add(1)
+		defer t.count.Add(-1)
+		return handler(srv, ss)
+	}
+}
+
+// stopServer drains the gRPC server and forcibly stops it if draining exceeds timeout
+func stopServer(logger *slog.Logger, server *grpc.Server, inflight *inflightTracker, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		logger.Info("gRPC server drained")
+	case <-timer.C:
+		logger.Warn("Graceful shutdown timed out, forcing stop",
+			"timeout", timeout.String(),
+			"inflight_rpcs", inflight.count.Load(),
+		)
+		server.Stop()
+		<-stopped
+	}
+}
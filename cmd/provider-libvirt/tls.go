@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// TLS modes reported in the startup log
+const (
+	tlsModeInsecure = "insecure"
+	tlsModeServer   = "tls"
+	tlsModeMutual   = "mtls"
+)
+
+// buildTransportCredentials builds gRPC transport credentials from the TLS flags.
+// It returns nil credentials when no certificate is configured (insecure mode).
+func buildTransportCredentials(certFile, keyFile, clientCAFile string) (credentials.TransportCredentials, string, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, "", fmt.Errorf("--tls-client-ca requires --tls-cert and --tls-key")
+		}
+		return nil, tlsModeInsecure, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, "", fmt.Errorf("both --tls-cert and --tls-key must be set")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile == "" {
+		return credentials.NewTLS(tlsConfig), tlsModeServer, nil
+	}
+
+	caPEM, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read client CA: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, "", fmt.Errorf("no valid certificates found in client CA %s", clientCAFile)
+	}
+
+	tlsConfig.ClientCAs = caPool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return credentials.NewTLS(tlsConfig), tlsModeMutual, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/projectbeskar/virtrigaud/internal/obs/metrics"
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/internal/providers/libvirt"
+)
+
+// defaultVMLockWait bounds how long an RPC waits for another operation on the same VM
+const defaultVMLockWait = 10 * time.Second
+
+// Outcomes of a per-VM lock wait reported by the lock wait metric
+const (
+	vmLockAcquired  = "acquired"
+	vmLockContended = "contended"
+)
+
+// vmLockedMethods lists the RPCs that mutate a single VM and must not interleave with
+// each other. Migrate and full clones hold the lock until their background task finishes;
+// ConsolidateSnapshots is a stream and holds it until the stream ends.
+var vmLockedMethods = map[string]bool{
+	"Create":               true,
+	"EnsureVM":             true,
+	"Delete":               true,
+	"Power":                true,
+	"Reconfigure":          true,
+	"HardwareUpgrade":      true,
+	"SnapshotCreate":       true,
+	"SnapshotDelete":       true,
+	"SnapshotRevert":       true,
+	"Clone":                true,
+	"Migrate":              true,
+	"AdoptVM":              true,
+	"RebootVM":             true,
+	"RenameVM":             true,
+	"InsertMedia":          true,
+	"EjectMedia":           true,
+	"SuspendVM":            true,
+	"ResumeVM":             true,
+	"SaveVM":               true,
+	"DiscardManagedSave":   true,
+	"SetGuestHostname":     true,
+	"ExecInGuest":          true,
+	"ConsolidateSnapshots": true,
+}
+
+// vmLocks is a keyed mutex serializing operations on the same VM while operations
+// on different VMs proceed in parallel. Entries are dropped once nobody holds or
+// waits for them, so the table only grows with the number of VMs being mutated.
+type vmLocks struct {
+	mu      sync.Mutex
+	entries map[string]*vmLockEntry
+	wait    time.Duration
+	metrics *metrics.ConcurrencyMetrics
+}
+
+// vmLockEntry is the lock of one VM; holding it means owning the single token of held
+type vmLockEntry struct {
+	held chan struct{}
+	refs int
+}
+
+// newVMLocks returns a lock table whose callers give up after waiting for wait
+func newVMLocks(wait time.Duration) *vmLocks {
+	return &vmLocks{
+		entries: make(map[string]*vmLockEntry),
+		wait:    wait,
+		metrics: metrics.NewConcurrencyMetrics("libvirt"),
+	}
+}
+
+// lock takes the lock of vm for method. A caller that cannot get it within the wait
+// gets a retryable error instead of blocking behind a long-running operation.
+func (l *vmLocks) lock(ctx context.Context, method, vm string) (func(), error) {
+	l.mu.Lock()
+	entry, ok := l.entries[vm]
+	if !ok {
+		entry = &vmLockEntry{held: make(chan struct{}, 1)}
+		l.entries[vm] = entry
+	}
+	entry.refs++
+	l.mu.Unlock()
+
+	start := time.Now()
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+
+	select {
+	case entry.held <- struct{}{}:
+		l.metrics.RecordLockWait(method, vmLockAcquired, time.Since(start))
+		var once sync.Once
+		return func() {
+			once.Do(func() {
+				<-entry.held
+				l.unref(vm, entry)
+			})
+		}, nil
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	l.metrics.RecordLockWait(method, vmLockContended, time.Since(start))
+	l.unref(vm, entry)
+	return nil, contracts.NewRetryableError(
+		fmt.Sprintf("another operation on VM %s is in progress; %s gave up after %s", vm, method, time.Since(start).Round(time.Millisecond)), ctx.Err())
+}
+
+// unref drops a holder or waiter of entry, removing it from the table when it was the last
+func (l *vmLocks) unref(vm string, entry *vmLockEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.entries, vm)
+	}
+}
+
+// unaryInterceptor serializes the VM-mutating RPCs per VM. An RPC that starts a background
+// task hands the lock over to the task, which releases it when it finishes.
+func (l *vmLocks) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		if !vmLockedMethods[method] {
+			return handler(ctx, req)
+		}
+		vm := auditVMName(req)
+		if vm == "" {
+			return handler(ctx, req)
+		}
+
+		unlock, err := l.lock(ctx, method, vm)
+		if err != nil {
+			return nil, libvirt.ToStatusError(err)
+		}
+		var handedOver atomic.Bool
+		ctx = libvirt.WithTaskHold(ctx, func() func() {
+			handedOver.Store(true)
+			return unlock
+		})
+		defer func() {
+			if !handedOver.Load() {
+				unlock()
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor serializes the VM-mutating streaming RPCs per VM. The VM is named by
+// the first message received, so the lock is taken then and held until the stream ends.
+func (l *vmLocks) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method := path.Base(info.FullMethod)
+		if !vmLockedMethods[method] {
+			return handler(srv, ss)
+		}
+
+		stream := &lockedStream{ServerStream: ss, locks: l, method: method}
+		defer stream.unlock()
+		return handler(srv, stream)
+	}
+}
+
+// lockedStream takes the lock of the VM named by the first message received on a stream
+type lockedStream struct {
+	grpc.ServerStream
+	locks    *vmLocks
+	method   string
+	received bool
+	release  func()
+}
+
+// RecvMsg receives a message, locking the VM of the first one before the handler sees it
+func (s *lockedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil || s.received {
+		return err
+	}
+	s.received = true
+	vm := auditVMName(m)
+	if vm == "" {
+		return nil
+	}
+	release, err := s.locks.lock(s.Context(), s.method, vm)
+	if err != nil {
+		return libvirt.ToStatusError(err)
+	}
+	s.release = release
+	return nil
+}
+
+// unlock releases the VM lock of the stream, if it took one
+func (s *lockedStream) unlock() {
+	if s.release != nil {
+		s.release()
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// lockedCall runs req through the lock interceptor as method, calling handler inside it
+func lockedCall(locks *vmLocks, method string, req interface{}, handler func()) error {
+	info := &grpc.UnaryServerInfo{FullMethod: "/provider.v1.Provider/" + method}
+	_, err := locks.unaryInterceptor()(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handler()
+		return nil, nil
+	})
+	return err
+}
+
+// heldCall starts an RPC whose handler blocks until release is closed and waits until it runs
+func heldCall(t *testing.T, locks *vmLocks, method string, req interface{}) (release func(), done <-chan error) {
+	t.Helper()
+	entered := make(chan struct{})
+	released := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		errs <- lockedCall(locks, method, req, func() {
+			close(entered)
+			<-released
+		})
+	}()
+	select {
+	case <-entered:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s did not start", method)
+	}
+	return func() { close(released) }, errs
+}
+
+func TestVMLocksSerializeMutationsOfTheSameVM(t *testing.T) {
+	locks := newVMLocks(5 * time.Second)
+	release, firstDone := heldCall(t, locks, "Power", &providerv1.PowerRequest{Id: "vm-a"})
+
+	var secondRan atomic.Bool
+	secondDone := make(chan error, 1)
+	go func() {
+		secondDone <- lockedCall(locks, "Reconfigure", &providerv1.ReconfigureRequest{Id: "vm-a"}, func() {
+			secondRan.Store(true)
+		})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, secondRan.Load(), "Reconfigure ran while Power held the lock of vm-a")
+
+	release()
+	require.NoError(t, <-firstDone)
+	require.NoError(t, <-secondDone)
+	assert.True(t, secondRan.Load())
+	assert.Empty(t, locks.entries, "lock entries are dropped once released")
+}
+
+func TestVMLocksRunDifferentVMsInParallel(t *testing.T) {
+	locks := newVMLocks(5 * time.Second)
+	release, firstDone := heldCall(t, locks, "Power", &providerv1.PowerRequest{Id: "vm-a"})
+	defer func() {
+		release()
+		require.NoError(t, <-firstDone)
+	}()
+
+	ran := false
+	require.NoError(t, lockedCall(locks, "Power", &providerv1.PowerRequest{Id: "vm-b"}, func() { ran = true }))
+	assert.True(t, ran, "Power on vm-b waited for vm-a")
+
+	ran = false
+	require.NoError(t, lockedCall(locks, "Describe", &providerv1.DescribeRequest{Id: "vm-a"}, func() { ran = true }))
+	assert.True(t, ran, "read-only RPCs do not take the lock")
+}
+
+func TestVMLocksGiveUpAfterWait(t *testing.T) {
+	locks := newVMLocks(20 * time.Millisecond)
+	release, firstDone := heldCall(t, locks, "Power", &providerv1.PowerRequest{Id: "vm-a"})
+
+	ran := false
+	err := lockedCall(locks, "Delete", &providerv1.DeleteRequest{Id: "vm-a"}, func() { ran = true })
+	assert.False(t, ran)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "got %v", err)
+
+	release()
+	require.NoError(t, <-firstDone)
+	assert.Empty(t, locks.entries)
+}
+
+func TestVMLocksConcurrentMutations(t *testing.T) {
+	locks := newVMLocks(10 * time.Second)
+	vms := []string{"vm-a", "vm-b", "vm-c"}
+	methods := []string{"Power", "Reconfigure", "SnapshotCreate", "SetGuestHostname"}
+
+	var mu sync.Mutex
+	active := make(map[string]int)
+	var overlapping, maxParallel, parallel int
+
+	var wg sync.WaitGroup
+	for i := 0; i < 60; i++ {
+		vm := vms[i%len(vms)]
+		method := methods[i%len(methods)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := lockedCall(locks, method, &providerv1.PowerRequest{Id: vm}, func() {
+				mu.Lock()
+				active[vm]++
+				if active[vm] > 1 {
+					overlapping++
+				}
+				parallel++
+				maxParallel = max(maxParallel, parallel)
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				active[vm]--
+				parallel--
+				mu.Unlock()
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Zero(t, overlapping, "operations on the same VM overlapped")
+	assert.LessOrEqual(t, maxParallel, len(vms))
+	assert.Empty(t, locks.entries)
+}
+
+func TestVMLocksSerializeStreamsWithMutationsOfTheSameVM(t *testing.T) {
+	locks := newVMLocks(5 * time.Second)
+	interceptor := locks.streamInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/provider.v1.Provider/ConsolidateSnapshots"}
+
+	entered := make(chan struct{})
+	released := make(chan struct{})
+	streamDone := make(chan error, 1)
+	go func() {
+		stream := &recvStream{req: &providerv1.ConsolidateSnapshotsRequest{VmId: "vm-a"}}
+		streamDone <- interceptor(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+			if err := ss.RecvMsg(&providerv1.ConsolidateSnapshotsRequest{}); err != nil {
+				return err
+			}
+			close(entered)
+			<-released
+			return nil
+		})
+	}()
+	<-entered
+
+	// Another VM is not held up by the stream
+	require.NoError(t, lockedCall(locks, "SnapshotDelete", &providerv1.SnapshotDeleteRequest{VmId: "vm-b"}, func() {}))
+
+	var deleteRan atomic.Bool
+	deleteDone := make(chan error, 1)
+	go func() {
+		deleteDone <- lockedCall(locks, "SnapshotDelete", &providerv1.SnapshotDeleteRequest{VmId: "vm-a"}, func() {
+			deleteRan.Store(true)
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, deleteRan.Load(), "SnapshotDelete ran while ConsolidateSnapshots held the lock of vm-a")
+
+	close(released)
+	require.NoError(t, <-streamDone)
+	require.NoError(t, <-deleteDone)
+	assert.True(t, deleteRan.Load())
+	assert.Empty(t, locks.entries)
+}
+
+func TestVMLocksStreamGivesUpAfterWait(t *testing.T) {
+	locks := newVMLocks(20 * time.Millisecond)
+	release, done := heldCall(t, locks, "Delete", &providerv1.DeleteRequest{Id: "vm-a"})
+	defer func() {
+		release()
+		require.NoError(t, <-done)
+	}()
+
+	ran := false
+	stream := &recvStream{req: &providerv1.ConsolidateSnapshotsRequest{VmId: "vm-a"}}
+	err := locks.streamInterceptor()(nil, stream, &grpc.StreamServerInfo{FullMethod: "/provider.v1.Provider/ConsolidateSnapshots"},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			if err := ss.RecvMsg(&providerv1.ConsolidateSnapshotsRequest{}); err != nil {
+				return err
+			}
+			ran = true
+			return nil
+		})
+	assert.False(t, ran)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "got %v", err)
+}
+
+func TestVMLocksCoverGuestCommandsAndConsolidation(t *testing.T) {
+	for _, method := range []string{"ExecInGuest", "SetGuestHostname", "ConsolidateSnapshots", "Migrate", "Clone"} {
+		assert.True(t, vmLockedMethods[method], "%s is not locked per VM", method)
+	}
+}
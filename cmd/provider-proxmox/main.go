@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log/slog"
 	"os"
 
@@ -31,7 +32,7 @@ import (
 func main() {
 	// Handle --version flag before any other flag parsing
 	if len(os.Args) > 1 && os.Args[1] == "--version" {
-		println("proxmox-provider", version.String())
+		fmt.Printf("virtrigaud-provider-proxmox %s\n", version.String())
 		os.Exit(0)
 	}
 
@@ -88,8 +89,10 @@ func main() {
 		"version", version.String(),
 		"log_level", getLogLevel().String(),
 		"log_format", logFormat,
+		"port", port,
+		"health_port", healthPort,
 		"capabilities", []string{
-			"core", "snapshots", "memory-snapshots", "linked-clones",
+			"core", "snapshots", "memory-snapshots", "clone", "linked-clones",
 			"online-reconfigure", "online-disk-expansion", "image-import",
 		},
 		"supported_disk_types", []string{"raw", "qcow2"},
@@ -802,12 +802,14 @@ func (r *VirtualMachineReconciler) buildCreateRequest(
 			"cluster", vm.Spec.Placement.Cluster,
 			"datastore", vm.Spec.Placement.Datastore,
 			"storagePod", vm.Spec.Placement.StoragePod,
-			"folder", vm.Spec.Placement.Folder)
+			"folder", vm.Spec.Placement.Folder,
+			"host", vm.Spec.Placement.Host)
 		placement = &contracts.Placement{
 			Datastore:  vm.Spec.Placement.Datastore,
 			StoragePod: vm.Spec.Placement.StoragePod,
 			Cluster:    vm.Spec.Placement.Cluster,
 			Folder:     vm.Spec.Placement.Folder,
+			Host:       vm.Spec.Placement.Host,
 		}
 	} else {
 		log.Info("No placement specified in VM spec", "vm", vm.Name)
@@ -19,6 +19,7 @@ package logging
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"regexp"
 	"strconv"
@@ -50,8 +51,14 @@ const (
 	TaskRefKey ContextKey = "taskRef"
 	// ReconcileKey is the context key for reconcile loop ID
 	ReconcileKey ContextKey = "reconcile"
+	// SlogKey is the context key for the request-scoped slog logger
+	SlogKey ContextKey = "slog"
 )
 
+// RequestIDMetadataKey is the gRPC metadata key that carries the correlation ID of a
+// request between the manager and providers
+const RequestIDMetadataKey = "x-virtrigaud-request-id"
+
 // Config holds logging configuration
 type Config struct {
 	Level        string
@@ -179,6 +186,25 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, TraceIDKey, traceID)
 }
 
+// CorrelationID returns the correlation ID of a context, or "" when it has none
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(CorrelationIDKey).(string)
+	return id
+}
+
+// WithSlogger attaches a request-scoped slog logger to context
+func WithSlogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, SlogKey, logger)
+}
+
+// Slogger returns the request-scoped slog logger of a context, or the default logger
+func Slogger(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(SlogKey).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
 // enrichLogger adds correlation fields from context to logger
 func enrichLogger(ctx context.Context, logger logr.Logger) logr.Logger {
 	fields := make([]interface{}, 0, 14) // Pre-allocate for typical usage
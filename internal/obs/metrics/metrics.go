@@ -88,6 +88,39 @@ var (
 		[]string{"provider_type", "method"},
 	)
 
+	providerRPCDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "virtrigaud_provider_rpc_duration_seconds",
+			Help:    "Duration of provider RPCs served by provider type, method, and code",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 15), // 1ms to ~32s
+		},
+		[]string{"provider_type", "method", "code"},
+	)
+
+	providerConnectionsActive = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "virtrigaud_provider_connections_active",
+			Help: "Number of active hypervisor connections by provider type",
+		},
+		[]string{"provider_type"},
+	)
+
+	providerConnectionPool = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "virtrigaud_provider_connection_pool_connections",
+			Help: "Number of pooled hypervisor connections by provider type and state (in_use, idle)",
+		},
+		[]string{"provider_type", "state"},
+	)
+
+	providerReconnectTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "virtrigaud_provider_reconnect_total",
+			Help: "Total number of hypervisor reconnects by provider type and reason (error, closed)",
+		},
+		[]string{"provider_type", "reason"},
+	)
+
 	// Provider task metrics
 	providerTasksInflight = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -97,6 +130,40 @@ var (
 		[]string{"provider_type", "provider"},
 	)
 
+	// Provider concurrency limiter metrics
+	providerOpsInflight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "virtrigaud_provider_ops_inflight",
+			Help: "Number of rate-limited provider operations holding a concurrency slot by provider type",
+		},
+		[]string{"provider_type"},
+	)
+
+	providerOpsQueued = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "virtrigaud_provider_ops_queued",
+			Help: "Number of provider operations waiting for a concurrency slot by provider type",
+		},
+		[]string{"provider_type"},
+	)
+
+	providerOpsRejectedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "virtrigaud_provider_ops_rejected_total",
+			Help: "Total number of provider operations rejected because the wait queue was full, by provider type and operation",
+		},
+		[]string{"provider_type", "operation"},
+	)
+
+	providerVMLockWait = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "virtrigaud_provider_vm_lock_wait_seconds",
+			Help:    "Time provider operations waited for the per-VM lock by provider type, method, and outcome (acquired, contended)",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 15), // 1ms to ~32s
+		},
+		[]string{"provider_type", "method", "outcome"},
+	)
+
 	// Error metrics
 	errorsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -224,6 +291,33 @@ func (m *ProviderRPCMetrics) RecordRPC(method, code string, duration time.Durati
 	providerRPCLatency.WithLabelValues(m.providerType, method).Observe(duration.Seconds())
 }
 
+// RecordServedRPC records an RPC handled by a provider server
+func (m *ProviderRPCMetrics) RecordServedRPC(method, code string, duration time.Duration) {
+	m.RecordRPC(method, code, duration)
+	providerRPCDuration.WithLabelValues(m.providerType, method, code).Observe(duration.Seconds())
+}
+
+// IncActiveConnections increments the active hypervisor connection gauge
+func (m *ProviderRPCMetrics) IncActiveConnections() {
+	providerConnectionsActive.WithLabelValues(m.providerType).Inc()
+}
+
+// DecActiveConnections decrements the active hypervisor connection gauge
+func (m *ProviderRPCMetrics) DecActiveConnections() {
+	providerConnectionsActive.WithLabelValues(m.providerType).Dec()
+}
+
+// SetPoolConnections records the in-use and idle pooled connection counts
+func (m *ProviderRPCMetrics) SetPoolConnections(inUse, idle int) {
+	providerConnectionPool.WithLabelValues(m.providerType, "in_use").Set(float64(inUse))
+	providerConnectionPool.WithLabelValues(m.providerType, "idle").Set(float64(idle))
+}
+
+// RecordReconnect counts a hypervisor reconnect
+func (m *ProviderRPCMetrics) RecordReconnect(reason string) {
+	providerReconnectTotal.WithLabelValues(m.providerType, reason).Inc()
+}
+
 // TaskMetrics provides metrics for provider tasks
 type TaskMetrics struct {
 	providerType string
@@ -243,6 +337,36 @@ func (m *TaskMetrics) SetInflightTasks(count float64) {
 	providerTasksInflight.WithLabelValues(m.providerType, m.provider).Set(count)
 }
 
+// ConcurrencyMetrics provides metrics for a provider's concurrency limiter
+type ConcurrencyMetrics struct {
+	providerType string
+}
+
+// NewConcurrencyMetrics creates metrics for a provider's concurrency limiter
+func NewConcurrencyMetrics(providerType string) *ConcurrencyMetrics {
+	return &ConcurrencyMetrics{providerType: providerType}
+}
+
+// SetInflight sets the number of operations holding a concurrency slot
+func (m *ConcurrencyMetrics) SetInflight(count float64) {
+	providerOpsInflight.WithLabelValues(m.providerType).Set(count)
+}
+
+// SetQueued sets the number of operations waiting for a concurrency slot
+func (m *ConcurrencyMetrics) SetQueued(count float64) {
+	providerOpsQueued.WithLabelValues(m.providerType).Set(count)
+}
+
+// RecordRejected records an operation rejected because the wait queue was full
+func (m *ConcurrencyMetrics) RecordRejected(operation string) {
+	providerOpsRejectedTotal.WithLabelValues(m.providerType, operation).Inc()
+}
+
+// RecordLockWait records how long an operation waited for its per-VM lock
+func (m *ConcurrencyMetrics) RecordLockWait(method, outcome string, wait time.Duration) {
+	providerVMLockWait.WithLabelValues(m.providerType, method, outcome).Observe(wait.Seconds())
+}
+
 // RecordError records an error with its reason and component
 func RecordError(reason, component string) {
 	errorsTotal.WithLabelValues(reason, component).Inc()
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -65,6 +66,21 @@ func DefaultConfig(serviceName, version string) *Config {
 	}
 }
 
+// OTLPConfigFromEnv returns a tracing configuration driven by the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT variable. Tracing is disabled when it is unset.
+func OTLPConfigFromEnv(serviceName, version string) *Config {
+	config := DefaultConfig(serviceName, version)
+
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	config.Enabled = endpoint != ""
+	if strings.HasPrefix(endpoint, "https://") {
+		config.InsecureTransport = false
+	}
+	config.Endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
+
+	return config
+}
+
 // Setup initializes OpenTelemetry tracing
 func Setup(ctx context.Context, config *Config) (func(), error) {
 	if !config.Enabled {
@@ -107,7 +123,7 @@ func Setup(ctx context.Context, config *Config) (func(), error) {
 	tp := trace.NewTracerProvider(
 		trace.WithBatcher(exporter),
 		trace.WithResource(res),
-		trace.WithSampler(trace.TraceIDRatioBased(config.SamplingRatio)),
+		trace.WithSampler(trace.ParentBased(trace.TraceIDRatioBased(config.SamplingRatio))),
 	)
 
 	// Set global otracer provider
@@ -298,6 +314,33 @@ func GRPCServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// GRPCServerAttributesInterceptor annotates the active server span with the
+// provider type and the VM referenced by the request, if any.
+func GRPCServerAttributesInterceptor(providerType string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		span := otrace.SpanFromContext(ctx)
+		if span.IsRecording() {
+			span.SetAttributes(AttrProviderType.String(providerType))
+			if r, ok := req.(interface{ GetName() string }); ok && r.GetName() != "" {
+				span.SetAttributes(AttrVMName.String(r.GetName()))
+			}
+			if r, ok := req.(interface{ GetId() string }); ok && r.GetId() != "" {
+				span.SetAttributes(AttrVMID.String(r.GetId()))
+			}
+			if r, ok := req.(interface{ GetVmId() string }); ok && r.GetVmId() != "" {
+				span.SetAttributes(AttrVMID.String(r.GetVmId()))
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
 // InjectGRPCContext injects tracing context into gRPC metadata
 func InjectGRPCContext(ctx context.Context) context.Context {
 	// This would typically use otel's gRPC instrumentation
@@ -44,6 +44,8 @@ const (
 	ErrorTypeQuotaExceeded ErrorType = "QuotaExceeded"
 	// ErrorTypeConflict indicates resource conflict
 	ErrorTypeConflict ErrorType = "Conflict"
+	// ErrorTypeInvalidState indicates the resource is not in a state that allows the operation
+	ErrorTypeInvalidState ErrorType = "InvalidState"
 )
 
 // ProviderError represents a categorized error from a provider
@@ -167,6 +169,16 @@ func NewQuotaExceededError(message string, cause error) *ProviderError {
 	}
 }
 
+// NewInvalidStateError creates an invalid state error
+func NewInvalidStateError(message string, cause error) *ProviderError {
+	return &ProviderError{
+		Type:      ErrorTypeInvalidState,
+		Message:   message,
+		Cause:     cause,
+		Retryable: false,
+	}
+}
+
 // NewConflictError creates a conflict error
 func NewConflictError(message string, cause error) *ProviderError {
 	return &ProviderError{
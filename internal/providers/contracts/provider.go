@@ -50,10 +50,57 @@ type CreateRequest struct {
 	UserData *UserData
 	// MetaData contains cloud-init metadata configuration
 	MetaData *MetaData
+	// NetworkConfig contains cloud-init network configuration
+	NetworkConfig *NetworkConfig
 	// Placement provides placement hints
 	Placement *Placement
 	// Tags are applied to the VM
 	Tags []string
+	// VMMetadata is key/value metadata persisted with the VM (keys are DNS labels)
+	VMMetadata map[string]string
+	// UUID is an optional client-supplied VM identifier; Create is idempotent by name and UUID
+	UUID string
+	// HostDevices are host devices passed through to the VM
+	HostDevices []HostDevice
+	// RNG configures the paravirtual random number generator; nil attaches one without a rate limit
+	RNG *RNGDevice
+	// Boot configures the boot device order and boot menu
+	Boot *BootConfig
+	// Graphics configures the video adapter and remote display; nil runs headless with
+	// only the serial console
+	Graphics *GraphicsConfig
+	// Inputs lists the keyboards and pointers of the VM; nil adds a USB tablet when a
+	// display is exposed and an empty list adds none
+	Inputs []InputDevice
+	// Watchdog attaches a watchdog device that acts when the guest stops servicing it
+	Watchdog *WatchdogDevice
+	// OnCrash is what happens when the guest panics (restart, preserve for forensics, or
+	// destroy); setting it attaches a panic device so that the crash is seen. Empty destroys.
+	OnCrash string
+	// DiskThrottles cap the I/O of disks by target. On Reconfigure they are applied live
+	// without a reboot and replace the limits of the listed disks; nil leaves them unchanged.
+	DiskThrottles []DiskThrottle
+	// Ephemeral runs the VM as a transient domain that is started on creation and removed
+	// together with the storage created for it when it stops; no definition is kept
+	Ephemeral bool
+	// Clock configures the guest clock offset and timers; nil keeps a UTC clock without HPET
+	Clock *ClockConfig
+	// OSType (linux, windows) or OSVariant (an osinfo short ID such as ubuntu22.04 or win11)
+	// picks defaults for the disk bus, NIC model, clock and video model; OSType wins if both are set
+	OSType    string
+	OSVariant string
+	// SerialLog captures the serial console output in a log file on the host
+	SerialLog bool
+	// StoragePool is the name or host path of the pool of disks that do not name one;
+	// empty uses the configured pool
+	StoragePool string
+	// QemuArgs are passed straight to the qemu command line of providers that allow it
+	QemuArgs []string
+	// AffinityGroups and AntiAffinityGroups name the groups an external scheduler packs
+	// together or spreads apart (group names are DNS labels). They are persisted with the VM
+	// but not enforced; on Reconfigure, setting either replaces both and nil leaves them unchanged.
+	AffinityGroups     []string
+	AntiAffinityGroups []string
 }
 
 // CreateResponse contains the result of a create operation
@@ -62,6 +109,10 @@ type CreateResponse struct {
 	ID string
 	// TaskRef references an async operation if applicable
 	TaskRef string
+	// AlreadyExists reports that a VM matching the request already existed and was returned unchanged
+	AlreadyExists bool
+	// Host is the host the VM was created on, for providers that manage several
+	Host string
 }
 
 // DescribeResponse contains the current state of a VM
@@ -76,6 +127,68 @@ type DescribeResponse struct {
 	ConsoleURL string
 	// ProviderRaw contains provider-specific details
 	ProviderRaw map[string]string
+	// VMMetadata is the key/value metadata persisted with the VM
+	VMMetadata map[string]string
+	// BootOrder lists the boot devices in the order they are tried (disk, cdrom, network)
+	BootOrder []string
+	// Firmware is the firmware type (bios, uefi)
+	Firmware string
+	// SecureBoot reports whether UEFI secure boot is enabled
+	SecureBoot bool
+	// GuestInterfaces lists the addresses of each guest network interface
+	GuestInterfaces []GuestInterface
+	// HasManagedSave reports whether the next power-on restores a saved memory state
+	HasManagedSave bool
+	// ManagedSaveSizeBytes is the size of the saved memory state
+	ManagedSaveSizeBytes int64
+	// WatchdogTriggered reports that the watchdog fired and paused the VM
+	WatchdogTriggered bool
+	// Crashed reports that the guest crashed and was preserved or destroyed, with the
+	// reason libvirt gives for the domain state (e.g. panicked)
+	Crashed     bool
+	CrashReason string
+	// GuestHostname is the hostname reported by the guest agent; empty while the agent is unavailable
+	GuestHostname string
+	// DiskThrottles are the I/O limits in effect on throttled disks
+	DiskThrottles []DiskThrottle
+	// Ephemeral reports a transient VM that disappears when it stops
+	Ephemeral bool
+	// Disks reports the capacity and actual allocation of each disk
+	Disks []DiskAllocation
+	// SpecHash is a stable hash of the provider-relevant parts of the VM definition;
+	// it changes when the definition is edited out of band. Empty if unsupported.
+	SpecHash string
+	// AffinityGroups and AntiAffinityGroups are the scheduling groups persisted with the VM
+	AffinityGroups     []string
+	AntiAffinityGroups []string
+}
+
+// DiskAllocation describes how much of a disk's capacity is allocated on the host
+type DiskAllocation struct {
+	// Target is the guest device name (vda, sdb, ...)
+	Target string
+	// Path is the host path of the volume or block device
+	Path string
+	// CapacityBytes is the size the guest sees
+	CapacityBytes int64
+	// AllocationBytes is the space written or reserved on the host
+	AllocationBytes int64
+	// PhysicalBytes is the size of the volume on the host, including image metadata
+	PhysicalBytes int64
+}
+
+// GuestInterface describes the addresses of a guest network interface
+type GuestInterface struct {
+	// Name is the interface name (guest name from the agent, host tap device from leases)
+	Name string
+	// MAC is the MAC address
+	MAC string
+	// Addresses are the IP addresses in CIDR notation
+	Addresses []string
+	// Source is where the addresses were read from (agent, lease)
+	Source string
+	// Provisional marks addresses that were not confirmed by the guest agent
+	Provisional bool
 }
 
 // Provider defines the interface that all providers must implement
@@ -136,6 +249,13 @@ type Provider interface {
 	ListVMs(ctx context.Context) ([]VMInfo, error)
 }
 
+// HealthChecker is implemented by providers that can probe their backend
+// connection. It is used to drive readiness probes and should be cheap.
+type HealthChecker interface {
+	// CheckHealth returns an error if the hypervisor connection is unusable
+	CheckHealth(ctx context.Context) error
+}
+
 // VMInfo contains basic information about a VM for discovery
 type VMInfo struct {
 	// ID is the provider-specific VM identifier
@@ -36,6 +36,94 @@ type VMClass struct {
 	SecurityProfile *SecurityProfile
 	// ResourceLimits defines resource limits and reservations
 	ResourceLimits *ResourceLimits
+	// CPUTopology specifies how vCPUs are arranged into sockets, cores and threads
+	CPUTopology *CPUTopology
+	// CPUModel selects the CPU model presented to the guest
+	CPUModel *CPUModel
+	// NUMACells lays out the guest NUMA topology; cell memory must sum to MemoryMiB
+	NUMACells []NUMACell
+	// HugepageSizeKiB backs guest memory with hugepages of this size (e.g. 2048, 1048576)
+	HugepageSizeKiB int64
+	// CPUTune sets the CPU scheduler parameters and vCPU pinning of the VM
+	CPUTune *CPUTune
+	// BlkioTune sets the block I/O weight and per-device IOPS limits of the VM
+	BlkioTune *BlkioTune
+	// NUMATune binds the VM's memory to host NUMA nodes
+	NUMATune *NUMATune
+}
+
+// CPUTune defines the CPU scheduler parameters of a VM; zero values keep the host default
+type CPUTune struct {
+	// Shares is the CPU weight of the VM relative to other VMs on the host (2-262144)
+	Shares int64
+	// PeriodMicros is the enforcement period of the quota in microseconds (1000-1000000)
+	PeriodMicros int64
+	// QuotaMicros is the CPU time each vCPU may use per period in microseconds (at least 1000; -1 is unlimited)
+	QuotaMicros int64
+	// VCPUPins pins vCPUs to host CPUs
+	VCPUPins []VCPUPin
+	// EmulatorPin restricts the QEMU emulator threads to a host CPU set (e.g. "0-1")
+	EmulatorPin string
+}
+
+// NUMATune defines the host NUMA memory policy of a VM
+type NUMATune struct {
+	// Mode is strict (default), preferred, interleave or restrictive
+	Mode string
+	// Nodeset is the host NUMA node set in cpuset syntax (e.g. "0" or "0-1")
+	Nodeset string
+}
+
+// VCPUPin restricts one vCPU to a set of host CPUs
+type VCPUPin struct {
+	// VCPU is the vCPU number
+	VCPU int32
+	// CPUSet is the host CPU set in cpuset syntax (e.g. "2-3,6")
+	CPUSet string
+}
+
+// BlkioTune defines the block I/O cgroup settings of a VM
+type BlkioTune struct {
+	// Weight is the I/O weight of the VM relative to other VMs on the host (100-1000)
+	Weight int32
+	// Devices throttles I/O to individual host block devices
+	Devices []BlkioDeviceTune
+}
+
+// BlkioDeviceTune caps the IOPS a VM may issue to one host block device
+type BlkioDeviceTune struct {
+	// Path is the host block device (e.g. /dev/sda)
+	Path string
+	// ReadIOPS caps read operations per second; 0 is unlimited
+	ReadIOPS int64
+	// WriteIOPS caps write operations per second; 0 is unlimited
+	WriteIOPS int64
+}
+
+// NUMACell describes one guest NUMA node
+type NUMACell struct {
+	// CPUs is the vCPU set of the cell in cpuset syntax (e.g. "0-3,6")
+	CPUs string
+	// MemoryMiB is the memory of the cell
+	MemoryMiB int64
+}
+
+// CPUTopology defines the guest CPU topology; the product must equal the vCPU count
+type CPUTopology struct {
+	// Sockets is the number of CPU sockets
+	Sockets int32
+	// Cores is the number of cores per socket
+	Cores int32
+	// Threads is the number of threads per core
+	Threads int32
+}
+
+// CPUModel defines the CPU model presented to the guest
+type CPUModel struct {
+	// Mode is host-passthrough, host-model or custom (a named model)
+	Mode string
+	// Name is the named CPU model for custom mode (e.g. Skylake-Server)
+	Name string
 }
 
 // VMImage defines the base template/image (provider-agnostic)
@@ -64,6 +152,14 @@ type NetworkAttachment struct {
 	NetworkName string
 	// Bridge for bridge networks
 	Bridge string
+	// SourceType selects how a libvirt interface attaches: network, bridge, direct (macvtap)
+	// or hostdev (SR-IOV VF). Empty infers network or bridge from NetworkName and Bridge.
+	SourceType string
+	// SourceDevice is the host NIC of a direct attachment (e.g. eth0) or the PCI address
+	// of the virtual function of a hostdev attachment (e.g. 0000:03:10.2)
+	SourceDevice string
+	// DirectMode is the macvtap mode of a direct attachment: vepa (default), bridge or private
+	DirectMode string
 	// VLAN ID if applicable
 	VLAN int32
 	// Model specifies network device model
@@ -83,6 +179,26 @@ type NetworkAttachment struct {
 	// PCISlotNumber specifies the PCI slot for predictable interface naming (vSphere)
 	// Common values: 192 for ens192, 224 for ens224, 256 for ens256
 	PCISlotNumber *int32
+	// Bandwidth specifies traffic shaping for the interface
+	Bandwidth *NetworkBandwidth
+}
+
+// NetworkBandwidth defines per-direction traffic shaping for an interface
+type NetworkBandwidth struct {
+	// Inbound limits traffic received by the VM
+	Inbound *BandwidthLimit
+	// Outbound limits traffic sent by the VM
+	Outbound *BandwidthLimit
+}
+
+// BandwidthLimit defines a traffic shaping rule
+type BandwidthLimit struct {
+	// AverageKiBps is the average rate in KiB/s
+	AverageKiBps int64
+	// PeakKiBps is the maximum rate in KiB/s while bursting (0 = no peak limit)
+	PeakKiBps int64
+	// BurstKiB is the amount of data in KiB that may be sent at peak rate
+	BurstKiB int64
 }
 
 // DiskSpec defines disk requirements (provider-agnostic)
@@ -93,6 +209,125 @@ type DiskSpec struct {
 	Type string
 	// Name provides a name for the disk
 	Name string
+	// Bus specifies the disk bus (virtio, scsi, sata)
+	Bus string
+	// CacheMode specifies the host cache mode (none, writeback, writethrough, directsync, unsafe)
+	CacheMode string
+	// IOMode specifies the I/O mode (native, threads)
+	IOMode string
+	// Discard specifies whether guest discard requests are passed down (unmap, ignore)
+	Discard string
+	// Format is the volume format (qcow2, raw); empty means qcow2
+	Format string
+	// Pool is the name or host path of the storage pool; empty uses the pool of the request
+	Pool string
+	// SourceImage is the image (URL, host path or template name) the disk is created from;
+	// empty creates an empty disk
+	SourceImage string
+	// SourceFormat is the format of SourceImage (qcow2, raw, vmdk, vdi, vhdx, vpc); empty detects it.
+	// Images in another format than Format are converted when the disk is created.
+	SourceFormat string
+	// AllocationPolicy is how the volume of a created disk is allocated: thin (on write),
+	// thick (fully preallocated) or falloc (reserved with fallocate); empty leaves it to the pool
+	AllocationPolicy string
+	// Target is the guest device name (vda, sdb, ...); empty assigns the next free name on the bus
+	Target string
+	// Volume attaches the existing volume of this name in Pool instead of creating a disk
+	Volume string
+	// Path attaches an existing block device or image file instead of creating a disk
+	Path string
+	// Shareable allows the disk to be attached to several VMs at once (clustered filesystems);
+	// the host cache is bypassed so that every VM sees the same data
+	Shareable bool
+}
+
+// HostDevice is a host device passed through to a VM
+type HostDevice struct {
+	// Type is the device bus (pci, usb); empty means pci
+	Type string
+	// Address is the PCI address of the device (domain:bus:slot.function, e.g. 0000:65:00.0)
+	// or the USB bus and device number (bus:device, e.g. 1:4)
+	Address string
+	// VendorID and ProductID select a USB device by its hexadecimal IDs (e.g. 046d and c52b)
+	// instead of its address, which changes whenever the device is replugged
+	VendorID  string
+	ProductID string
+}
+
+// RNGDevice configures the paravirtual random number generator that feeds host entropy to a VM
+type RNGDevice struct {
+	// Disabled omits the device
+	Disabled bool
+	// RateBytes limits the entropy a VM may read per period (0 = unlimited)
+	RateBytes int64
+	// RatePeriodMs is the rate limit period in milliseconds (default 1000)
+	RatePeriodMs int64
+}
+
+// GraphicsConfig defines the video adapter of a VM and how its display is exposed
+type GraphicsConfig struct {
+	// VideoModel is the video adapter (cirrus, qxl, virtio, none); empty picks cirrus for
+	// VNC, qxl for SPICE and none without a display
+	VideoModel string
+	// VRAMKiB is the video memory of cirrus and qxl adapters (0 = default)
+	VRAMKiB int32
+	// Display exposes the console over vnc or spice; empty leaves the VM headless
+	Display string
+	// Password protects the display (VNC passwords are at most 8 characters)
+	Password string
+}
+
+// InputDevice defines a keyboard or pointer of a VM
+type InputDevice struct {
+	// Type is tablet, keyboard or mouse
+	Type string
+	// Bus is usb (default) or virtio
+	Bus string
+}
+
+// WatchdogDevice defines an emulated watchdog that acts when a hung guest stops servicing it
+type WatchdogDevice struct {
+	// Model is i6300esb (default) or ib700
+	Model string
+	// Action is reset (default), poweroff, pause or none
+	Action string
+}
+
+// DiskThrottle caps the I/O of one guest disk; 0 leaves a limit unset. A total limit
+// cannot be combined with the read or write limit of the same kind.
+type DiskThrottle struct {
+	// Target is the guest device name of the disk (vda, sdb, ...)
+	Target string
+	// TotalBytesPerSec, ReadBytesPerSec and WriteBytesPerSec cap the throughput
+	TotalBytesPerSec int64
+	ReadBytesPerSec  int64
+	WriteBytesPerSec int64
+	// TotalIOPS, ReadIOPS and WriteIOPS cap the operations per second
+	TotalIOPS int64
+	ReadIOPS  int64
+	WriteIOPS int64
+}
+
+// ClockConfig defines the guest clock and the timers QEMU emulates
+type ClockConfig struct {
+	// Offset is utc (default) or localtime, which Windows guests expect
+	Offset string
+	// HyperVClock exposes the Hyper-V reference clock to Windows guests
+	HyperVClock bool
+	// HPET enables the high precision event timer (off by default)
+	HPET bool
+	// PITTickPolicy and RTCTickPolicy set how missed ticks are delivered (delay, catchup,
+	// merge, discard); they default to delay and catchup
+	PITTickPolicy string
+	RTCTickPolicy string
+}
+
+// BootConfig defines the boot devices of a VM and the firmware boot menu
+type BootConfig struct {
+	// Order lists the boot devices in the order they are tried (disk, cdrom, network)
+	Order []string
+	// MenuTimeoutMs shows the boot menu for this long before booting (0 = no menu)
+	MenuTimeoutMs int32
 }
 
 // DiskDefaults provides default disk settings
@@ -117,6 +352,12 @@ type MetaData struct {
 	MetaDataYAML string
 }
 
+// NetworkConfig contains cloud-init network configuration
+type NetworkConfig struct {
+	// NetworkConfigYAML contains the NoCloud network-config document (version 1 or 2)
+	NetworkConfigYAML string
+}
+
 // Placement provides VM placement hints
 type Placement struct {
 	// Datastore specifies preferred datastore
@@ -149,6 +390,8 @@ type TaskStatus struct {
 	Error string
 	// Message contains status message
 	Message string
+	// ProgressPercent is the task progress (0-100) if known
+	ProgressPercent int32
 }
 
 // SnapshotCreateRequest defines snapshot creation request
@@ -315,6 +558,9 @@ type SecurityProfile struct {
 	TPMEnabled bool
 	// TPMVersion specifies the TPM version
 	TPMVersion string
+	// TPMPersistentState keeps the emulated TPM state while the domain is powered off,
+	// even for transient domains; the state is always removed when the VM is deleted
+	TPMPersistentState bool
 	// VTDEnabled enables Intel VT-d or AMD-Vi
 	VTDEnabled bool
 	// EncryptionEnabled indicates if encryption should be used
@@ -0,0 +1,641 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/internal/version"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+const (
+	// ProviderType is reported by GetCapabilities
+	ProviderType = "fake"
+
+	// defaultConsoleLogBytes and maxConsoleLogBytes bound GetConsoleLog like the libvirt provider
+	defaultConsoleLogBytes = 64 << 10
+	maxConsoleLogBytes     = 1 << 20
+
+	// watchBuffer is how many events a slow WatchEvents client may fall behind by
+	watchBuffer = 64
+)
+
+// capabilities lists the optional features the fake supports
+var capabilities = []string{
+	"core", "snapshots", "linked-clones", "online-reconfigure", "qemu-guest-agent", "migration",
+	"console", "external-snapshots", "events", "garbage-collection", "guest-exec", "operation-progress",
+	"guest-hostname", "ephemeral-vms", "debug-rpcs",
+}
+
+// watcher is a WatchEvents subscription; an empty vmIDs watches every VM
+type watcher struct {
+	vmIDs  map[string]bool
+	events chan *providerv1.DomainEvent
+}
+
+// ImagePrepare accepts any image with a target name; there is nothing to download
+func (p *Provider) ImagePrepare(ctx context.Context, req *providerv1.ImagePrepareRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "ImagePrepare"); err != nil {
+		return nil, err
+	}
+	if req.TargetName == "" {
+		return nil, invalidSpec("target name is required")
+	}
+	if req.ImageJson != "" {
+		var image contracts.VMImage
+		if err := json.Unmarshal([]byte(req.ImageJson), &image); err != nil {
+			return nil, invalidSpec("invalid image: %v", err)
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// GetCapabilities reports the features of the fake
+func (p *Provider) GetCapabilities(ctx context.Context, req *providerv1.GetCapabilitiesRequest) (*providerv1.GetCapabilitiesResponse, error) {
+	if err := p.before(ctx, "GetCapabilities"); err != nil {
+		return nil, err
+	}
+	return &providerv1.GetCapabilitiesResponse{
+		SupportsReconfigureOnline:   true,
+		SupportsDiskExpansionOnline: true,
+		SupportsSnapshots:           true,
+		SupportsMemorySnapshots:     true,
+		SupportsLinkedClones:        true,
+		SupportsImageImport:         true,
+		SupportedDiskTypes:          []string{"qcow2", "raw"},
+		SupportedNetworkTypes:       []string{"network", "bridge"},
+		SupportsDiskExport:          true,
+		SupportsDiskImport:          true,
+		SupportedExportFormats:      []string{"qcow2", "raw"},
+		SupportedImportFormats:      []string{"qcow2", "raw"},
+		SupportedConsoleTypes:       []string{"serial"},
+		SupportedUserDataTypes:      []string{"cloud-init", "ignition"},
+		ProviderType:                ProviderType,
+		Version:                     version.String(),
+		Capabilities:                capabilities,
+		SupportedPlatforms:          []string{ProviderType},
+	}, nil
+}
+
+// diskFor returns the disk an export or info request names, defaulting to the root disk
+func (vm *virtualMachine) diskFor(id string) (*disk, error) {
+	if id == "" {
+		id = "vda"
+	}
+	for _, d := range vm.disks {
+		if !d.cdrom && (d.target == id || d.path == id) {
+			return d, nil
+		}
+	}
+	return nil, notFound("disk %s not found on domain %s", id, vm.name)
+}
+
+// checksum returns a stable stand-in for the SHA-256 of a disk's contents
+func checksum(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ExportDisk pretends to upload a disk, reporting its size and a stable checksum
+func (p *Provider) ExportDisk(ctx context.Context, req *providerv1.ExportDiskRequest) (*providerv1.ExportDiskResponse, error) {
+	if err := p.before(ctx, "ExportDisk"); err != nil {
+		return nil, err
+	}
+	if req.DestinationUrl == "" {
+		return nil, invalidSpec("destination URL is required")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vm.diskFor(req.DiskId)
+	if err != nil {
+		return nil, err
+	}
+	if req.SnapshotId != "" {
+		if _, ok := vm.findSnapshot(req.SnapshotId); !ok {
+			return nil, notFound("snapshot %s of domain %s not found", req.SnapshotId, vm.name)
+		}
+	}
+	return &providerv1.ExportDiskResponse{
+		ExportId:           p.newID("export"),
+		Task:               p.newTask(),
+		EstimatedSizeBytes: d.sizeBytes,
+		Checksum:           checksum(d.path, req.SnapshotId),
+	}, nil
+}
+
+// ImportDisk pretends to download a disk into the default pool
+func (p *Provider) ImportDisk(ctx context.Context, req *providerv1.ImportDiskRequest) (*providerv1.ImportDiskResponse, error) {
+	if err := p.before(ctx, "ImportDisk"); err != nil {
+		return nil, err
+	}
+	if req.SourceUrl == "" {
+		return nil, invalidSpec("source URL is required")
+	}
+	format := req.Format
+	if format == "" {
+		format = "qcow2"
+	}
+	name := req.TargetName
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(req.SourceUrl), path.Ext(req.SourceUrl))
+	}
+	sum := checksum(req.SourceUrl)
+	if req.VerifyChecksum && req.ExpectedChecksum != "" && req.ExpectedChecksum != sum {
+		return nil, invalidSpec("checksum mismatch: expected %s, got %s", req.ExpectedChecksum, sum)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &providerv1.ImportDiskResponse{
+		DiskId:          name,
+		Path:            filepath.Join("/var/lib/libvirt/images", name+"."+format),
+		Task:            p.newTask(),
+		ActualSizeBytes: defaultDiskGiB << 30,
+		Checksum:        sum,
+	}, nil
+}
+
+// GetDiskInfo describes a disk of a VM
+func (p *Provider) GetDiskInfo(ctx context.Context, req *providerv1.GetDiskInfoRequest) (*providerv1.GetDiskInfoResponse, error) {
+	if err := p.before(ctx, "GetDiskInfo"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vm.diskFor(req.DiskId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &providerv1.GetDiskInfoResponse{
+		DiskId:           d.target,
+		Format:           d.format,
+		VirtualSizeBytes: d.sizeBytes,
+		ActualSizeBytes:  d.sizeBytes / 10,
+		Path:             d.path,
+		IsBootable:       d.target == "vda",
+	}
+	for _, snap := range vm.snapshots {
+		resp.Snapshots = append(resp.Snapshots, snap.id)
+		for _, overlay := range snap.overlays {
+			if overlay.OverlayPath == d.path {
+				resp.BackingFile = overlay.BasePath
+			}
+		}
+	}
+	return resp, nil
+}
+
+// cdrom returns the CD-ROM drive at a target device
+func (vm *virtualMachine) cdrom(device string) (*disk, error) {
+	d, ok := vm.findDisk(device)
+	if !ok || !d.cdrom {
+		return nil, notFound("CD-ROM drive %s not found on domain %s", device, vm.name)
+	}
+	return d, nil
+}
+
+// InsertMedia loads an ISO into a CD-ROM drive
+func (p *Provider) InsertMedia(ctx context.Context, req *providerv1.InsertMediaRequest) (*providerv1.InsertMediaResponse, error) {
+	if err := p.before(ctx, "InsertMedia"); err != nil {
+		return nil, err
+	}
+	if !filepath.IsAbs(req.Source) {
+		return nil, invalidSpec("media source must be an absolute path, got %q", req.Source)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vm.cdrom(req.Device)
+	if err != nil {
+		return nil, err
+	}
+	d.media = req.Source
+	return &providerv1.InsertMediaResponse{}, nil
+}
+
+// EjectMedia empties a CD-ROM drive
+func (p *Provider) EjectMedia(ctx context.Context, req *providerv1.EjectMediaRequest) (*providerv1.EjectMediaResponse, error) {
+	if err := p.before(ctx, "EjectMedia"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	d, err := vm.cdrom(req.Device)
+	if err != nil {
+		return nil, err
+	}
+	ejected := d.media
+	d.media = ""
+	return &providerv1.EjectMediaResponse{Ejected: ejected}, nil
+}
+
+// GetCapacity reports the fake host and the resources of its running VMs
+func (p *Provider) GetCapacity(ctx context.Context, req *providerv1.GetCapacityRequest) (*providerv1.GetCapacityResponse, error) {
+	if err := p.before(ctx, "GetCapacity"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	resp := &providerv1.GetCapacityResponse{
+		Cpu:         &providerv1.ResourceCapacity{Total: p.host.cpus},
+		MemoryBytes: &providerv1.ResourceCapacity{Total: p.host.memoryBytes},
+		TotalVms:    int32(len(p.vms)),
+	}
+	var diskBytes int64
+	for _, vm := range p.vms {
+		for _, d := range vm.disks {
+			if !d.cdrom {
+				diskBytes += d.sizeBytes
+			}
+		}
+		if vm.state == stateShutoff {
+			continue
+		}
+		resp.RunningVms++
+		resp.Cpu.Allocated += int64(vm.cpus)
+		resp.MemoryBytes.Allocated += vm.memoryMiB << 20
+	}
+	// The fake does not overcommit, so its schedulable capacity is the physical one
+	for _, resource := range []*providerv1.ResourceCapacity{resp.Cpu, resp.MemoryBytes} {
+		resource.Available = max(resource.Total-resource.Allocated, 0)
+		resource.Schedulable = resource.Total
+		resource.SchedulableAvailable = resource.Available
+		resource.OvercommitRatio = 1
+		if resource.Total > 0 {
+			resource.CommitmentRatio = float64(resource.Allocated) / float64(resource.Total)
+		}
+	}
+	resp.StoragePools = []*providerv1.StoragePoolCapacity{{
+		Name:           "default",
+		CapacityBytes:  p.host.poolBytes,
+		AllocatedBytes: diskBytes,
+		AvailableBytes: max(p.host.poolBytes-diskBytes, 0),
+	}}
+	return resp, nil
+}
+
+// GetVMStats reports counters of a VM; I/O counters stay zero, CPU time grows while it runs
+func (p *Provider) GetVMStats(ctx context.Context, req *providerv1.GetVMStatsRequest) (*providerv1.GetVMStatsResponse, error) {
+	if err := p.before(ctx, "GetVMStats"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	now := p.now()
+	resp := &providerv1.GetVMStatsResponse{State: vm.state, TimestampUnixNano: now.UnixNano()}
+	if vm.state != stateRunning {
+		return resp, nil
+	}
+	resp.CpuTimeNs = int64(now.Sub(vm.started))
+	resp.Vcpus = vm.cpus
+	resp.MemoryActualBytes = vm.memoryMiB << 20
+	resp.MemoryRssBytes = vm.memoryMiB << 20
+	for _, d := range vm.disks {
+		path := d.path
+		if d.cdrom {
+			path = d.media
+		}
+		resp.Disks = append(resp.Disks, &providerv1.DiskStats{Device: d.target, Path: path})
+	}
+	for i := range vm.nics {
+		resp.Interfaces = append(resp.Interfaces, &providerv1.InterfaceStats{Name: "vnet" + string(rune('0'+i))})
+	}
+	return resp, nil
+}
+
+// ExecInGuest runs nothing: it succeeds for any command of a running VM, and "echo" prints its arguments
+func (p *Provider) ExecInGuest(ctx context.Context, req *providerv1.ExecInGuestRequest) (*providerv1.ExecInGuestResponse, error) {
+	if err := p.before(ctx, "ExecInGuest"); err != nil {
+		return nil, err
+	}
+	if !path.IsAbs(req.Path) {
+		return nil, invalidSpec("command path must be absolute, got %q", req.Path)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateRunning {
+		return nil, invalidState("domain %s is not running", vm.name)
+	}
+	resp := &providerv1.ExecInGuestResponse{}
+	if path.Base(req.Path) == "echo" {
+		resp.Stdout = []byte(strings.Join(req.Args, " ") + "\n")
+	}
+	return resp, nil
+}
+
+// GetGuestHostname returns the guest hostname; only running guests have an agent to answer
+func (p *Provider) GetGuestHostname(ctx context.Context, req *providerv1.GetGuestHostnameRequest) (*providerv1.GetGuestHostnameResponse, error) {
+	if err := p.before(ctx, "GetGuestHostname"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateRunning {
+		return nil, unavailable("domain %s is %s; its guest agent is unavailable", vm.name, vm.state)
+	}
+	return &providerv1.GetGuestHostnameResponse{Hostname: vm.guestHostname()}, nil
+}
+
+// SetGuestHostname changes the hostname the guest reports until the VM is deleted
+func (p *Provider) SetGuestHostname(ctx context.Context, req *providerv1.SetGuestHostnameRequest) (*providerv1.SetGuestHostnameResponse, error) {
+	if err := p.before(ctx, "SetGuestHostname"); err != nil {
+		return nil, err
+	}
+	if err := vmspec.ValidateHostname(req.Hostname); err != nil {
+		return nil, invalidSpec("%v", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateRunning {
+		return nil, unavailable("domain %s is %s; its guest agent is unavailable", vm.name, vm.state)
+	}
+	previous := vm.guestHostname()
+	vm.hostname = req.Hostname
+	return &providerv1.SetGuestHostnameResponse{PreviousHostname: previous}, nil
+}
+
+// GetRawXML renders a minimal domain definition; the fake keeps no separate persistent one
+func (p *Provider) GetRawXML(ctx context.Context, req *providerv1.GetRawXMLRequest) (*providerv1.GetRawXMLResponse, error) {
+	if err := p.before(ctx, "GetRawXML"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	return &providerv1.GetRawXMLResponse{Xml: fmt.Sprintf(
+		"<domain type='fake'>\n  <name>%s</name>\n  <uuid>%s</uuid>\n  <memory unit='MiB'>%d</memory>\n  <vcpu>%d</vcpu>\n</domain>\n",
+		vm.name, vm.uuid, vm.memoryMiB, vm.cpus)}, nil
+}
+
+// GetConsoleLog returns the end of the VM's console log, which records each boot
+func (p *Provider) GetConsoleLog(ctx context.Context, req *providerv1.GetConsoleLogRequest) (*providerv1.GetConsoleLogResponse, error) {
+	if err := p.before(ctx, "GetConsoleLog"); err != nil {
+		return nil, err
+	}
+	if req.MaxBytes < 0 {
+		return nil, invalidSpec("max_bytes must not be negative")
+	}
+	maxBytes := int64(defaultConsoleLogBytes)
+	if req.MaxBytes > 0 {
+		maxBytes = min(req.MaxBytes, maxConsoleLogBytes)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(len(vm.consoleLog))
+	start := max(size-maxBytes, 0)
+	return &providerv1.GetConsoleLogResponse{
+		Data:      append([]byte(nil), vm.consoleLog[start:]...),
+		Path:      filepath.Join("/var/log/libvirt/qemu", vm.name+"-serial.log"),
+		SizeBytes: size,
+		Truncated: start > 0,
+	}, nil
+}
+
+// OpenConsole opens a serial console to a running VM that echoes its input back
+func (p *Provider) OpenConsole(stream grpc.BidiStreamingServer[providerv1.ConsoleRequest, providerv1.ConsoleResponse]) error {
+	ctx := stream.Context()
+	if err := p.before(ctx, "OpenConsole"); err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	open := first.GetOpen()
+	if open == nil {
+		return invalidSpec("the first console message must open the session")
+	}
+	if open.Type != providerv1.ConsoleType_CONSOLE_TYPE_UNSPECIFIED && open.Type != providerv1.ConsoleType_CONSOLE_TYPE_SERIAL {
+		return Error(providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED, "console type %v is not supported", open.Type)
+	}
+
+	p.mu.Lock()
+	vm, err := p.lookup(open.VmId)
+	if err == nil && vm.state != stateRunning {
+		err = invalidState("domain %s is not running", vm.name)
+	}
+	var name string
+	if err == nil {
+		name = vm.name
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&providerv1.ConsoleResponse{Output: []byte("Connected to domain '" + name + "'\r\n")}); err != nil {
+		return err
+	}
+
+	inputs := make(chan []byte)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case inputs <- msg.GetInput():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var limit <-chan time.Time
+	if open.MaxSessionSeconds > 0 {
+		timer := time.NewTimer(time.Duration(open.MaxSessionSeconds) * time.Second)
+		defer timer.Stop()
+		limit = timer.C
+	}
+	for {
+		select {
+		case input := <-inputs:
+			if len(input) == 0 {
+				continue
+			}
+			if err := stream.Send(&providerv1.ConsoleResponse{Output: input}); err != nil {
+				return err
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return stream.Send(&providerv1.ConsoleResponse{Closed: true, Reason: "client closed the session"})
+			}
+			return err
+		case <-limit:
+			return stream.Send(&providerv1.ConsoleResponse{Closed: true, Reason: "session limit reached"})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// WatchOperation reports a task as completed; the fake finishes every operation synchronously
+func (p *Provider) WatchOperation(req *providerv1.WatchOperationRequest, stream grpc.ServerStreamingServer[providerv1.OperationProgress]) error {
+	if err := p.before(stream.Context(), "WatchOperation"); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	_, ok := p.tasks[req.OperationId]
+	p.mu.Unlock()
+	if !ok {
+		return notFound("operation %s not found", req.OperationId)
+	}
+	return stream.Send(&providerv1.OperationProgress{
+		OperationId:     req.OperationId,
+		State:           "completed",
+		ProgressPercent: 100,
+		Message:         "completed",
+	})
+}
+
+// WatchEvents replays the state of every watched VM, then streams lifecycle events
+func (p *Provider) WatchEvents(req *providerv1.WatchEventsRequest, stream grpc.ServerStreamingServer[providerv1.DomainEvent]) error {
+	ctx := stream.Context()
+	if err := p.before(ctx, "WatchEvents"); err != nil {
+		return err
+	}
+
+	w := &watcher{vmIDs: make(map[string]bool), events: make(chan *providerv1.DomainEvent, watchBuffer)}
+	p.mu.Lock()
+	var replay []*providerv1.DomainEvent
+	for _, id := range req.VmIds {
+		vm, err := p.lookup(id)
+		if err != nil {
+			p.mu.Unlock()
+			return err
+		}
+		w.vmIDs[vm.id] = true
+	}
+	for _, vm := range p.vms {
+		if len(w.vmIDs) > 0 && !w.vmIDs[vm.id] {
+			continue
+		}
+		replay = append(replay, &providerv1.DomainEvent{
+			VmId:              vm.id,
+			Type:              "state",
+			State:             vm.state,
+			Replay:            true,
+			TimestampUnixNano: p.now().UnixNano(),
+		})
+	}
+	p.nextWatch++
+	id := p.nextWatch
+	p.watchers[id] = w
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.watchers, id)
+		p.mu.Unlock()
+	}()
+
+	sort.Slice(replay, func(i, j int) bool { return replay[i].VmId < replay[j].VmId })
+	for _, event := range replay {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event := <-w.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// CollectGarbage finds nothing to remove: the fake never leaves artifacts behind
+func (p *Provider) CollectGarbage(ctx context.Context, req *providerv1.CollectGarbageRequest) (*providerv1.CollectGarbageResponse, error) {
+	if err := p.before(ctx, "CollectGarbage"); err != nil {
+		return nil, err
+	}
+	if req.MinAgeSeconds < 0 {
+		return nil, invalidSpec("min_age_seconds must not be negative")
+	}
+	return &providerv1.CollectGarbageResponse{}, nil
+}
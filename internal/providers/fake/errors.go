@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// Error returns a gRPC status carrying an ErrorDetail, the way the libvirt provider reports
+// failures. Injecting it with InjectFailure makes clients classify the failure like a real one.
+func Error(code providerv1.ErrorCode, format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	st := status.New(grpcCode(code), message)
+	withDetails, err := st.WithDetails(&providerv1.ErrorDetail{
+		Code:      code,
+		Message:   message,
+		Retryable: code == providerv1.ErrorCode_ERROR_CODE_TRANSIENT,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// grpcCode maps the provider error taxonomy to the gRPC status code the libvirt provider uses
+func grpcCode(code providerv1.ErrorCode) codes.Code {
+	switch code {
+	case providerv1.ErrorCode_ERROR_CODE_NOT_FOUND:
+		return codes.NotFound
+	case providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS:
+		return codes.AlreadyExists
+	case providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED:
+		return codes.ResourceExhausted
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC:
+		return codes.InvalidArgument
+	case providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED:
+		return codes.Unimplemented
+	case providerv1.ErrorCode_ERROR_CODE_TRANSIENT:
+		return codes.Unavailable
+	case providerv1.ErrorCode_ERROR_CODE_PERMISSION_DENIED:
+		return codes.PermissionDenied
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_STATE:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// notFound reports a missing VM, snapshot or device
+func notFound(format string, args ...any) error {
+	return Error(providerv1.ErrorCode_ERROR_CODE_NOT_FOUND, format, args...)
+}
+
+// invalidSpec reports a request that can never succeed
+func invalidSpec(format string, args ...any) error {
+	return Error(providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC, format, args...)
+}
+
+// unavailable reports a guest agent that cannot answer, like the libvirt provider does
+func unavailable(format string, args ...any) error {
+	return Error(providerv1.ErrorCode_ERROR_CODE_TRANSIENT, format, args...)
+}
+
+// invalidState reports a VM whose state does not allow the operation
+func invalidState(format string, args ...any) error {
+	return Error(providerv1.ErrorCode_ERROR_CODE_INVALID_STATE, format, args...)
+}
+
+// alreadyExists reports a name or UUID conflict
+func alreadyExists(format string, args ...any) error {
+	return Error(providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS, format, args...)
+}
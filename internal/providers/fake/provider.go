@@ -0,0 +1,243 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory provider that behaves like the libvirt provider
+// without a hypervisor. Every RPC completes synchronously, IDs are sequential and
+// latency and failures can be injected per RPC, so controller tests are deterministic.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// AllRPCs matches every RPC in SetLatency and InjectFailure; an RPC-specific setting wins
+const AllRPCs = "*"
+
+// Power states reported by Describe and ListVMs, as the libvirt provider reports them
+const (
+	powerOn  = "On"
+	powerOff = "Off"
+)
+
+// Domain states reported by GetVMStats, SuspendVM, ResumeVM and WatchEvents
+const (
+	stateRunning = "running"
+	statePaused  = "paused"
+	stateShutoff = "shut off"
+)
+
+var _ providerv1.ProviderServer = (*Provider)(nil)
+
+// Provider is an in-memory implementation of providerv1.ProviderServer
+type Provider struct {
+	providerv1.UnimplementedProviderServer
+
+	mu        sync.Mutex
+	vms       map[string]*virtualMachine
+	tasks     map[string]struct{}
+	nextID    int
+	latency   map[string]time.Duration
+	failures  map[string]*failure
+	watchers  map[int]*watcher
+	nextWatch int
+	now       func() time.Time
+	host      hostResources
+}
+
+// failure is an error injected into an RPC
+type failure struct {
+	err error
+	// remaining is how many more calls fail; negative fails until cleared
+	remaining int
+}
+
+// hostResources is the capacity GetCapacity reports
+type hostResources struct {
+	cpus        int64
+	memoryBytes int64
+	poolBytes   int64
+}
+
+// Option configures a Provider
+type Option func(*Provider)
+
+// WithLatency delays every RPC by d
+func WithLatency(d time.Duration) Option {
+	return func(p *Provider) {
+		p.latency[AllRPCs] = d
+	}
+}
+
+// WithClock replaces the clock used for creation times and event timestamps
+func WithClock(now func() time.Time) Option {
+	return func(p *Provider) {
+		p.now = now
+	}
+}
+
+// WithHostCapacity sets the CPUs, memory and storage pool size the fake host reports
+func WithHostCapacity(cpus, memoryBytes, poolBytes int64) Option {
+	return func(p *Provider) {
+		p.host = hostResources{cpus: cpus, memoryBytes: memoryBytes, poolBytes: poolBytes}
+	}
+}
+
+// NewProvider creates an empty fake provider
+func NewProvider(opts ...Option) *Provider {
+	p := &Provider{
+		vms:      make(map[string]*virtualMachine),
+		tasks:    make(map[string]struct{}),
+		latency:  make(map[string]time.Duration),
+		failures: make(map[string]*failure),
+		watchers: make(map[int]*watcher),
+		now:      time.Now,
+		host: hostResources{
+			cpus:        16,
+			memoryBytes: 64 << 30,
+			poolBytes:   1 << 40,
+		},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// SetLatency delays calls of an RPC (e.g. "Create", or AllRPCs) by d; zero removes the delay
+func (p *Provider) SetLatency(rpc string, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if d == 0 {
+		delete(p.latency, rpc)
+		return
+	}
+	p.latency[rpc] = d
+}
+
+// InjectFailure makes the next count calls of an RPC (or AllRPCs) return err before
+// touching any state. A count of zero or less fails every call until ClearFailures.
+func (p *Provider) InjectFailure(rpc string, err error, count int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if count <= 0 {
+		count = -1
+	}
+	p.failures[rpc] = &failure{err: err, remaining: count}
+}
+
+// ClearFailures removes every injected failure
+func (p *Provider) ClearFailures() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures = make(map[string]*failure)
+}
+
+// Reset removes every VM and task, keeping injected latency and failures
+func (p *Provider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.vms = make(map[string]*virtualMachine)
+	p.tasks = make(map[string]struct{})
+	p.nextID = 0
+}
+
+// before applies the latency and injected failure of an RPC; every RPC calls it first
+func (p *Provider) before(ctx context.Context, rpc string) error {
+	p.mu.Lock()
+	delay, ok := p.latency[rpc]
+	if !ok {
+		delay = p.latency[AllRPCs]
+	}
+	var err error
+	for _, key := range []string{rpc, AllRPCs} {
+		f, ok := p.failures[key]
+		if !ok {
+			continue
+		}
+		err = f.err
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				delete(p.failures, key)
+			}
+		}
+		break
+	}
+	p.mu.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return Error(providerv1.ErrorCode_ERROR_CODE_TRANSIENT, "%s canceled: %v", rpc, ctx.Err())
+		}
+	}
+	return err
+}
+
+// newID returns the next sequential ID with a prefix; the caller holds p.mu
+func (p *Provider) newID(prefix string) string {
+	p.nextID++
+	return fmt.Sprintf("%s-%d", prefix, p.nextID)
+}
+
+// newTask records a completed task; the caller holds p.mu
+func (p *Provider) newTask() *providerv1.TaskRef {
+	id := p.newID("task")
+	p.tasks[id] = struct{}{}
+	return &providerv1.TaskRef{Id: id}
+}
+
+// lookup returns a VM by ID or name; the caller holds p.mu
+func (p *Provider) lookup(id string) (*virtualMachine, error) {
+	if vm, ok := p.vms[id]; ok {
+		return vm, nil
+	}
+	for _, vm := range p.vms {
+		if vm.name == id || vm.uuid == id {
+			return vm, nil
+		}
+	}
+	return nil, notFound("domain %s not found", id)
+}
+
+// publish sends a lifecycle event to every watcher; the caller holds p.mu.
+// Watchers that fall behind miss events rather than blocking the RPC.
+func (p *Provider) publish(vm *virtualMachine, typ, detail string) {
+	event := &providerv1.DomainEvent{
+		VmId:              vm.id,
+		Type:              typ,
+		Detail:            detail,
+		State:             vm.state,
+		TimestampUnixNano: p.now().UnixNano(),
+	}
+	for _, w := range p.watchers {
+		if len(w.vmIDs) > 0 && !w.vmIDs[vm.id] {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+		}
+	}
+}
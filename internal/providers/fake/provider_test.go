@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+func TestFakeProvider_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	p := NewProvider()
+
+	created, err := p.Create(ctx, &providerv1.CreateRequest{Name: "web", ClassJson: `{"CPU":2,"MemoryMiB":2048}`})
+	require.NoError(t, err)
+	assert.Equal(t, "vm-1", created.Id)
+
+	again, err := p.Create(ctx, &providerv1.CreateRequest{Name: "web"})
+	require.NoError(t, err)
+	assert.True(t, again.AlreadyExists)
+	assert.Equal(t, created.Id, again.Id)
+
+	_, err = p.Power(ctx, &providerv1.PowerRequest{Id: created.Id, Op: providerv1.PowerOp_POWER_OP_ON})
+	require.NoError(t, err)
+
+	desc, err := p.Describe(ctx, &providerv1.DescribeRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.True(t, desc.Exists)
+	assert.Equal(t, powerOn, desc.PowerState)
+	assert.NotEmpty(t, desc.Ips)
+
+	_, err = p.Delete(ctx, &providerv1.DeleteRequest{Id: created.Id})
+	require.NoError(t, err)
+	desc, err = p.Describe(ctx, &providerv1.DescribeRequest{Id: created.Id})
+	require.NoError(t, err)
+	assert.False(t, desc.Exists)
+}
+
+func TestFakeProvider_InjectFailure(t *testing.T) {
+	ctx := context.Background()
+	p := NewProvider()
+	p.InjectFailure("Create", Error(providerv1.ErrorCode_ERROR_CODE_TRANSIENT, "injected"), 2)
+
+	for i := 0; i < 2; i++ {
+		_, err := p.Create(ctx, &providerv1.CreateRequest{Name: "web"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	_, err := p.Create(ctx, &providerv1.CreateRequest{Name: "web"})
+	require.NoError(t, err)
+
+	p.InjectFailure(AllRPCs, Error(providerv1.ErrorCode_ERROR_CODE_INTERNAL, "down"), 0)
+	_, err = p.ListVMs(ctx, &providerv1.ListVMsRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	p.ClearFailures()
+	_, err = p.ListVMs(ctx, &providerv1.ListVMsRequest{})
+	require.NoError(t, err)
+}
+
+func TestFakeProvider_LatencyHonoursContext(t *testing.T) {
+	p := NewProvider()
+	p.SetLatency("Validate", time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := p.Validate(ctx, &providerv1.ValidateRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestFakeProvider_ListVMsPages(t *testing.T) {
+	ctx := context.Background()
+	p := NewProvider()
+	for _, name := range []string{"c", "a", "b"} {
+		_, err := p.Create(ctx, &providerv1.CreateRequest{Name: name, VmMetadata: map[string]string{"tier": "web"}})
+		require.NoError(t, err)
+	}
+
+	var names []string
+	token := ""
+	for {
+		resp, err := p.ListVMs(ctx, &providerv1.ListVMsRequest{PageSize: 2, PageToken: token, LabelSelector: "tier=web"})
+		require.NoError(t, err)
+		for _, vm := range resp.Vms {
+			names = append(names, vm.Name)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// snapshot is a snapshot of a VM; external ones have an overlay per disk
+type snapshot struct {
+	id            string
+	description   string
+	parent        string
+	created       time.Time
+	memory        bool
+	state         string
+	external      bool
+	overlays      []*providerv1.SnapshotOverlay
+	diskPaths     map[string]string
+	cpus          int32
+	memoryMiB     int64
+	diskSizeBytes map[string]int64
+}
+
+// findSnapshot returns a snapshot of a VM by ID
+func (vm *virtualMachine) findSnapshot(id string) (*snapshot, bool) {
+	for _, snap := range vm.snapshots {
+		if snap.id == id {
+			return snap, true
+		}
+	}
+	return nil, false
+}
+
+// SnapshotCreate records the VM's configuration; external snapshots redirect disk writes to overlays
+func (p *Provider) SnapshotCreate(ctx context.Context, req *providerv1.SnapshotCreateRequest) (*providerv1.SnapshotCreateResponse, error) {
+	if err := p.before(ctx, "SnapshotCreate"); err != nil {
+		return nil, err
+	}
+	if req.External && !req.DiskOnly {
+		return nil, invalidSpec("external snapshots must be disk-only")
+	}
+	if req.DiskOnly && req.IncludeMemory {
+		return nil, invalidSpec("a disk-only snapshot cannot include memory")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	id := req.NameHint
+	if id == "" {
+		id = p.newID("snap")
+	}
+	if _, exists := vm.findSnapshot(id); exists {
+		return nil, alreadyExists("snapshot %s of domain %s already exists", id, vm.name)
+	}
+
+	snap := &snapshot{
+		id:            id,
+		description:   req.Description,
+		parent:        vm.currentSnapshot,
+		created:       p.now(),
+		memory:        req.IncludeMemory && vm.state != stateShutoff,
+		state:         vm.state,
+		external:      req.External,
+		diskPaths:     make(map[string]string),
+		cpus:          vm.cpus,
+		memoryMiB:     vm.memoryMiB,
+		diskSizeBytes: make(map[string]int64),
+	}
+	if req.DiskOnly {
+		snap.state = "disk-snapshot"
+	}
+	for _, d := range vm.disks {
+		if d.cdrom {
+			continue
+		}
+		snap.diskPaths[d.target] = d.path
+		snap.diskSizeBytes[d.target] = d.sizeBytes
+		if req.External {
+			overlay := fmt.Sprintf("%s.%s", d.path, id)
+			snap.overlays = append(snap.overlays, &providerv1.SnapshotOverlay{Disk: d.target, OverlayPath: overlay, BasePath: d.path})
+			d.path = overlay
+		}
+	}
+	vm.snapshots = append(vm.snapshots, snap)
+	vm.currentSnapshot = id
+
+	return &providerv1.SnapshotCreateResponse{
+		SnapshotId: id,
+		Task:       p.newTask(),
+		Overlays:   snap.overlays,
+		Quiesced:   req.DiskOnly && vm.state == stateRunning,
+	}, nil
+}
+
+// SnapshotDelete removes a snapshot, and its descendants when asked to; children of a
+// snapshot deleted alone are reparented to its parent
+func (p *Provider) SnapshotDelete(ctx context.Context, req *providerv1.SnapshotDeleteRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "SnapshotDelete"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := vm.findSnapshot(req.SnapshotId)
+	if !ok {
+		return nil, notFound("snapshot %s of domain %s not found", req.SnapshotId, vm.name)
+	}
+
+	remove := map[string]bool{target.id: true}
+	if req.Children {
+		// Snapshots are stored oldest first, so descendants follow their ancestors
+		for _, snap := range vm.snapshots {
+			if remove[snap.parent] {
+				remove[snap.id] = true
+			}
+		}
+	}
+	vm.snapshots = slices.DeleteFunc(vm.snapshots, func(snap *snapshot) bool { return remove[snap.id] })
+	for _, snap := range vm.snapshots {
+		if snap.parent == target.id {
+			snap.parent = target.parent
+		}
+	}
+	if remove[vm.currentSnapshot] {
+		vm.currentSnapshot = target.parent
+	}
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// SnapshotRevert restores the configuration recorded in a snapshot
+func (p *Provider) SnapshotRevert(ctx context.Context, req *providerv1.SnapshotRevertRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "SnapshotRevert"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	snap, ok := vm.findSnapshot(req.SnapshotId)
+	if !ok {
+		return nil, notFound("snapshot %s of domain %s not found", req.SnapshotId, vm.name)
+	}
+	if snap.external {
+		return nil, Error(providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED, "reverting to external snapshot %s is not supported", snap.id)
+	}
+
+	vm.cpus, vm.memoryMiB = snap.cpus, snap.memoryMiB
+	for _, d := range vm.disks {
+		if size, ok := snap.diskSizeBytes[d.target]; ok {
+			d.sizeBytes = size
+		}
+	}
+	vm.currentSnapshot = snap.id
+
+	state := snap.state
+	switch req.PowerState {
+	case "":
+		if state == "disk-snapshot" || (!snap.memory && state != stateShutoff) {
+			state = vm.state
+		}
+	case "running":
+		state = stateRunning
+	case "paused":
+		state = statePaused
+	case "stopped":
+		state = stateShutoff
+	default:
+		return nil, invalidSpec("unsupported power state %q", req.PowerState)
+	}
+	if state != vm.state {
+		event := map[string]string{stateRunning: "started", statePaused: "paused", stateShutoff: "stopped"}[state]
+		p.setState(vm, state, event, "from-snapshot")
+	}
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// ListSnapshots lists the snapshots of a VM, oldest first
+func (p *Provider) ListSnapshots(ctx context.Context, req *providerv1.ListSnapshotsRequest) (*providerv1.ListSnapshotsResponse, error) {
+	if err := p.before(ctx, "ListSnapshots"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &providerv1.ListSnapshotsResponse{}
+	for _, snap := range vm.snapshots {
+		var delta int64
+		if snap.external {
+			delta = int64(len(snap.overlays)) << 20
+		}
+		resp.TotalDeltaBytes += delta
+		resp.Snapshots = append(resp.Snapshots, &providerv1.SnapshotInfo{
+			Id:               snap.id,
+			Description:      snap.description,
+			CreationTimeUnix: snap.created.Unix(),
+			ParentId:         snap.parent,
+			IncludesMemory:   snap.memory,
+			State:            snap.state,
+			Current:          snap.id == vm.currentSnapshot,
+			DeltaBytes:       delta,
+		})
+	}
+	return resp, nil
+}
+
+// ConsolidateSnapshots commits external overlays back into their base images and deletes
+// the external snapshots, streaming a commit, pivot and done phase per disk
+func (p *Provider) ConsolidateSnapshots(req *providerv1.ConsolidateSnapshotsRequest, stream grpc.ServerStreamingServer[providerv1.ConsolidateSnapshotsProgress]) error {
+	if err := p.before(stream.Context(), "ConsolidateSnapshots"); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		p.mu.Unlock()
+		return err
+	}
+
+	// The base of a disk is the oldest overlay's base; everything above it is merged
+	bases := make(map[string]string)
+	var overlays []string
+	for _, snap := range vm.snapshots {
+		for _, overlay := range snap.overlays {
+			if req.Disk != "" && overlay.Disk != req.Disk {
+				continue
+			}
+			if _, ok := bases[overlay.Disk]; !ok {
+				bases[overlay.Disk] = overlay.BasePath
+			}
+			overlays = append(overlays, overlay.OverlayPath)
+		}
+	}
+	if req.Disk != "" {
+		if _, ok := vm.findDisk(req.Disk); !ok {
+			p.mu.Unlock()
+			return notFound("disk %s not found on domain %s", req.Disk, vm.name)
+		}
+	}
+	var deleted []string
+	if len(bases) > 0 {
+		for _, d := range vm.disks {
+			if base, ok := bases[d.target]; ok {
+				d.path = base
+			}
+		}
+		vm.snapshots = slices.DeleteFunc(vm.snapshots, func(snap *snapshot) bool {
+			if !snap.external {
+				return false
+			}
+			deleted = append(deleted, snap.id)
+			return true
+		})
+		if _, ok := vm.findSnapshot(vm.currentSnapshot); !ok {
+			vm.currentSnapshot = ""
+		}
+	}
+	p.mu.Unlock()
+
+	disks := make([]string, 0, len(bases))
+	for target := range bases {
+		disks = append(disks, target)
+	}
+	slices.Sort(disks)
+	for _, target := range disks {
+		for _, progress := range []*providerv1.ConsolidateSnapshotsProgress{
+			{Disk: target, Phase: "commit", ProgressPercent: 100},
+			{Disk: target, Phase: "pivot", ProgressPercent: 100},
+		} {
+			if err := stream.Send(progress); err != nil {
+				return err
+			}
+		}
+	}
+	return stream.Send(&providerv1.ConsolidateSnapshotsProgress{
+		Disk:             req.Disk,
+		Phase:            "done",
+		ProgressPercent:  100,
+		RemovedOverlays:  overlays,
+		DeletedSnapshots: deleted,
+	})
+}
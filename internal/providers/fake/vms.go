@@ -0,0 +1,1008 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+const (
+	// defaultDiskGiB is the root disk size of a VM whose class sets none
+	defaultDiskGiB = 20
+
+	// maxListPageSize caps the page size of ListVMs, as the libvirt provider does
+	maxListPageSize = 500
+)
+
+// virtualMachine is a VM in the in-memory store
+type virtualMachine struct {
+	id, name, uuid  string
+	seq             int
+	state           string
+	spec            *providerv1.CreateRequest
+	metadata        map[string]string
+	cpus            int32
+	memoryMiB       int64
+	hardwareVersion int32
+	host            string
+	disks           []*disk
+	nics            []*nic
+	snapshots       []*snapshot
+	currentSnapshot string
+	managedSave     bool
+	unmanaged       bool
+	consoleLog      []byte
+	hostname        string
+	created         time.Time
+	started         time.Time
+}
+
+// disk is a disk or CD-ROM drive of a VM
+type disk struct {
+	target    string
+	path      string
+	format    string
+	sizeBytes int64
+	cdrom     bool
+	media     string
+	throttle  *contracts.DiskThrottle
+}
+
+// nic is a network interface of a VM; ip is only set while it runs
+type nic struct {
+	name    string
+	mac     string
+	network string
+	ip      string
+}
+
+// guestHostname is the hostname the guest agent reports; guests boot with the VM name
+func (vm *virtualMachine) guestHostname() string {
+	if vm.hostname == "" {
+		return vm.name
+	}
+	return vm.hostname
+}
+
+// powerState maps the domain state to the power state the libvirt provider reports
+func (vm *virtualMachine) powerState() string {
+	if vm.state == stateRunning {
+		return powerOn
+	}
+	return powerOff
+}
+
+// ips returns the addresses of the running VM's interfaces
+func (vm *virtualMachine) ips() []string {
+	var ips []string
+	for _, n := range vm.nics {
+		if n.ip != "" {
+			ips = append(ips, n.ip)
+		}
+	}
+	return ips
+}
+
+// assignAddresses gives each interface a stable address derived from the VM and interface index
+func (vm *virtualMachine) assignAddresses() {
+	for i, n := range vm.nics {
+		n.ip = fmt.Sprintf("10.%d.%d.%d", i, vm.seq/250, vm.seq%250+2)
+	}
+}
+
+// setState changes the domain state, assigning addresses on start and releasing them on stop
+func (p *Provider) setState(vm *virtualMachine, state, event, detail string) {
+	vm.state = state
+	switch state {
+	case stateRunning:
+		if vm.started.IsZero() {
+			vm.started = p.now()
+		}
+		vm.assignAddresses()
+		vm.consoleLog = append(vm.consoleLog, []byte(fmt.Sprintf("[%s] %s %s\n", p.now().UTC().Format(time.RFC3339), vm.name, detail))...)
+	case stateShutoff:
+		vm.started = time.Time{}
+		for _, n := range vm.nics {
+			n.ip = ""
+		}
+	}
+	p.publish(vm, event, detail)
+}
+
+// stop shuts a VM off; an ephemeral VM disappears like a transient domain
+func (p *Provider) stop(vm *virtualMachine, detail string) {
+	p.setState(vm, stateShutoff, "stopped", detail)
+	if vm.spec.Ephemeral {
+		delete(p.vms, vm.id)
+	}
+}
+
+// AddVM seeds a VM that the provider did not create, as if it had been defined with virsh.
+// It is not managed until adopted with AdoptVM. It returns the VM ID.
+func (p *Provider) AddVM(name string, running bool) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	vm := p.newVM(&providerv1.CreateRequest{Name: name}, contracts.VMClass{}, nil, nil)
+	vm.unmanaged = true
+	if running {
+		p.setState(vm, stateRunning, "started", "booted")
+	}
+	return vm.id
+}
+
+// newVM adds a shut off VM to the store, or a running one when it is ephemeral; the caller
+// holds p.mu and has validated the request
+func (p *Provider) newVM(req *providerv1.CreateRequest, class contracts.VMClass, disks []contracts.DiskSpec,
+	networks []contracts.NetworkAttachment) *virtualMachine {
+	id := p.newID("vm")
+	vm := &virtualMachine{
+		id:              id,
+		seq:             p.nextID,
+		name:            req.Name,
+		uuid:            req.Uuid,
+		state:           stateShutoff,
+		spec:            proto.Clone(req).(*providerv1.CreateRequest),
+		metadata:        maps.Clone(req.VmMetadata),
+		cpus:            max(class.CPU, 1),
+		memoryMiB:       int64(max(class.MemoryMiB, 512)),
+		hardwareVersion: 1,
+		created:         p.now(),
+	}
+	if vm.uuid == "" {
+		vm.uuid = fmt.Sprintf("00000000-0000-4000-8000-%012d", p.nextID)
+	}
+	if vm.metadata == nil {
+		vm.metadata = map[string]string{}
+	}
+
+	rootGiB := int64(defaultDiskGiB)
+	if class.DiskDefaults != nil && class.DiskDefaults.SizeGiB > 0 {
+		rootGiB = int64(class.DiskDefaults.SizeGiB)
+	}
+	vm.disks = append(vm.disks, &disk{target: "vda", path: fmt.Sprintf("/var/lib/libvirt/images/%s-disk.qcow2", req.Name),
+		format: "qcow2", sizeBytes: rootGiB << 30})
+	for i, spec := range disks {
+		format := spec.Format
+		if format == "" {
+			format = "qcow2"
+		}
+		d := &disk{target: fmt.Sprintf("vd%c", 'b'+i), format: format, sizeBytes: int64(spec.SizeGiB) << 30}
+		d.path = fmt.Sprintf("/var/lib/libvirt/images/%s-disk-%s.%s", req.Name, d.target, format)
+		vm.disks = append(vm.disks, d)
+	}
+
+	if len(req.UserData) > 0 {
+		vm.disks = append(vm.disks, &disk{target: "sda", cdrom: true, format: "raw",
+			media: fmt.Sprintf("/var/lib/libvirt/images/%s-cloudinit.iso", req.Name)})
+	}
+
+	if len(networks) == 0 {
+		networks = []contracts.NetworkAttachment{{Name: "default", NetworkName: "default"}}
+	}
+	for i, network := range networks {
+		mac := strings.ToLower(network.MacAddress)
+		if mac == "" {
+			mac = fmt.Sprintf("52:54:00:%02x:%02x:%02x", p.nextID>>8&0xff, p.nextID&0xff, i)
+		}
+		name := network.NetworkName
+		if name == "" {
+			name = network.Bridge
+		}
+		vm.nics = append(vm.nics, &nic{name: fmt.Sprintf("eth%d", i), mac: mac, network: name})
+	}
+
+	p.vms[id] = vm
+	if req.Ephemeral {
+		// Like a transient domain, an ephemeral VM is never defined and runs from creation
+		p.setState(vm, stateRunning, "started", "booted")
+		return vm
+	}
+	p.publish(vm, "defined", "added")
+	return vm
+}
+
+// parseCreateRequest decodes the JSON fields of a create request the fake acts on
+func parseCreateRequest(req *providerv1.CreateRequest) (contracts.VMClass, []contracts.DiskSpec, []contracts.NetworkAttachment, error) {
+	var class contracts.VMClass
+	var disks []contracts.DiskSpec
+	var networks []contracts.NetworkAttachment
+	if req.Name == "" {
+		return class, nil, nil, invalidSpec("VM name is required")
+	}
+	for _, field := range []struct {
+		name, value string
+		target      any
+	}{
+		{"class", req.ClassJson, &class},
+		{"disks", req.DisksJson, &disks},
+		{"networks", req.NetworksJson, &networks},
+	} {
+		if field.value == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(field.value), field.target); err != nil {
+			return class, nil, nil, invalidSpec("invalid %s: %v", field.name, err)
+		}
+	}
+	if class.CPU < 0 || class.MemoryMiB < 0 {
+		return class, nil, nil, invalidSpec("CPU and memory must not be negative")
+	}
+	return class, disks, networks, nil
+}
+
+// create validates and stores a VM, returning an existing one with the same name and UUID
+// unchanged; the caller holds p.mu
+func (p *Provider) create(req *providerv1.CreateRequest) (*virtualMachine, bool, error) {
+	class, disks, networks, err := parseCreateRequest(req)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, vm := range p.vms {
+		if vm.name != req.Name && (req.Uuid == "" || vm.uuid != req.Uuid) {
+			continue
+		}
+		if vm.name != req.Name || (req.Uuid != "" && vm.uuid != req.Uuid) {
+			return nil, false, alreadyExists("domain %s (uuid %s) conflicts with the request", vm.name, vm.uuid)
+		}
+		return vm, true, nil
+	}
+	if req.DryRun {
+		return &virtualMachine{name: req.Name}, false, nil
+	}
+	return p.newVM(req, class, disks, networks), false, nil
+}
+
+// Validate reports that the fake is always reachable
+func (p *Provider) Validate(ctx context.Context, req *providerv1.ValidateRequest) (*providerv1.ValidateResponse, error) {
+	if err := p.before(ctx, "Validate"); err != nil {
+		return nil, err
+	}
+	return &providerv1.ValidateResponse{Ok: true, Message: "fake provider is ready"}, nil
+}
+
+// Create stores a new shut off VM; it is idempotent by name and UUID
+func (p *Provider) Create(ctx context.Context, req *providerv1.CreateRequest) (*providerv1.CreateResponse, error) {
+	if err := p.before(ctx, "Create"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, existed, err := p.create(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		var warnings []string
+		if existed {
+			warnings = append(warnings, fmt.Sprintf("domain %s already exists and matches the request; Create would return it unchanged", vm.name))
+		}
+		return &providerv1.CreateResponse{
+			RenderedDefinition: fmt.Sprintf("<domain type='fake'>\n  <name>%s</name>\n</domain>\n", req.Name),
+			Warnings:           warnings,
+		}, nil
+	}
+	return &providerv1.CreateResponse{Id: vm.id, Task: p.newTask(), AlreadyExists: existed}, nil
+}
+
+// EnsureVM creates the VM if needed and drives it to the desired power state
+func (p *Provider) EnsureVM(ctx context.Context, req *providerv1.EnsureVMRequest) (*providerv1.EnsureVMResponse, error) {
+	if err := p.before(ctx, "EnsureVM"); err != nil {
+		return nil, err
+	}
+	if req.Spec == nil {
+		return nil, invalidSpec("spec is required")
+	}
+	if req.PowerState != "" && req.PowerState != powerOn && req.PowerState != powerOff {
+		return nil, invalidSpec("unsupported power state %q", req.PowerState)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	spec := proto.Clone(req.Spec).(*providerv1.CreateRequest)
+	spec.DryRun = false
+	vm, existed, err := p.create(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &providerv1.EnsureVMResponse{Id: vm.id, Created: !existed}
+	if !existed {
+		resp.Actions = append(resp.Actions, "create")
+	}
+	switch {
+	case req.PowerState == powerOn && vm.state != stateRunning:
+		p.setState(vm, stateRunning, "started", "booted")
+		resp.Actions = append(resp.Actions, "power-on")
+	case req.PowerState == powerOff && vm.state != stateShutoff:
+		p.stop(vm, "shutdown")
+		resp.Actions = append(resp.Actions, "power-off")
+	}
+	resp.PowerState = vm.powerState()
+	return resp, nil
+}
+
+// Delete removes a VM and its snapshots
+func (p *Provider) Delete(ctx context.Context, req *providerv1.DeleteRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "Delete"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateShutoff {
+		p.setState(vm, stateShutoff, "stopped", "destroyed")
+	}
+	delete(p.vms, vm.id)
+	p.publish(vm, "undefined", "removed")
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// Power starts, stops or reboots a VM; starting a VM with saved state restores it
+func (p *Provider) Power(ctx context.Context, req *providerv1.PowerRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "Power"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch req.Op {
+	case providerv1.PowerOp_POWER_OP_ON:
+		if vm.state == stateShutoff {
+			detail := "booted"
+			if vm.managedSave {
+				vm.managedSave = false
+				detail = "restored"
+			}
+			p.setState(vm, stateRunning, "started", detail)
+		}
+	case providerv1.PowerOp_POWER_OP_OFF:
+		if vm.state != stateShutoff {
+			p.stop(vm, "destroyed")
+		}
+	case providerv1.PowerOp_POWER_OP_SHUTDOWN_GRACEFUL:
+		if vm.state != stateShutoff {
+			p.stop(vm, "shutdown")
+		}
+	case providerv1.PowerOp_POWER_OP_REBOOT:
+		if vm.state != stateRunning {
+			return nil, invalidState("domain %s is not running", vm.name)
+		}
+		p.setState(vm, stateRunning, "started", "booted")
+	default:
+		return nil, invalidSpec("unsupported power operation %v", req.Op)
+	}
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// Reconfigure applies CPU, memory, disk size, disk I/O limit, network, metadata and affinity group changes
+func (p *Provider) Reconfigure(ctx context.Context, req *providerv1.ReconfigureRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "Reconfigure"); err != nil {
+		return nil, err
+	}
+	var desired contracts.CreateRequest
+	if req.DesiredJson != "" {
+		if err := json.Unmarshal([]byte(req.DesiredJson), &desired); err != nil {
+			return nil, invalidSpec("invalid desired configuration: %v", err)
+		}
+	}
+	var networks []contracts.NetworkAttachment
+	if req.NetworksJson != "" {
+		if err := json.Unmarshal([]byte(req.NetworksJson), &networks); err != nil {
+			return nil, invalidSpec("invalid networks: %v", err)
+		}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate everything before changing anything
+	for _, resize := range req.DiskResizes {
+		d, ok := vm.findDisk(resize.Target)
+		if !ok {
+			return nil, notFound("disk %s not found on domain %s", resize.Target, vm.name)
+		}
+		if resize.SizeBytes < d.sizeBytes {
+			return nil, invalidSpec("disk %s cannot shrink from %d to %d bytes", resize.Target, d.sizeBytes, resize.SizeBytes)
+		}
+	}
+	if err := vmspec.ValidateDiskThrottles(desired.DiskThrottles); err != nil {
+		return nil, invalidSpec("%v", err)
+	}
+	for _, throttle := range desired.DiskThrottles {
+		if d, ok := vm.findDisk(throttle.Target); !ok || d.cdrom {
+			return nil, invalidSpec("cannot throttle disk %s: domain %s has no such disk", throttle.Target, vm.name)
+		}
+	}
+
+	if desired.Class.CPU > 0 {
+		vm.cpus = desired.Class.CPU
+	}
+	if desired.Class.MemoryMiB > 0 {
+		vm.memoryMiB = int64(desired.Class.MemoryMiB)
+	}
+	if req.TargetVcpus > 0 {
+		vm.cpus = req.TargetVcpus
+	}
+	if req.TargetMemoryMib > 0 {
+		vm.memoryMiB = req.TargetMemoryMib
+	}
+	for _, resize := range req.DiskResizes {
+		d, _ := vm.findDisk(resize.Target)
+		d.sizeBytes = resize.SizeBytes
+	}
+	for _, throttle := range desired.DiskThrottles {
+		// A throttle without limits removes them, like blkdeviotune with all zeros
+		d, _ := vm.findDisk(throttle.Target)
+		d.throttle = nil
+		if throttle != (contracts.DiskThrottle{Target: throttle.Target}) {
+			d.throttle = &throttle
+		}
+	}
+	if len(req.VmMetadata) > 0 {
+		vm.metadata = maps.Clone(req.VmMetadata)
+	} else if len(desired.VMMetadata) > 0 {
+		vm.metadata = maps.Clone(desired.VMMetadata)
+	}
+	if desired.AffinityGroups != nil || desired.AntiAffinityGroups != nil {
+		vm.spec.AffinityGroups = slices.Clone(desired.AffinityGroups)
+		vm.spec.AntiAffinityGroups = slices.Clone(desired.AntiAffinityGroups)
+	}
+	if req.NetworksJson != "" {
+		vm.nics = nil
+		for i, network := range networks {
+			name := network.NetworkName
+			if name == "" {
+				name = network.Bridge
+			}
+			vm.nics = append(vm.nics, &nic{name: fmt.Sprintf("eth%d", i), mac: strings.ToLower(network.MacAddress), network: name})
+		}
+		if vm.state == stateRunning {
+			vm.assignAddresses()
+		}
+	}
+
+	resp := &providerv1.TaskResponse{Task: p.newTask()}
+	if req.TargetMemoryMib > 0 {
+		resp.AppliedMemoryMib = vm.memoryMiB
+	}
+	if req.TargetVcpus > 0 {
+		resp.OnlineVcpus = vm.cpus
+	}
+	return resp, nil
+}
+
+// findDisk returns the disk at a target device
+func (vm *virtualMachine) findDisk(target string) (*disk, bool) {
+	for _, d := range vm.disks {
+		if d.target == target {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// HardwareUpgrade records the new hardware version of a shut off VM
+func (p *Provider) HardwareUpgrade(ctx context.Context, req *providerv1.HardwareUpgradeRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "HardwareUpgrade"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateShutoff {
+		return nil, invalidState("domain %s must be shut off to upgrade its hardware", vm.name)
+	}
+	if req.TargetVersion < vm.hardwareVersion {
+		return nil, invalidSpec("cannot downgrade hardware version from %d to %d", vm.hardwareVersion, req.TargetVersion)
+	}
+	vm.hardwareVersion = req.TargetVersion
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// Describe reports the state of a VM; a missing VM is reported as not existing
+func (p *Provider) Describe(ctx context.Context, req *providerv1.DescribeRequest) (*providerv1.DescribeResponse, error) {
+	if err := p.before(ctx, "Describe"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return &providerv1.DescribeResponse{Exists: false}, nil
+	}
+
+	raw, _ := json.Marshal(map[string]any{
+		"id":               vm.id,
+		"name":             vm.name,
+		"uuid":             vm.uuid,
+		"state":            vm.state,
+		"host":             vm.host,
+		"hardware_version": vm.hardwareVersion,
+		"created":          vm.created.UTC().Format(time.RFC3339),
+	})
+	resp := &providerv1.DescribeResponse{
+		Exists:             true,
+		PowerState:         vm.powerState(),
+		Ips:                vm.ips(),
+		ConsoleUrl:         fmt.Sprintf("fake://%s/console", vm.id),
+		ProviderRawJson:    string(raw),
+		VmMetadata:         maps.Clone(vm.metadata),
+		AffinityGroups:     slices.Clone(vm.spec.AffinityGroups),
+		AntiAffinityGroups: slices.Clone(vm.spec.AntiAffinityGroups),
+		BootOrder:          []string{"disk"},
+		Firmware:           "bios",
+		HasManagedSave:     vm.managedSave,
+		SpecHash:           vm.specHash(),
+		Ephemeral:          vm.spec.Ephemeral,
+	}
+	if vm.managedSave {
+		resp.ManagedSaveSizeBytes = vm.memoryMiB << 20
+	}
+	for _, n := range vm.nics {
+		iface := &providerv1.GuestInterface{Name: n.name, Mac: n.mac, Source: "agent"}
+		if n.ip != "" {
+			iface.Addresses = []string{n.ip + "/24"}
+		}
+		if vm.state == stateRunning {
+			resp.GuestInterfaces = append(resp.GuestInterfaces, iface)
+		}
+	}
+	if vm.state == stateRunning {
+		resp.GuestHostname = vm.guestHostname()
+	}
+	for _, d := range vm.disks {
+		if d.cdrom {
+			continue
+		}
+		resp.Disks = append(resp.Disks, &providerv1.DiskAllocation{
+			Target:          d.target,
+			Path:            d.path,
+			CapacityBytes:   d.sizeBytes,
+			AllocationBytes: d.sizeBytes / 10,
+			PhysicalBytes:   d.sizeBytes / 10,
+		})
+		if t := d.throttle; t != nil {
+			resp.DiskThrottles = append(resp.DiskThrottles, &providerv1.DiskThrottle{
+				Target:           t.Target,
+				TotalBytesPerSec: t.TotalBytesPerSec,
+				ReadBytesPerSec:  t.ReadBytesPerSec,
+				WriteBytesPerSec: t.WriteBytesPerSec,
+				TotalIops:        t.TotalIOPS,
+				ReadIops:         t.ReadIOPS,
+				WriteIops:        t.WriteIOPS,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// specHash hashes the configuration a reconcile could change: name, CPUs, memory,
+// disks (target, size, format, media) and interfaces (MAC, network), in that order
+func (vm *virtualMachine) specHash() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "name|%s\nvcpu|%d\nmemory|%d\n", vm.name, vm.cpus, vm.memoryMiB)
+	for _, d := range vm.disks {
+		fmt.Fprintf(&b, "disk|%s|%d|%s|%t|%s\n", d.target, d.sizeBytes, d.format, d.cdrom, d.media)
+	}
+	for _, n := range vm.nics {
+		fmt.Fprintf(&b, "interface|%s|%s\n", n.mac, n.network)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// TaskStatus reports every task as done, since the fake completes operations synchronously
+func (p *Provider) TaskStatus(ctx context.Context, req *providerv1.TaskStatusRequest) (*providerv1.TaskStatusResponse, error) {
+	if err := p.before(ctx, "TaskStatus"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if req.Task == nil {
+		return nil, invalidSpec("task is required")
+	}
+	if _, ok := p.tasks[req.Task.Id]; !ok {
+		return &providerv1.TaskStatusResponse{Done: true, Error: "task not found"}, nil
+	}
+	return &providerv1.TaskStatusResponse{Done: true, Message: "completed", ProgressPercent: 100}, nil
+}
+
+// Clone copies a VM's configuration and disks to a new shut off VM
+func (p *Provider) Clone(ctx context.Context, req *providerv1.CloneRequest) (*providerv1.CloneResponse, error) {
+	if err := p.before(ctx, "Clone"); err != nil {
+		return nil, err
+	}
+	if req.TargetName == "" {
+		return nil, invalidSpec("target name is required")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	source, err := p.lookup(req.SourceVmId)
+	if err != nil {
+		return nil, err
+	}
+	for _, vm := range p.vms {
+		if vm.name == req.TargetName {
+			return nil, alreadyExists("domain %s already exists", req.TargetName)
+		}
+	}
+
+	spec := proto.Clone(source.spec).(*providerv1.CreateRequest)
+	spec.Name = req.TargetName
+	spec.Uuid = ""
+	clone := p.newVM(spec, contracts.VMClass{CPU: source.cpus, MemoryMiB: int32(source.memoryMiB)}, nil, nil)
+	clone.metadata = maps.Clone(source.metadata)
+	clone.disks = nil
+	for _, d := range source.disks {
+		copied := *d
+		if !d.cdrom {
+			copied.path = strings.Replace(d.path, source.name, req.TargetName, 1)
+		}
+		clone.disks = append(clone.disks, &copied)
+	}
+	for i, n := range clone.nics {
+		if i < len(source.nics) {
+			n.network = source.nics[i].network
+		}
+	}
+	return &providerv1.CloneResponse{TargetVmId: clone.id, Task: p.newTask(), Linked: req.Linked}, nil
+}
+
+// ListVMs lists VMs ordered by name, filtered by a metadata label selector and paged like
+// the libvirt provider: the page token records the last name returned
+func (p *Provider) ListVMs(ctx context.Context, req *providerv1.ListVMsRequest) (*providerv1.ListVMsResponse, error) {
+	if err := p.before(ctx, "ListVMs"); err != nil {
+		return nil, err
+	}
+	selector := labels.Everything()
+	if req.LabelSelector != "" {
+		parsed, err := labels.Parse(req.LabelSelector)
+		if err != nil {
+			return nil, invalidSpec("invalid label selector %q: %v", req.LabelSelector, err)
+		}
+		selector = parsed
+	}
+	after := ""
+	if req.PageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err != nil {
+			return nil, invalidSpec("invalid page token")
+		}
+		after = string(raw)
+	}
+	pageSize := min(int(req.PageSize), maxListPageSize)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vms := make([]*virtualMachine, 0, len(p.vms))
+	for _, vm := range p.vms {
+		if vm.name > after && selector.Matches(labels.Set(vm.metadata)) {
+			vms = append(vms, vm)
+		}
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].name < vms[j].name })
+
+	resp := &providerv1.ListVMsResponse{}
+	for _, vm := range vms {
+		if pageSize > 0 && len(resp.Vms) == pageSize {
+			resp.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(resp.Vms[len(resp.Vms)-1].Name))
+			break
+		}
+		info := &providerv1.VMInfo{
+			Id:          vm.id,
+			Name:        vm.name,
+			PowerState:  vm.powerState(),
+			Ips:         vm.ips(),
+			Cpu:         vm.cpus,
+			MemoryMib:   vm.memoryMiB,
+			ProviderRaw: map[string]string{"uuid": vm.uuid, "state": vm.state},
+		}
+		for _, d := range vm.disks {
+			if !d.cdrom {
+				info.Disks = append(info.Disks, &providerv1.DiskInfo{Id: d.target, Path: d.path, SizeGib: int32(d.sizeBytes >> 30), Format: d.format})
+			}
+		}
+		for _, n := range vm.nics {
+			info.Networks = append(info.Networks, &providerv1.NetworkInfo{Name: n.network, Mac: n.mac, IpAddress: n.ip})
+		}
+		resp.Vms = append(resp.Vms, info)
+	}
+	return resp, nil
+}
+
+// Migrate records the destination host; live migration needs a running VM and offline a stopped one
+func (p *Provider) Migrate(ctx context.Context, req *providerv1.MigrateRequest) (*providerv1.TaskResponse, error) {
+	if err := p.before(ctx, "Migrate"); err != nil {
+		return nil, err
+	}
+	if req.Destination == "" {
+		return nil, invalidSpec("destination is required")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Live && vm.state != stateRunning {
+		return nil, invalidState("domain %s must be running for live migration", vm.name)
+	}
+	if !req.Live && vm.state != stateShutoff {
+		return nil, invalidState("domain %s must be shut off for offline migration", vm.name)
+	}
+	vm.host = req.Destination
+	p.publish(vm, "started", "migrated")
+	return &providerv1.TaskResponse{Task: p.newTask()}, nil
+}
+
+// AdoptVM takes over a VM seeded with AddVM; VMs the fake created are already adopted
+func (p *Provider) AdoptVM(ctx context.Context, req *providerv1.AdoptVMRequest) (*providerv1.AdoptVMResponse, error) {
+	if err := p.before(ctx, "AdoptVM"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	alreadyAdopted := !vm.unmanaged
+	vm.unmanaged = false
+
+	class, _ := json.Marshal(contracts.VMClass{CPU: vm.cpus, MemoryMiB: int32(vm.memoryMiB)})
+	spec := &providerv1.CreateRequest{
+		Name:       vm.name,
+		Uuid:       vm.uuid,
+		ClassJson:  string(class),
+		VmMetadata: maps.Clone(vm.metadata),
+	}
+	return &providerv1.AdoptVMResponse{Id: vm.id, Uuid: vm.uuid, Spec: spec, AlreadyAdopted: alreadyAdopted}, nil
+}
+
+// ExportSpec renders VMClass and VirtualMachine manifests of a VM
+func (p *Provider) ExportSpec(ctx context.Context, req *providerv1.ExportSpecRequest) (*providerv1.ExportSpecResponse, error) {
+	if err := p.before(ctx, "ExportSpec"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	providerName := req.ProviderName
+	if providerName == "" {
+		providerName = "libvirt"
+	}
+	className := req.ClassName
+	if className == "" {
+		className = vm.name
+	}
+	namespace := ""
+	if req.Namespace != "" {
+		namespace = "\n  namespace: " + req.Namespace
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "apiVersion: infra.virtrigaud.io/v1beta1\nkind: VMClass\nmetadata:\n  name: %s%s\nspec:\n  cpu: %d\n  memory: %dMi\n",
+		className, namespace, vm.cpus, vm.memoryMiB)
+	fmt.Fprintf(&b, "---\napiVersion: infra.virtrigaud.io/v1beta1\nkind: VirtualMachine\nmetadata:\n  name: %s%s\nspec:\n  providerRef:\n    name: %s\n  classRef:\n    name: %s\n  powerState: %s\n",
+		vm.name, namespace, providerName, className, vm.powerState())
+	return &providerv1.ExportSpecResponse{Manifest: b.String()}, nil
+}
+
+// RebootVM restarts a running VM, reporting the reset method when forced and acpi otherwise
+func (p *Provider) RebootVM(ctx context.Context, req *providerv1.RebootVMRequest) (*providerv1.RebootVMResponse, error) {
+	if err := p.before(ctx, "RebootVM"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state != stateRunning {
+		return nil, invalidState("domain %s is not running", vm.name)
+	}
+	method := "acpi"
+	if req.Force {
+		method = "reset"
+	}
+	p.setState(vm, stateRunning, "started", "booted")
+	return &providerv1.RebootVMResponse{Method: method}, nil
+}
+
+// RenameVM renames a shut off VM; the ID stays the same
+func (p *Provider) RenameVM(ctx context.Context, req *providerv1.RenameVMRequest) (*providerv1.RenameVMResponse, error) {
+	if err := p.before(ctx, "RenameVM"); err != nil {
+		return nil, err
+	}
+	if req.NewName == "" {
+		return nil, invalidSpec("new name is required")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if vm.name == req.NewName {
+		return &providerv1.RenameVMResponse{Id: vm.id}, nil
+	}
+	for _, other := range p.vms {
+		if other.name == req.NewName {
+			return nil, alreadyExists("domain %s already exists", req.NewName)
+		}
+	}
+	if vm.state != stateShutoff {
+		return nil, invalidState("domain %s must be shut off to be renamed", vm.name)
+	}
+	vm.name = req.NewName
+	vm.spec.Name = req.NewName
+	p.publish(vm, "defined", "renamed")
+	return &providerv1.RenameVMResponse{Id: vm.id}, nil
+}
+
+// SuspendVM pauses a running VM
+func (p *Provider) SuspendVM(ctx context.Context, req *providerv1.SuspendVMRequest) (*providerv1.SuspendVMResponse, error) {
+	if err := p.before(ctx, "SuspendVM"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	switch vm.state {
+	case statePaused:
+	case stateRunning:
+		p.setState(vm, statePaused, "paused", "paused")
+	default:
+		return nil, invalidState("domain %s is not running", vm.name)
+	}
+	return &providerv1.SuspendVMResponse{State: vm.state}, nil
+}
+
+// ResumeVM continues a paused VM
+func (p *Provider) ResumeVM(ctx context.Context, req *providerv1.ResumeVMRequest) (*providerv1.ResumeVMResponse, error) {
+	if err := p.before(ctx, "ResumeVM"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	switch vm.state {
+	case stateRunning:
+	case statePaused:
+		p.setState(vm, stateRunning, "resumed", "unpaused")
+	default:
+		return nil, invalidState("domain %s is not paused", vm.name)
+	}
+	return &providerv1.ResumeVMResponse{State: vm.state}, nil
+}
+
+// SaveVM stops a running or paused VM, keeping its memory for the next power-on
+func (p *Provider) SaveVM(ctx context.Context, req *providerv1.SaveVMRequest) (*providerv1.SaveVMResponse, error) {
+	if err := p.before(ctx, "SaveVM"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	if vm.state == stateShutoff {
+		return nil, invalidState("domain %s is not running", vm.name)
+	}
+	if vm.spec.Ephemeral {
+		return nil, invalidState("domain %s is ephemeral; it has no definition to restore a saved state into", vm.name)
+	}
+	vm.managedSave = true
+	p.setState(vm, stateShutoff, "stopped", "saved")
+	return &providerv1.SaveVMResponse{SizeBytes: vm.memoryMiB << 20}, nil
+}
+
+// DiscardManagedSave drops the saved memory of a VM
+func (p *Provider) DiscardManagedSave(ctx context.Context, req *providerv1.DiscardManagedSaveRequest) (*providerv1.DiscardManagedSaveResponse, error) {
+	if err := p.before(ctx, "DiscardManagedSave"); err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	vm, err := p.lookup(req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	discarded := vm.managedSave
+	vm.managedSave = false
+	return &providerv1.DiscardManagedSaveResponse{Discarded: discarded}, nil
+}
+
+// ValidateSpec reports the findings Create would fail with instead of failing
+func (p *Provider) ValidateSpec(ctx context.Context, req *providerv1.ValidateSpecRequest) (*providerv1.ValidateSpecResponse, error) {
+	if err := p.before(ctx, "ValidateSpec"); err != nil {
+		return nil, err
+	}
+	if req.Spec == nil {
+		return nil, invalidSpec("spec is required")
+	}
+	if _, _, _, err := parseCreateRequest(req.Spec); err != nil {
+		return &providerv1.ValidateSpecResponse{
+			Findings: []*providerv1.SpecFinding{{
+				Severity: "error",
+				Check:    "spec",
+				Code:     providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC,
+				Message:  status.Convert(err).Message(),
+			}},
+		}, nil
+	}
+	return &providerv1.ValidateSpecResponse{Valid: true}, nil
+}
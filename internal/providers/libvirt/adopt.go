@@ -0,0 +1,206 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// AdoptResult describes a pre-existing domain taken over by the provider
+type AdoptResult struct {
+	// ID is the domain name, the identifier used by all other operations
+	ID string
+	// UUID is the libvirt UUID of the domain
+	UUID string
+	// Spec is the create request that describes the domain as it is defined
+	Spec contracts.CreateRequest
+	// AlreadyAdopted is set when the domain was already managed by this instance
+	AlreadyAdopted bool
+	// Warnings lists parts of the definition the spec cannot represent
+	Warnings []string
+}
+
+// AdoptVM stamps a hand-created domain, given by name or UUID, with the ownership marker
+// of this provider instance and returns its normalized spec, so that a matching resource
+// can be created without recreating the VM. Domains managed by another instance are refused.
+func (p *Provider) AdoptVM(ctx context.Context, id string) (AdoptResult, error) {
+	var result AdoptResult
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if id == "" {
+		return result, contracts.NewInvalidSpecError("domain name or UUID is required", nil)
+	}
+
+	// dumpxml resolves both names and UUIDs
+	domain, err := p.virshProvider.getDomainXML(ctx, id)
+	if err != nil {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", id), err)
+	}
+	result.ID, result.UUID = domain.Name, domain.UUID
+
+	owner, err := p.virshProvider.getDomainOwner(ctx, domain.Name)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read domain owner", err)
+	}
+	switch owner {
+	case "":
+		scope, err := p.virshProvider.domainChangeScope(ctx, domain.Name)
+		if err != nil {
+			return result, contracts.NewRetryableError("failed to get domain state", err)
+		}
+		log.Printf("INFO Adopting domain %s (%s) as instance %s", domain.Name, domain.UUID, p.instanceID())
+		if err := p.virshProvider.setDomainOwner(ctx, domain.Name, p.instanceID(), scope); err != nil {
+			return result, err
+		}
+	case p.instanceID():
+		result.AlreadyAdopted = true
+	default:
+		return result, contracts.NewConflictError(fmt.Sprintf(
+			"domain %s is managed by virtrigaud instance %q", domain.Name, owner), nil)
+	}
+
+	metadata, err := p.virshProvider.getVMMetadata(ctx, domain.Name)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read domain metadata", err)
+	}
+	result.Spec, result.Warnings = p.normalizedSpec(ctx, domain, metadata)
+	return result, nil
+}
+
+// normalizedSpec converts a domain definition into the create request that would produce it.
+// Definitions Create cannot reproduce are reported as warnings instead of being dropped silently.
+func (p *Provider) normalizedSpec(ctx context.Context, domain *domainXML, metadata map[string]string) (contracts.CreateRequest, []string) {
+	var warnings []string
+
+	spec := contracts.CreateRequest{
+		Name: domain.Name,
+		UUID: domain.UUID,
+		Class: contracts.VMClass{
+			CPU:       domain.VCPU,
+			MemoryMiB: int32(domain.Memory.MiB()),
+		},
+		VMMetadata: metadata,
+	}
+
+	switch domain.firmware() {
+	case firmwareUEFI:
+		spec.Class.Firmware = firmwareUEFI
+	case firmwareUEFISecure:
+		spec.Class.Firmware = firmwareUEFISecure
+		spec.Class.SecurityProfile = &contracts.SecurityProfile{SecureBoot: true}
+	}
+	for _, tpm := range domain.Devices.TPMs {
+		if tpm.Backend.Type != "emulator" {
+			warnings = append(warnings, fmt.Sprintf("TPM backend %s is not supported, only emulated TPMs are", tpm.Backend.Type))
+			continue
+		}
+		if spec.Class.SecurityProfile == nil {
+			spec.Class.SecurityProfile = &contracts.SecurityProfile{}
+		}
+		spec.Class.SecurityProfile.TPMEnabled = true
+		spec.Class.SecurityProfile.TPMVersion = tpm.Backend.Version
+	}
+	spec.RNG = contractRNG(domain.Devices.RNGs)
+	spec.Graphics = contractGraphics(domain)
+	spec.Inputs = contractInputs(domain)
+	spec.Watchdog = contractWatchdog(domain)
+	spec.OnCrash = contractOnCrash(domain)
+	spec.Clock = contractClock(domain.Clock)
+
+	for _, disk := range domain.Devices.Disks {
+		if disk.Device != "" && disk.Device != "disk" {
+			// Cloud-init seeds and installer media are not part of the spec
+			continue
+		}
+		root := len(spec.Disks) == 0
+		spec.Disks = append(spec.Disks, contracts.DiskSpec{
+			Name:      disk.Target.Dev,
+			SizeGiB:   p.diskSizeGiB(ctx, domain.Name, disk.Target.Dev),
+			Bus:       disk.Target.Bus,
+			CacheMode: disk.Driver.Cache,
+			IOMode:    disk.Driver.IO,
+			Discard:   disk.Driver.Discard,
+			Target:    disk.Target.Dev,
+			Shareable: disk.Shareable != nil,
+		})
+		if format := disk.Driver.Type; format == diskFormatRaw && !root {
+			spec.Disks[len(spec.Disks)-1].Format = diskFormatRaw
+		} else if format != "" && format != diskFormatQcow2 {
+			warnings = append(warnings, fmt.Sprintf("disk %s: format %s is not reproduced, Create uses qcow2", disk.Target.Dev, format))
+		}
+	}
+
+	for _, iface := range domain.Devices.Interfaces {
+		attachment := contracts.NetworkAttachment{
+			NetworkName: iface.Source.Network,
+			Bridge:      iface.Source.Bridge,
+			Model:       iface.Model.Type,
+			MacAddress:  strings.ToLower(iface.MAC.Address),
+			Bandwidth:   contractBandwidth(iface.Bandwidth),
+		}
+		switch iface.Type {
+		case "network":
+			attachment.Name = iface.Source.Network
+		case "bridge":
+			attachment.Name = iface.Source.Bridge
+		case "user":
+			attachment.Name = "user"
+		default:
+			warnings = append(warnings, fmt.Sprintf("interface %s: type %s is not supported", attachment.MacAddress, iface.Type))
+			continue
+		}
+		spec.Networks = append(spec.Networks, attachment)
+	}
+
+	return spec, warnings
+}
+
+// diskSizeGiB reports the capacity of a domain disk in GiB, rounded up; 0 if unknown
+func (p *Provider) diskSizeGiB(ctx context.Context, vmID, target string) int32 {
+	result, err := p.virshProvider.runVirshCommand(ctx, "domblkinfo", vmID, target)
+	if err != nil {
+		log.Printf("WARN Failed to get size of disk %s of %s: %v", target, vmID, err)
+		return 0
+	}
+	capacity, err := parseStorageSize(parseColonFields(result.Stdout)["Capacity"])
+	if err != nil {
+		return 0
+	}
+	const gib = 1024 * 1024 * 1024
+	return int32((capacity + gib - 1) / gib)
+}
+
+// contractBandwidth converts the shaping of a domain interface back into its request form
+func contractBandwidth(bandwidth *domainBandwidth) *contracts.NetworkBandwidth {
+	if bandwidth == nil {
+		return nil
+	}
+	convert := func(limit *domainBandwidthLimit) *contracts.BandwidthLimit {
+		if limit == nil {
+			return nil
+		}
+		return &contracts.BandwidthLimit{AverageKiBps: limit.Average, PeakKiBps: limit.Peak, BurstKiB: limit.Burst}
+	}
+	return &contracts.NetworkBandwidth{Inbound: convert(bandwidth.Inbound), Outbound: convert(bandwidth.Outbound)}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Volume allocation policies of DiskSpec.AllocationPolicy
+const (
+	// allocationThin allocates space as the guest writes it
+	allocationThin = "thin"
+	// allocationThick preallocates the whole capacity when the volume is created
+	allocationThick = "thick"
+	// allocationFalloc reserves the whole capacity with fallocate without writing it
+	allocationFalloc = "falloc"
+)
+
+// allocationPolicies lists the supported allocation policies
+var allocationPolicies = []string{allocationThin, allocationThick, allocationFalloc}
+
+// validateAllocationPolicy checks the allocation policy of a disk the provider creates
+func validateAllocationPolicy(disk contracts.DiskSpec) error {
+	if disk.AllocationPolicy == "" {
+		return nil
+	}
+	if !slices.Contains(allocationPolicies, disk.AllocationPolicy) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: unsupported allocation policy %q (supported: %s)",
+			disk.Name, disk.AllocationPolicy, strings.Join(allocationPolicies, ", ")), nil)
+	}
+	if isReferencedDisk(disk) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"disk %q: an allocation policy only applies to disks the provider creates", disk.Name), nil)
+	}
+	return nil
+}
+
+// validatePoolAllocation checks that a storage pool can allocate volumes with a policy:
+// file-based pools support every policy, LVM pools only thick volumes
+func validatePoolAllocation(pool poolDefinition, policy string) error {
+	if policy == "" || slices.Contains(fileBasedPoolTypes, pool.Type) {
+		return nil
+	}
+	if pool.Type == poolTypeLogical && policy == allocationThick {
+		return nil
+	}
+	return contracts.NewInvalidSpecError(fmt.Sprintf(
+		"storage pool %s of type %s does not support %s allocation", pool.Name, pool.Type, policy), nil)
+}
+
+// qemuPreallocation returns the qemu-img preallocation mode of an allocation policy
+func qemuPreallocation(policy string) string {
+	switch policy {
+	case allocationThick:
+		return "full"
+	case allocationFalloc:
+		return "falloc"
+	case allocationThin:
+		return "off"
+	}
+	return ""
+}
+
+// volumeAllocationArgs returns the vol-create-as arguments for an allocation policy:
+// thin volumes start with no allocation and thick ones with their whole capacity
+func volumeAllocationArgs(policy, size string) []string {
+	switch policy {
+	case allocationThin:
+		return []string{"--allocation", "0"}
+	case allocationThick:
+		return []string{"--allocation", size}
+	}
+	return nil
+}
+
+// createFallocVolume creates an empty volume whose space is reserved with fallocate.
+// libvirt has no such mode, so the image is created with qemu-img and the pool refreshed.
+func (s *StorageProvider) createFallocVolume(ctx context.Context, poolName, volumeName, format, size string) error {
+	poolInfo, err := s.GetPoolInfo(ctx, poolName)
+	if err != nil {
+		return fmt.Errorf("failed to get pool info: %w", err)
+	}
+	path := filepath.Join(poolInfo.Path, volumeName)
+	result, err := s.virshProvider.runVirshCommand(ctx, "!", "qemu-img", "create", "-f", format,
+		"-o", "preallocation=falloc", path, size)
+	if err != nil {
+		return fmt.Errorf("failed to create volume: %w, output: %s", err, result.Stderr)
+	}
+	if _, err := s.virshProvider.runVirshCommand(ctx, "pool-refresh", poolName); err != nil {
+		log.Printf("WARN Failed to refresh storage pool: %v", err)
+	}
+	return nil
+}
+
+// diskAllocations reports the capacity and actual allocation of the disks of a domain
+func (p *Provider) diskAllocations(ctx context.Context, vmID string, domain *domainXML) []contracts.DiskAllocation {
+	var allocations []contracts.DiskAllocation
+	for _, disk := range domain.Devices.Disks {
+		if disk.Device != "disk" || disk.Target.Dev == "" {
+			continue
+		}
+		result, err := p.virshProvider.runVirshCommand(ctx, "domblkinfo", vmID, disk.Target.Dev)
+		if err != nil {
+			log.Printf("WARN Failed to get allocation of disk %s of %s: %v", disk.Target.Dev, vmID, err)
+			continue
+		}
+		fields := parseColonFields(result.Stdout)
+		allocation := contracts.DiskAllocation{Target: disk.Target.Dev, Path: disk.Source.File}
+		if allocation.Path == "" {
+			allocation.Path = disk.Source.Dev
+		}
+		allocation.CapacityBytes, _ = parseStorageSize(fields["Capacity"])
+		allocation.AllocationBytes, _ = parseStorageSize(fields["Allocation"])
+		allocation.PhysicalBytes, _ = parseStorageSize(fields["Physical"])
+		allocations = append(allocations, allocation)
+	}
+	return allocations
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// balloonSettleTimeout bounds how long SetBalloonMemory waits for the guest to reach the target
+	balloonSettleTimeout = 5 * time.Second
+
+	// balloonPollInterval is how often the balloon size is sampled while settling
+	balloonPollInterval = 500 * time.Millisecond
+)
+
+// SetBalloonMemory changes the current memory of a domain through the balloon driver.
+// The target may not exceed the domain's maximum memory; growing beyond it needs a cold resize.
+// It returns the memory in MiB the guest actually reports, which may lag behind the target.
+func (p *Provider) SetBalloonMemory(ctx context.Context, vmID string, targetMiB int64) (int64, error) {
+	if p.virshProvider == nil {
+		return 0, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if targetMiB <= 0 {
+		return 0, contracts.NewInvalidSpecError("target memory must be positive", nil)
+	}
+
+	info, err := p.virshProvider.runVirshCommand(ctx, "dominfo", vmID)
+	if err != nil {
+		return 0, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	fields := parseColonFields(info.Stdout)
+	maxMiB := parseKiB(fields["Max memory"]) / (1024 * 1024)
+	if maxMiB > 0 && targetMiB > maxMiB {
+		return 0, contracts.NewInvalidSpecError(fmt.Sprintf(
+			"target memory %d MiB exceeds the maximum memory of %d MiB; a cold resize with the VM stopped is required",
+			targetMiB, maxMiB), nil)
+	}
+
+	scope := changeScope{live: fields["State"] == "running", transient: fields["Persistent"] == "no"}
+	live := scope.live
+	targetKiB := targetMiB * 1024
+	args := append([]string{"setmem", vmID, strconv.FormatInt(targetKiB, 10)}, scope.flags()...)
+	log.Printf("INFO Setting memory of domain %s to %d MiB (live: %t)", vmID, targetMiB, live)
+	if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+		return 0, fmt.Errorf("failed to set domain memory: %w", err)
+	}
+
+	if !live {
+		return targetMiB, nil
+	}
+
+	// Wait briefly for the balloon driver, then report whatever the guest has reached
+	actualKiB := p.balloonActualKiB(ctx, vmID)
+	deadline := time.Now().Add(balloonSettleTimeout)
+	for actualKiB != targetKiB && time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return actualKiB / 1024, nil
+		case <-time.After(balloonPollInterval):
+		}
+		actualKiB = p.balloonActualKiB(ctx, vmID)
+	}
+	if actualKiB != targetKiB {
+		log.Printf("WARN Balloon of domain %s at %d MiB, still settling towards %d MiB", vmID, actualKiB/1024, targetMiB)
+	}
+	return actualKiB / 1024, nil
+}
+
+// balloonActualKiB returns the current balloon size of a running domain, or 0 if unknown
+func (p *Provider) balloonActualKiB(ctx context.Context, vmID string) int64 {
+	stats, err := p.virshProvider.getDomainMemoryStats(ctx, vmID)
+	if err != nil {
+		return 0
+	}
+	actual, err := strconv.ParseInt(stats["memory_actual"], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return actual
+}
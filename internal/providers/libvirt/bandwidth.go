@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// domainBandwidth is the <bandwidth> element of a domain interface
+type domainBandwidth struct {
+	Inbound  *domainBandwidthLimit `xml:"inbound"`
+	Outbound *domainBandwidthLimit `xml:"outbound"`
+}
+
+// domainBandwidthLimit holds libvirt's rates (KiB/s) and burst size (KiB)
+type domainBandwidthLimit struct {
+	Average int64 `xml:"average,attr"`
+	Peak    int64 `xml:"peak,attr"`
+	Burst   int64 `xml:"burst,attr"`
+}
+
+// bandwidthXML renders the <bandwidth> element for an interface, indented by indent
+func bandwidthXML(bandwidth *contracts.NetworkBandwidth, indent string) string {
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n" + indent + "<bandwidth>")
+	for _, rule := range []struct {
+		name  string
+		limit *contracts.BandwidthLimit
+	}{
+		{"inbound", bandwidth.Inbound},
+		{"outbound", bandwidth.Outbound},
+	} {
+		if rule.limit == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s  <%s average='%d'", indent, rule.name, rule.limit.AverageKiBps)
+		if rule.limit.PeakKiBps > 0 {
+			fmt.Fprintf(&b, " peak='%d'", rule.limit.PeakKiBps)
+		}
+		if rule.limit.BurstKiB > 0 {
+			fmt.Fprintf(&b, " burst='%d'", rule.limit.BurstKiB)
+		}
+		b.WriteString("/>")
+	}
+	b.WriteString("\n" + indent + "</bandwidth>")
+	return b.String()
+}
+
+// bandwidthEqual reports whether a domain interface already has the desired shaping
+func bandwidthEqual(current *domainBandwidth, desired *contracts.NetworkBandwidth) bool {
+	limitEqual := func(c *domainBandwidthLimit, d *contracts.BandwidthLimit) bool {
+		if c == nil || d == nil {
+			return c == nil && d == nil
+		}
+		return c.Average == d.AverageKiBps && c.Peak == d.PeakKiBps && c.Burst == d.BurstKiB
+	}
+
+	var currentIn, currentOut *domainBandwidthLimit
+	if current != nil {
+		currentIn, currentOut = current.Inbound, current.Outbound
+	}
+	var desiredIn, desiredOut *contracts.BandwidthLimit
+	if desired != nil {
+		desiredIn, desiredOut = desired.Inbound, desired.Outbound
+	}
+	return limitEqual(currentIn, desiredIn) && limitEqual(currentOut, desiredOut)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// blockJobPollInterval is how often block job progress is sampled
+	blockJobPollInterval = 2 * time.Second
+
+	// jobAbortTimeout bounds the cleanup run after the context of an operation ended
+	jobAbortTimeout = 30 * time.Second
+)
+
+// abortContext returns a short-lived context for aborting work whose own context has ended
+func abortContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), jobAbortTimeout)
+}
+
+// waitBlockJob polls the block job on a disk (virDomainGetBlockJobInfo), reporting the bytes
+// processed out of the job's end, until it finishes or, for jobs that must be pivoted (active
+// commit, copy), until it is ready to pivot.
+// When ctx is cancelled the job is aborted so it does not outlive the caller.
+func (p *Provider) waitBlockJob(ctx context.Context, vmID, disk string, onProgress func(cur, end int64)) (ready bool, err error) {
+	ticker := time.NewTicker(blockJobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.abortBlockJob(vmID, disk)
+			return false, fmt.Errorf("block job on %s/%s cancelled: %w", vmID, disk, ctx.Err())
+		case <-ticker.C:
+			result, err := p.virshProvider.runVirshCommand(ctx, "blockjob", vmID, disk, "--raw")
+			if err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				return false, fmt.Errorf("failed to query block job on %s/%s: %w", vmID, disk, err)
+			}
+			cur, end, active := parseBlockJobRaw(result.Stdout)
+			if !active {
+				return false, nil
+			}
+			if onProgress != nil && end > 0 {
+				onProgress(cur, end)
+			}
+			if end > 0 && cur == end {
+				return true, nil
+			}
+		}
+	}
+}
+
+// blockJobGuard prevents starting a second block job on a disk with one in progress
+type blockJobGuard struct {
+	mu     sync.Mutex
+	active map[string]bool
+}
+
+// acquire claims a disk for a block job; ok is false if a job started by this provider runs on it
+func (g *blockJobGuard) acquire(vmID, disk string) (release func(), ok bool) {
+	key := vmID + "/" + disk
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.active[key] {
+		return nil, false
+	}
+	if g.active == nil {
+		g.active = make(map[string]bool)
+	}
+	g.active[key] = true
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		delete(g.active, key)
+	}, true
+}
+
+// blockJobActive reports whether libvirt runs any block job on a disk, including ones started elsewhere
+func (p *Provider) blockJobActive(ctx context.Context, vmID, disk string) (bool, error) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "blockjob", vmID, disk, "--raw")
+	if err != nil {
+		return false, err
+	}
+	_, _, active := parseBlockJobRaw(result.Stdout)
+	return active, nil
+}
+
+// abortBlockJob cancels the block job on a disk, e.g. after the caller gave up on it
+func (p *Provider) abortBlockJob(vmID, disk string) {
+	ctx, cancel := abortContext()
+	defer cancel()
+
+	log.Printf("WARN Aborting block job on %s/%s", vmID, disk)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "blockjob", vmID, disk, "--abort"); err != nil {
+		log.Printf("WARN Failed to abort block job on %s/%s: %v", vmID, disk, err)
+	}
+}
+
+// parseBlockJobRaw parses "virsh blockjob --raw" output (" type=2 bandwidth=0 cur=42 end=100");
+// a disk without a job prints "No current block job for vda" instead
+func parseBlockJobRaw(output string) (cur, end int64, active bool) {
+	for _, field := range strings.Fields(output) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "cur":
+			cur, _ = strconv.ParseInt(value, 10, 64)
+			active = true
+		case "end":
+			end, _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+	return cur, end, active
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// maxBootMenuTimeoutMs is the longest boot menu timeout libvirt accepts
+const maxBootMenuTimeoutMs = 65535
+
+// Boot devices as reported by Describe
+const (
+	bootDeviceDisk    = "disk"
+	bootDeviceCDROM   = "cdrom"
+	bootDeviceNetwork = "network"
+	bootDeviceFloppy  = "floppy"
+)
+
+// domainBoot is an <os><boot dev='...'/> entry, the domain-wide boot order
+type domainBoot struct {
+	Dev string `xml:"dev,attr"`
+}
+
+// domainBootOrder is a per-device <boot order='N'/> element, which replaces the domain-wide order
+type domainBootOrder struct {
+	Order int `xml:"order,attr"`
+}
+
+// bootOrder returns the boot devices of the domain in the order they are tried. Per-device
+// boot orders take precedence over the <os> entries; libvirt rejects mixing the two.
+func (d *domainXML) bootOrder() []string {
+	type entry struct {
+		order  int
+		device string
+	}
+	var entries []entry
+	for _, disk := range d.Devices.Disks {
+		if disk.Boot == nil {
+			continue
+		}
+		device := bootDeviceDisk
+		switch disk.Device {
+		case "cdrom":
+			device = bootDeviceCDROM
+		case "floppy":
+			device = bootDeviceFloppy
+		}
+		entries = append(entries, entry{disk.Boot.Order, device})
+	}
+	for _, iface := range d.Devices.Interfaces {
+		if iface.Boot != nil {
+			entries = append(entries, entry{iface.Boot.Order, bootDeviceNetwork})
+		}
+	}
+
+	var order []string
+	if len(entries) > 0 {
+		slices.SortStableFunc(entries, func(a, b entry) int { return a.order - b.order })
+		for _, e := range entries {
+			order = append(order, e.device)
+		}
+		return order
+	}
+
+	for _, boot := range d.OS.Boot {
+		switch boot.Dev {
+		case "hd":
+			order = append(order, bootDeviceDisk)
+		case "cdrom":
+			order = append(order, bootDeviceCDROM)
+		case "network":
+			order = append(order, bootDeviceNetwork)
+		case "fd":
+			order = append(order, bootDeviceFloppy)
+		}
+	}
+	return order
+}
+
+// validateBootSpec checks that the boot order names each device once and only devices the
+// VM is created with: the root disk, the cloud-init CD-ROM and the network interfaces
+func validateBootSpec(req contracts.CreateRequest) error {
+	boot := req.Boot
+	if boot == nil {
+		return nil
+	}
+	if boot.MenuTimeoutMs < 0 || boot.MenuTimeoutMs > maxBootMenuTimeoutMs {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("boot menu timeout must be between 0 and %d ms", maxBootMenuTimeoutMs), nil)
+	}
+
+	seen := make(map[string]bool, len(boot.Order))
+	for _, device := range boot.Order {
+		device = strings.ToLower(device)
+		switch device {
+		case bootDeviceDisk, bootDeviceNetwork:
+		case bootDeviceCDROM:
+			// Ignition configs are passed through fw_cfg, so those VMs have no CD-ROM
+			if isIgnitionUserData(req.UserData) {
+				return contracts.NewInvalidSpecError("boot order references a cdrom, but VMs provisioned with Ignition have none", nil)
+			}
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported boot device %q (supported: disk, cdrom, network)", device), nil)
+		}
+		if seen[device] {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("boot device %s is listed twice", device), nil)
+		}
+		seen[device] = true
+	}
+	return nil
+}
+
+// bootDeviceOrder maps the requested boot devices to their 1-based boot order
+func bootDeviceOrder(boot *contracts.BootConfig) map[string]int {
+	order := make(map[string]int)
+	if boot == nil {
+		return order
+	}
+	for i, device := range boot.Order {
+		order[strings.ToLower(device)] = i + 1
+	}
+	return order
+}
+
+// osBootXML renders the boot entries of the <os> element. The default order is used unless
+// the request sets one, which is rendered as per-device boot orders instead.
+func osBootXML(boot *contracts.BootConfig) string {
+	var b strings.Builder
+	if boot == nil || len(boot.Order) == 0 {
+		b.WriteString("\n    <boot dev='hd'/>\n    <boot dev='cdrom'/>")
+	}
+	if boot != nil && boot.MenuTimeoutMs > 0 {
+		fmt.Fprintf(&b, "\n    <bootmenu enable='yes' timeout='%d'/>", boot.MenuTimeoutMs)
+	}
+	return b.String()
+}
+
+// withBootOrder adds a <boot order='N'/> element to the first device of the rendered XML;
+// an order of 0 leaves the XML unchanged
+func withBootOrder(devicesXML, element string, order int) string {
+	if order == 0 {
+		return devicesXML
+	}
+	closing := "\n    </" + element + ">"
+	return strings.Replace(devicesXML, closing, fmt.Sprintf("\n      <boot order='%d'/>", order)+closing, 1)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+// ProviderType identifies this provider in GetCapabilities
+const ProviderType = "libvirt"
+
+// SupportedPlatforms are the hypervisor platforms this provider manages
+var SupportedPlatforms = []string{"kvm", "qemu", "libvirt"}
+
+// baseCapabilities are the features supported regardless of the provider options
+var baseCapabilities = []string{
+	"core", "snapshots", "linked-clones",
+	"online-reconfigure", "qemu-guest-agent", "migration",
+	"console", "external-snapshots", "pci-passthrough", "usb-passthrough", "pxe-boot",
+	"events", "garbage-collection", "operation-progress", "guest-hostname",
+	"ephemeral-vms",
+}
+
+// Capabilities lists the features this provider supports with its current options.
+// It is both advertised at startup and returned by GetCapabilities.
+func (p *Provider) Capabilities() []string {
+	capabilities := append([]string(nil), baseCapabilities...)
+	if p.options.EnableIgnition {
+		capabilities = append(capabilities, "ignition")
+	}
+	if p.options.AllowGuestExec {
+		capabilities = append(capabilities, "guest-exec")
+	}
+	if p.options.AllowDebugRPCs {
+		capabilities = append(capabilities, "debug-rpcs")
+	}
+	if p.options.AllowQEMUPassthrough {
+		capabilities = append(capabilities, "qemu-passthrough")
+	}
+	if p.sriovSupported.Load() {
+		capabilities = append(capabilities, "sriov")
+	}
+	return capabilities
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// DefaultOvercommitRatio reports the physical CPUs and memory as the schedulable capacity
+const DefaultOvercommitRatio = 1.0
+
+// HostCapacity describes the resources of the hypervisor host and how much is in use
+type HostCapacity struct {
+	// CPUs is the number of logical host CPUs
+	CPUs int64
+	// AllocatedVCPUs is the sum of vCPUs of running domains
+	AllocatedVCPUs int64
+	// MemoryBytes is the total host memory
+	MemoryBytes int64
+	// AllocatedMemoryBytes is the sum of current memory of running domains
+	AllocatedMemoryBytes int64
+	// FreeMemoryBytes is the memory the host reports as free
+	FreeMemoryBytes int64
+	// StoragePools lists the active storage pools
+	StoragePools []PoolCapacity
+	// RunningDomains is the number of running domains
+	RunningDomains int
+	// TotalDomains is the number of defined domains
+	TotalDomains int
+	// CPUOvercommit and MemoryOvercommit are the configured overcommit ratios
+	CPUOvercommit    float64
+	MemoryOvercommit float64
+	// SchedulableCPUs and SchedulableMemoryBytes are the physical totals scaled by the
+	// overcommit ratios, the capacity a scheduler may hand out to VMs
+	SchedulableCPUs        int64
+	SchedulableMemoryBytes int64
+}
+
+// PoolCapacity describes the space of a storage pool in bytes
+type PoolCapacity struct {
+	Name           string
+	CapacityBytes  int64
+	AllocatedBytes int64
+	AvailableBytes int64
+}
+
+// GetCapacity collects host CPU, memory and storage capacity and current allocation
+func (p *Provider) GetCapacity(ctx context.Context) (HostCapacity, error) {
+	var capacity HostCapacity
+
+	if p.virshProvider == nil {
+		return capacity, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	nodeInfo, err := p.virshProvider.runVirshCommand(ctx, "nodeinfo")
+	if err != nil {
+		return capacity, contracts.NewRetryableError("failed to get node info", err)
+	}
+	for key, value := range parseColonFields(nodeInfo.Stdout) {
+		switch key {
+		case "CPU(s)":
+			capacity.CPUs, _ = strconv.ParseInt(value, 10, 64)
+		case "Memory size":
+			capacity.MemoryBytes = parseKiB(value)
+		}
+	}
+
+	memStats, err := p.virshProvider.runVirshCommand(ctx, "nodememstats")
+	if err != nil {
+		return capacity, contracts.NewRetryableError("failed to get node memory stats", err)
+	}
+	if free, ok := parseColonFields(memStats.Stdout)["free"]; ok {
+		capacity.FreeMemoryBytes = parseKiB(free)
+	}
+
+	if err := p.collectDomainAllocation(ctx, &capacity); err != nil {
+		return capacity, err
+	}
+
+	capacity.CPUOvercommit, capacity.MemoryOvercommit = p.overcommitRatios()
+	capacity.SchedulableCPUs = int64(float64(capacity.CPUs) * capacity.CPUOvercommit)
+	capacity.SchedulableMemoryBytes = int64(float64(capacity.MemoryBytes) * capacity.MemoryOvercommit)
+
+	pools, err := p.virshProvider.runVirshCommand(ctx, "pool-list", "--name")
+	if err != nil {
+		return capacity, contracts.NewRetryableError("failed to list storage pools", err)
+	}
+	for _, name := range strings.Split(strings.TrimSpace(pools.Stdout), "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		pool, err := p.getPoolCapacity(ctx, name)
+		if err != nil {
+			log.Printf("WARN Failed to get capacity of storage pool %s: %v", name, err)
+			continue
+		}
+		capacity.StoragePools = append(capacity.StoragePools, pool)
+	}
+
+	return capacity, nil
+}
+
+// overcommitRatios returns the configured CPU and memory overcommit ratios
+func (p *Provider) overcommitRatios() (cpu, memory float64) {
+	cpu, memory = p.options.CPUOvercommit, p.options.MemoryOvercommit
+	if cpu <= 0 {
+		cpu = DefaultOvercommitRatio
+	}
+	if memory <= 0 {
+		memory = DefaultOvercommitRatio
+	}
+	return cpu, memory
+}
+
+// SchedulableAvailableCPUs is the schedulable vCPU capacity not allocated to running domains
+func (c HostCapacity) SchedulableAvailableCPUs() int64 {
+	return max(c.SchedulableCPUs-c.AllocatedVCPUs, 0)
+}
+
+// SchedulableAvailableMemoryBytes is the schedulable memory not allocated to running domains
+func (c HostCapacity) SchedulableAvailableMemoryBytes() int64 {
+	return max(c.SchedulableMemoryBytes-c.AllocatedMemoryBytes, 0)
+}
+
+// commitmentRatio is how much of a physical resource is allocated; above 1 it is overcommitted
+func commitmentRatio(allocated, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(allocated) / float64(total)
+}
+
+// collectDomainAllocation sums the vCPUs and memory of running domains and counts domains
+func (p *Provider) collectDomainAllocation(ctx context.Context, capacity *HostCapacity) error {
+	all, err := p.virshProvider.runVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return contracts.NewRetryableError("failed to list domains", err)
+	}
+	for _, line := range strings.Split(all.Stdout, "\n") {
+		if strings.TrimSpace(line) != "" {
+			capacity.TotalDomains++
+		}
+	}
+
+	stats, err := p.virshProvider.runVirshCommand(ctx, "domstats", "--list-running", "--vcpu", "--balloon")
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain stats", err)
+	}
+	for _, line := range strings.Split(stats.Stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "Domain:") {
+			capacity.RunningDomains++
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "vcpu.current":
+			capacity.AllocatedVCPUs += n
+		case "balloon.current":
+			capacity.AllocatedMemoryBytes += n * 1024
+		}
+	}
+	return nil
+}
+
+// getPoolCapacity reads the capacity, allocation and free space of a storage pool
+func (p *Provider) getPoolCapacity(ctx context.Context, name string) (PoolCapacity, error) {
+	pool := PoolCapacity{Name: name}
+
+	result, err := p.virshProvider.runVirshCommand(ctx, "pool-info", "--bytes", name)
+	if err != nil {
+		return pool, err
+	}
+	for key, value := range parseColonFields(result.Stdout) {
+		size, err := parseStorageSize(value)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "Capacity":
+			pool.CapacityBytes = size
+		case "Allocation":
+			pool.AllocatedBytes = size
+		case "Available":
+			pool.AvailableBytes = size
+		}
+	}
+	return pool, nil
+}
+
+// parseColonFields parses virsh "Key: value" output into a map
+func parseColonFields(output string) map[string]string {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return fields
+}
+
+// parseKiB converts a virsh memory figure such as "16303784 KiB" to bytes
+func parseKiB(value string) int64 {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0
+	}
+	n, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n * 1024
+}
+
+// String summarises the capacity for logging
+func (c HostCapacity) String() string {
+	return fmt.Sprintf("cpus=%d/%d (schedulable %d) memory=%d/%d bytes (schedulable %d) pools=%d running=%d/%d",
+		c.AllocatedVCPUs, c.CPUs, c.SchedulableCPUs, c.AllocatedMemoryBytes, c.MemoryBytes, c.SchedulableMemoryBytes,
+		len(c.StoragePools), c.RunningDomains, c.TotalDomains)
+}
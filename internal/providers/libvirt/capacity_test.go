@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capacityVirsh reports a host with CAP_CPUS CPUs and CAP_MEMORY_KIB of memory, running
+// one domain that uses CAP_VCPUS vCPUs and CAP_BALLOON_KIB of memory
+const capacityVirsh = `#!/bin/sh
+case "$1" in
+nodeinfo)
+	printf 'CPU model:           x86_64\nCPU(s):              %s\nMemory size:         %s KiB\n' "$CAP_CPUS" "$CAP_MEMORY_KIB"
+	;;
+nodememstats)
+	printf 'total  :             %s KiB\nfree   :             1024 KiB\n' "$CAP_MEMORY_KIB"
+	;;
+list)
+	printf 'vm-a\nvm-b\n'
+	;;
+domstats)
+	printf 'Domain: '"'"'vm-a'"'"'\n  balloon.current=%s\n  vcpu.current=%s\n\n' "$CAP_BALLOON_KIB" "$CAP_VCPUS"
+	;;
+esac
+exit 0
+`
+
+const gib = 1024 * 1024 * 1024
+
+// capacityOf runs GetCapacity against a scripted host with the given ratios
+func capacityOf(t *testing.T, cpus, memoryGiB, vcpus, balloonGiB int64, cpuRatio, memoryRatio float64) HostCapacity {
+	t.Helper()
+	t.Setenv("CAP_CPUS", strconv.FormatInt(cpus, 10))
+	t.Setenv("CAP_MEMORY_KIB", strconv.FormatInt(memoryGiB*1024*1024, 10))
+	t.Setenv("CAP_VCPUS", strconv.FormatInt(vcpus, 10))
+	t.Setenv("CAP_BALLOON_KIB", strconv.FormatInt(balloonGiB*1024*1024, 10))
+
+	p, _ := newScriptedVirshProvider(t, capacityVirsh)
+	p.options.CPUOvercommit = cpuRatio
+	p.options.MemoryOvercommit = memoryRatio
+
+	capacity, err := p.GetCapacity(context.Background())
+	require.NoError(t, err)
+	return capacity
+}
+
+func TestOvercommitRatiosDefault(t *testing.T) {
+	tests := []struct {
+		name                string
+		cpu, memory         float64
+		wantCPU, wantMemory float64
+	}{
+		{name: "unset", wantCPU: 1, wantMemory: 1},
+		{name: "negative", cpu: -2, memory: -0.5, wantCPU: 1, wantMemory: 1},
+		{name: "configured", cpu: 4, memory: 1.5, wantCPU: 4, wantMemory: 1.5},
+		{name: "undercommit", cpu: 0.5, memory: 0.8, wantCPU: 0.5, wantMemory: 0.8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{options: Options{CPUOvercommit: tt.cpu, MemoryOvercommit: tt.memory}}
+			cpu, memory := p.overcommitRatios()
+			assert.Equal(t, tt.wantCPU, cpu)
+			assert.Equal(t, tt.wantMemory, memory)
+		})
+	}
+}
+
+func TestGetCapacityOvercommit(t *testing.T) {
+	tests := []struct {
+		name                    string
+		cpus, memoryGiB         int64
+		vcpus, balloonGiB       int64
+		cpuRatio, memoryRatio   float64
+		wantCPUs, wantMemoryGiB int64
+		wantFreeCPUs            int64
+		wantFreeMemoryGiB       int64
+		wantCPUCommitment       float64
+		wantMemoryCommitment    float64
+	}{
+		{
+			name: "no overcommit", cpus: 8, memoryGiB: 32, vcpus: 4, balloonGiB: 8,
+			wantCPUs: 8, wantMemoryGiB: 32, wantFreeCPUs: 4, wantFreeMemoryGiB: 24,
+			wantCPUCommitment: 0.5, wantMemoryCommitment: 0.25,
+		},
+		{
+			name: "overcommitted cpu", cpus: 8, memoryGiB: 32, vcpus: 20, balloonGiB: 8, cpuRatio: 4, memoryRatio: 1,
+			wantCPUs: 32, wantMemoryGiB: 32, wantFreeCPUs: 12, wantFreeMemoryGiB: 24,
+			wantCPUCommitment: 2.5, wantMemoryCommitment: 0.25,
+		},
+		{
+			name: "overcommitted memory", cpus: 8, memoryGiB: 32, vcpus: 8, balloonGiB: 40, cpuRatio: 1, memoryRatio: 1.5,
+			wantCPUs: 8, wantMemoryGiB: 48, wantFreeCPUs: 0, wantFreeMemoryGiB: 8,
+			wantCPUCommitment: 1, wantMemoryCommitment: 1.25,
+		},
+		{
+			name: "fractional schedulable cpus round down", cpus: 5, memoryGiB: 16, vcpus: 0, balloonGiB: 0, cpuRatio: 1.5,
+			wantCPUs: 7, wantMemoryGiB: 16, wantFreeCPUs: 7, wantFreeMemoryGiB: 16,
+		},
+		{
+			name: "undercommit reserves host capacity", cpus: 16, memoryGiB: 64, vcpus: 8, balloonGiB: 32, cpuRatio: 0.5, memoryRatio: 0.75,
+			wantCPUs: 8, wantMemoryGiB: 48, wantFreeCPUs: 0, wantFreeMemoryGiB: 16,
+			wantCPUCommitment: 0.5, wantMemoryCommitment: 0.5,
+		},
+		{
+			name: "allocation beyond schedulable reports none free", cpus: 4, memoryGiB: 8, vcpus: 10, balloonGiB: 12, cpuRatio: 2, memoryRatio: 1,
+			wantCPUs: 8, wantMemoryGiB: 8, wantFreeCPUs: 0, wantFreeMemoryGiB: 0,
+			wantCPUCommitment: 2.5, wantMemoryCommitment: 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capacity := capacityOf(t, tt.cpus, tt.memoryGiB, tt.vcpus, tt.balloonGiB, tt.cpuRatio, tt.memoryRatio)
+
+			assert.Equal(t, tt.cpus, capacity.CPUs)
+			assert.Equal(t, tt.vcpus, capacity.AllocatedVCPUs)
+			assert.Equal(t, tt.balloonGiB*gib, capacity.AllocatedMemoryBytes)
+			assert.Equal(t, tt.wantCPUs, capacity.SchedulableCPUs)
+			assert.Equal(t, tt.wantMemoryGiB*gib, capacity.SchedulableMemoryBytes)
+			assert.Equal(t, tt.wantFreeCPUs, capacity.SchedulableAvailableCPUs())
+			assert.Equal(t, tt.wantFreeMemoryGiB*gib, capacity.SchedulableAvailableMemoryBytes())
+			assert.InDelta(t, tt.wantCPUCommitment, commitmentRatio(capacity.AllocatedVCPUs, capacity.CPUs), 1e-9)
+			assert.InDelta(t, tt.wantMemoryCommitment, commitmentRatio(capacity.AllocatedMemoryBytes, capacity.MemoryBytes), 1e-9)
+			assert.Equal(t, 2, capacity.TotalDomains)
+			assert.Equal(t, 1, capacity.RunningDomains)
+		})
+	}
+}
+
+func TestSchedulableCapacityBoundary(t *testing.T) {
+	// 8 CPUs at 2x and 16 GiB at 1.5x leave 16 vCPUs and 24 GiB to hand out
+	tests := []struct {
+		name              string
+		vcpus, balloonGiB int64
+		requestVCPUs      int64
+		requestMemoryGiB  int64
+		fits              bool
+	}{
+		{name: "just fits", vcpus: 12, balloonGiB: 20, requestVCPUs: 4, requestMemoryGiB: 4, fits: true},
+		{name: "one vCPU too many", vcpus: 12, balloonGiB: 20, requestVCPUs: 5, requestMemoryGiB: 4},
+		{name: "one GiB too many", vcpus: 12, balloonGiB: 20, requestVCPUs: 4, requestMemoryGiB: 5},
+		{name: "host full", vcpus: 16, balloonGiB: 24, requestVCPUs: 1, requestMemoryGiB: 1},
+		{name: "empty host takes everything", requestVCPUs: 16, requestMemoryGiB: 24, fits: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capacity := capacityOf(t, 8, 16, tt.vcpus, tt.balloonGiB, 2, 1.5)
+
+			fits := tt.requestVCPUs <= capacity.SchedulableAvailableCPUs() &&
+				tt.requestMemoryGiB*gib <= capacity.SchedulableAvailableMemoryBytes()
+			assert.Equal(t, tt.fits, fits, "available %d vCPUs, %d bytes",
+				capacity.SchedulableAvailableCPUs(), capacity.SchedulableAvailableMemoryBytes())
+		})
+	}
+}
+
+func TestCommitmentRatioWithoutCapacity(t *testing.T) {
+	assert.Zero(t, commitmentRatio(4, 0))
+	assert.Zero(t, commitmentRatio(4, -1))
+	assert.Zero(t, commitmentRatio(0, 8))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Guest clock offsets
+const (
+	clockOffsetUTC       = vmspec.ClockOffsetUTC
+	clockOffsetLocaltime = vmspec.ClockOffsetLocaltime
+)
+
+// Defaults the guest clock has without explicit settings; lost RTC ticks are caught up
+// and lost PIT ticks delivered late, which keeps Linux guests in sync
+const (
+	defaultRTCTickPolicy = "catchup"
+	defaultPITTickPolicy = "delay"
+)
+
+// domainClock describes the clock of a domain
+type domainClock struct {
+	Offset string        `xml:"offset,attr"`
+	Timers []domainTimer `xml:"timer"`
+}
+
+// domainTimer describes a timer of a domain clock
+type domainTimer struct {
+	Name       string `xml:"name,attr"`
+	TickPolicy string `xml:"tickpolicy,attr"`
+	Present    string `xml:"present,attr"`
+}
+
+// clockXML renders the <clock> element; unset fields keep the UTC clock without HPET
+func clockXML(clock *contracts.ClockConfig) string {
+	var settings contracts.ClockConfig
+	if clock != nil {
+		settings = *clock
+	}
+	if settings.Offset == "" {
+		settings.Offset = clockOffsetUTC
+	}
+	if settings.RTCTickPolicy == "" {
+		settings.RTCTickPolicy = defaultRTCTickPolicy
+	}
+	if settings.PITTickPolicy == "" {
+		settings.PITTickPolicy = defaultPITTickPolicy
+	}
+
+	hpet := "no"
+	if settings.HPET {
+		hpet = "yes"
+	}
+	hypervClock := ""
+	if settings.HyperVClock {
+		hypervClock = "\n    <timer name='hypervclock' present='yes'/>"
+	}
+	return fmt.Sprintf(`  <clock offset='%s'>
+    <timer name='rtc' tickpolicy='%s'/>
+    <timer name='pit' tickpolicy='%s'/>
+    <timer name='hpet' present='%s'/>%s
+  </clock>
+`, settings.Offset, settings.RTCTickPolicy, settings.PITTickPolicy, hpet, hypervClock)
+}
+
+// contractClock describes the clock of a domain as a create request setting; a clock
+// with the default settings yields nil
+func contractClock(clock domainClock) *contracts.ClockConfig {
+	settings := contracts.ClockConfig{Offset: clock.Offset}
+	for _, timer := range clock.Timers {
+		switch timer.Name {
+		case "rtc":
+			settings.RTCTickPolicy = timer.TickPolicy
+		case "pit":
+			settings.PITTickPolicy = timer.TickPolicy
+		case "hpet":
+			settings.HPET = timer.Present == "yes"
+		case "hypervclock":
+			settings.HyperVClock = timer.Present == "yes"
+		}
+	}
+	if settings.Offset == clockOffsetUTC {
+		settings.Offset = ""
+	}
+	if settings.RTCTickPolicy == defaultRTCTickPolicy {
+		settings.RTCTickPolicy = ""
+	}
+	if settings.PITTickPolicy == defaultPITTickPolicy {
+		settings.PITTickPolicy = ""
+	}
+	if settings == (contracts.ClockConfig{}) {
+		return nil
+	}
+	return &settings
+}
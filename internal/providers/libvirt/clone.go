@@ -0,0 +1,458 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// cloneTimeout bounds how long a full clone may copy disks
+	cloneTimeout = 4 * time.Hour
+
+	// clonePollInterval is how often copy progress is sampled
+	clonePollInterval = 2 * time.Second
+)
+
+var (
+	// cloneDiskPattern matches a <disk> element of a dumpxml definition
+	cloneDiskPattern = regexp.MustCompile(`(?s)\n[ \t]*<disk [^>]*>.*?</disk>`)
+
+	// cloneMACPattern matches a MAC address line; libvirt generates new addresses when it is absent
+	cloneMACPattern = regexp.MustCompile(`\n[ \t]*<mac address=['"][^'"]*['"]/>`)
+
+	// cloneUUIDPattern matches the domain UUID; libvirt generates a new one when it is absent
+	cloneUUIDPattern = regexp.MustCompile(`\n[ \t]*<uuid>[^<]*</uuid>`)
+
+	// cloneSysinfoPattern matches the fw_cfg sysinfo carrying the source's Ignition config
+	cloneSysinfoPattern = regexp.MustCompile(`(?s)\n[ \t]*<sysinfo type=['"]fwcfg['"]>.*?</sysinfo>`)
+
+	// cloneNVRAMPattern matches the per-VM UEFI variable store path; without it libvirt
+	// creates a fresh store for the clone from the template
+	cloneNVRAMPattern = regexp.MustCompile(`(<nvram[^>/]*)>[^<]*</nvram>`)
+)
+
+// CloneOptions selects how a domain is cloned
+type CloneOptions struct {
+	// Linked creates qcow2 overlays backed by the source disks instead of copying them
+	Linked bool
+}
+
+// CloneResult describes a completed or started clone
+type CloneResult struct {
+	// VMID identifies the new domain
+	VMID string
+	// Linked reports whether a linked clone was performed
+	Linked bool
+	// TaskRef tracks the disk copy of a full clone; empty when the clone completed synchronously
+	TaskRef string
+}
+
+// cloneDisk maps a writable source disk to the path of its clone
+type cloneDisk struct {
+	Source string
+	Target string
+	Image  imageInfo
+	// Pool is the storage pool whose target directory holds the clone, empty if none does
+	Pool string
+}
+
+// imageInfo is the subset of qemu-img info used for cloning
+type imageInfo struct {
+	Format      string `json:"format"`
+	ActualSize  int64  `json:"actual-size"`
+	VirtualSize int64  `json:"virtual-size"`
+}
+
+// CloneVM creates a new domain from a stopped source domain. Readonly media such as
+// cloud-init seeds are not carried over, and the clone gets a new UUID and MAC addresses.
+// Linked clones complete synchronously; full clones copy disks in the background and
+// report progress through the returned task. The overlays of a linked clone are backed by
+// the source disks, so the source is recorded as their template: it cannot be started,
+// snapshotted, reverted, migrated or deleted until its linked clones are gone.
+func (p *Provider) CloneVM(ctx context.Context, sourceID, targetName string, opts CloneOptions) (CloneResult, error) {
+	log.Printf("INFO Cloning VM %s to %s (linked: %t)", sourceID, targetName, opts.Linked)
+
+	result := CloneResult{VMID: targetName, Linked: opts.Linked}
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if sourceID == "" || targetName == "" {
+		return result, contracts.NewInvalidSpecError("source VM and target name are required", nil)
+	}
+
+	weight := int64(fullCloneOpWeight)
+	if opts.Linked {
+		weight = linkedCloneOpWeight
+	}
+	release, err := p.limiter.acquire(ctx, "Clone", weight)
+	if err != nil {
+		return result, err
+	}
+	defer func() { release() }()
+
+	if _, err := p.virshProvider.getDomainState(ctx, targetName); err == nil {
+		return result, contracts.NewConflictError(fmt.Sprintf("domain %s already exists", targetName), nil)
+	}
+
+	// A running source keeps writing to the disks the clone would be based on
+	state, err := p.virshProvider.getDomainState(ctx, sourceID)
+	if err != nil {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", sourceID), err)
+	}
+	if state != "shut off" {
+		return result, contracts.NewConflictError(fmt.Sprintf("source VM %s must be shut off to be cloned (state: %s)", sourceID, state), nil)
+	}
+
+	dump, err := p.virshProvider.runVirshCommand(ctx, "dumpxml", "--inactive", sourceID)
+	if err != nil {
+		return result, fmt.Errorf("failed to dump source domain XML: %w", err)
+	}
+
+	cloneXML, disks, err := p.renderCloneXML(dump.Stdout, sourceID, targetName)
+	if err != nil {
+		return result, err
+	}
+	if len(disks) == 0 {
+		return result, contracts.NewInvalidSpecError(fmt.Sprintf("source VM %s has no file-backed disk to clone", sourceID), nil)
+	}
+
+	for i := range disks {
+		// An existing file means another clone to this name is in progress or was left behind
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-e", disks[i].Target); err == nil {
+			return result, contracts.NewConflictError(fmt.Sprintf("clone disk %s already exists", disks[i].Target), nil)
+		}
+		info, err := p.imageInfo(ctx, disks[i].Source)
+		if err != nil {
+			return result, err
+		}
+		if opts.Linked && info.Format != "qcow2" && info.Format != "raw" {
+			return result, contracts.NewInvalidSpecError(fmt.Sprintf("disk %s has format %s; linked clones need a qcow2 or raw backing image", disks[i].Source, info.Format), nil)
+		}
+		disks[i].Image = info
+	}
+	if err := p.assignClonePools(ctx, disks); err != nil {
+		return result, contracts.NewRetryableError("failed to list storage pools", err)
+	}
+
+	if opts.Linked {
+		for i, disk := range disks {
+			if _, err := p.virshProvider.runVirshCommand(ctx, "!", "qemu-img", "create", "-f", "qcow2",
+				"-F", disk.Image.Format, "-b", disk.Source, disk.Target); err != nil {
+				p.removeCloneDisks(ctx, disks[:i])
+				return result, fmt.Errorf("failed to create overlay %s: %w", disk.Target, err)
+			}
+			log.Printf("INFO Created overlay %s backed by %s", disk.Target, disk.Source)
+		}
+		if err := p.defineClone(ctx, targetName, cloneXML, disks); err != nil {
+			return result, err
+		}
+		if err := p.recordLinkedClone(ctx, sourceID, targetName); err != nil {
+			// An unrecorded clone would be corrupted by the next start of its source
+			if undefineErr := p.undefineDomainWithState(ctx, targetName); undefineErr != nil {
+				log.Printf("WARN Failed to undefine unrecorded linked clone %s: %v", targetName, undefineErr)
+			}
+			p.removeCloneDisks(ctx, disks)
+			return result, contracts.NewRetryableError(fmt.Sprintf("failed to record linked clone %s on %s", targetName, sourceID), err)
+		}
+		log.Printf("INFO Successfully cloned VM %s to %s", sourceID, targetName)
+		return result, nil
+	}
+
+	// Fail before copying anything if a pool cannot hold the copies written to it
+	var required int64
+	perPool := map[string]int64{}
+	for _, disk := range disks {
+		required += disk.Image.ActualSize
+		if disk.Pool == "" {
+			log.Printf("WARN Clone disk %s is outside every storage pool; its free space is not checked", disk.Target)
+			continue
+		}
+		perPool[disk.Pool] += disk.Image.ActualSize
+	}
+	for poolName, poolRequired := range perPool {
+		pool, err := p.getPoolCapacity(ctx, poolName)
+		if err != nil {
+			return result, contracts.NewRetryableError(fmt.Sprintf("failed to get capacity of storage pool %s", poolName), err)
+		}
+		if poolRequired > pool.AvailableBytes {
+			return result, contracts.NewQuotaExceededError(fmt.Sprintf(
+				"storage pool %s has %d bytes available, full clone of %s needs %d bytes", poolName, pool.AvailableBytes, sourceID, poolRequired), nil)
+		}
+	}
+
+	result.TaskRef = fmt.Sprintf("task-clone-%s", generateTaskID())
+	p.tasks.start(result.TaskRef, fmt.Sprintf("Cloning %s to %s", sourceID, targetName))
+	log.Printf("INFO Starting full clone of %s to %s (%d bytes, task %s)", sourceID, targetName, required, result.TaskRef)
+
+	// The RPC context ends when the call returns, so copy detached; the copy keeps the concurrency
+	// slot and the lock of the source VM
+	copyRelease := release
+	release = func() {}
+	unlock := holdForTask(ctx)
+	go func() {
+		defer unlock()
+		defer copyRelease()
+		cloneCtx, cancel := context.WithTimeout(context.Background(), cloneTimeout)
+		defer cancel()
+
+		if err := p.copyCloneDisks(cloneCtx, result.TaskRef, disks, required); err != nil {
+			log.Printf("ERROR Full clone of %s to %s failed: %v", sourceID, targetName, err)
+			p.tasks.finish(result.TaskRef, err, "Clone failed")
+			return
+		}
+		if err := p.defineClone(cloneCtx, targetName, cloneXML, disks); err != nil {
+			log.Printf("ERROR Full clone of %s to %s failed: %v", sourceID, targetName, err)
+			p.tasks.finish(result.TaskRef, err, "Clone failed")
+			return
+		}
+		log.Printf("INFO Successfully cloned VM %s to %s", sourceID, targetName)
+		p.tasks.finish(result.TaskRef, nil, fmt.Sprintf("Cloned %s to %s", sourceID, targetName))
+	}()
+
+	return result, nil
+}
+
+// recordLinkedClone adds a linked clone to the record of its source domain
+func (p *Provider) recordLinkedClone(ctx context.Context, sourceID, cloneName string) error {
+	result, err := p.virshProvider.runVirshCommand(ctx, "domuuid", cloneName)
+	if err != nil {
+		return fmt.Errorf("failed to get UUID of %s: %w", cloneName, err)
+	}
+	clones, err := p.virshProvider.getLinkedClones(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	return p.virshProvider.setLinkedClones(ctx, sourceID, append(clones, strings.TrimSpace(result.Stdout)))
+}
+
+// linkedClones returns the names of the linked clones backed by the disks of a domain.
+// Clones that no longer exist are dropped from its record.
+func (p *Provider) linkedClones(ctx context.Context, id string) ([]string, error) {
+	uuids, err := p.virshProvider.getLinkedClones(ctx, id)
+	if err != nil || len(uuids) == 0 {
+		return nil, err
+	}
+
+	var names, remaining []string
+	for _, uuid := range uuids {
+		result, err := p.virshProvider.runVirshCommand(ctx, "domname", uuid)
+		if domainGone(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up linked clone %s: %w", uuid, err)
+		}
+		names = append(names, strings.TrimSpace(result.Stdout))
+		remaining = append(remaining, uuid)
+	}
+	if len(remaining) < len(uuids) {
+		if err := p.virshProvider.setLinkedClones(ctx, id, remaining); err != nil {
+			log.Printf("WARN Failed to drop deleted linked clones from %s: %v", id, err)
+		}
+	}
+	return names, nil
+}
+
+// checkNoLinkedClones refuses an operation that would write to or remove the disks of a
+// domain while linked clones are backed by them
+func (p *Provider) checkNoLinkedClones(ctx context.Context, id, operation string) error {
+	clones, err := p.linkedClones(ctx, id)
+	if err != nil {
+		return contracts.NewRetryableError(fmt.Sprintf("failed to read the linked clones of %s", id), err)
+	}
+	if len(clones) > 0 {
+		return contracts.NewConflictError(fmt.Sprintf(
+			"VM %s backs the disks of linked clones %s and cannot be %s until they are deleted", id, strings.Join(clones, ", "), operation), nil)
+	}
+	return nil
+}
+
+// copyCloneDisks copies each source disk to its clone path, reporting progress on the task
+func (p *Provider) copyCloneDisks(ctx context.Context, taskID string, disks []cloneDisk, total int64) error {
+	var copied int64
+	for i, disk := range disks {
+		done := make(chan error, 1)
+		go func(disk cloneDisk) {
+			_, err := p.virshProvider.runVirshJob(ctx, "!", "qemu-img", "convert", "-O", "qcow2", disk.Source, disk.Target)
+			done <- err
+		}(disk)
+
+		if err := p.watchCloneCopy(ctx, taskID, disk, copied, total, done); err != nil {
+			cleanupCtx, cancel := abortContext()
+			p.removeCloneDisks(cleanupCtx, disks[:i+1])
+			cancel()
+			return fmt.Errorf("failed to copy %s: %w", disk.Source, err)
+		}
+		copied += disk.Image.ActualSize
+	}
+	return nil
+}
+
+// watchCloneCopy samples the size of a disk copy until the copy command returns
+func (p *Provider) watchCloneCopy(ctx context.Context, taskID string, disk cloneDisk, copied, total int64, done <-chan error) error {
+	ticker := time.NewTicker(clonePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			// The copy runs on the libvirt host, which does not notice the local command being killed
+			if ctx.Err() != nil {
+				p.killCloneCopy(disk)
+				return fmt.Errorf("copy cancelled: %w", ctx.Err())
+			}
+			return err
+		case <-ticker.C:
+			if total <= 0 {
+				continue
+			}
+			p.tasks.updateBytes(taskID, copied+p.fileSize(ctx, disk.Target), total, fmt.Sprintf("Copying %s", filepath.Base(disk.Source)))
+		}
+	}
+}
+
+// killCloneCopy stops a qemu-img copy to a clone disk that was abandoned
+func (p *Provider) killCloneCopy(disk cloneDisk) {
+	ctx, cancel := abortContext()
+	defer cancel()
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "pkill", "-f", "qemu-img convert .* "+disk.Target); err != nil {
+		log.Printf("DEBUG No qemu-img copy to %s left to stop: %v", disk.Target, err)
+	}
+}
+
+// defineClone defines the cloned domain, removing its disks if that fails
+func (p *Provider) defineClone(ctx context.Context, targetName, cloneXML string, disks []cloneDisk) error {
+	if err := p.createDomainDefinition(ctx, targetName, cloneXML); err != nil {
+		p.removeCloneDisks(ctx, disks)
+		return err
+	}
+	if err := p.defineDomain(ctx, targetName); err != nil {
+		p.removeCloneDisks(ctx, disks)
+		return err
+	}
+
+	refreshed := map[string]bool{}
+	for _, disk := range disks {
+		if disk.Pool == "" || refreshed[disk.Pool] {
+			continue
+		}
+		refreshed[disk.Pool] = true
+		if _, err := p.virshProvider.runVirshCommand(ctx, "pool-refresh", disk.Pool); err != nil {
+			log.Printf("WARN Failed to refresh storage pool %s after clone: %v", disk.Pool, err)
+		}
+	}
+	return nil
+}
+
+// assignClonePools records the storage pool whose target directory holds each clone disk.
+// Clones are written next to their source disk, which need not be in the configured pool.
+func (p *Provider) assignClonePools(ctx context.Context, disks []cloneDisk) error {
+	pools, err := p.virshProvider.listStoragePools(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range disks {
+		dir := filepath.Dir(disks[i].Target)
+		for _, pool := range pools {
+			if pool.matches(dir) {
+				disks[i].Pool = pool.Name
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// removeCloneDisks deletes disks created for a clone that did not complete
+func (p *Provider) removeCloneDisks(ctx context.Context, disks []cloneDisk) {
+	for _, disk := range disks {
+		if err := p.deleteDiskFile(ctx, disk.Target); err != nil {
+			log.Printf("WARN Failed to remove clone disk %s: %v", disk.Target, err)
+		}
+	}
+}
+
+// renderCloneXML rewrites a source definition for the clone and returns the disks to clone.
+// Writable file-backed disks are redirected to new files next to their source; other
+// disks (CD-ROMs, readonly and shareable disks) are dropped so the clone never owns them.
+func (p *Provider) renderCloneXML(sourceXML, sourceID, targetName string) (string, []cloneDisk, error) {
+	var disks []cloneDisk
+	var renderErr error
+
+	cloneXML := cloneDiskPattern.ReplaceAllStringFunc(sourceXML, func(block string) string {
+		var disk domainDisk
+		if err := xml.Unmarshal([]byte(block), &disk); err != nil {
+			renderErr = fmt.Errorf("failed to parse source disk: %w", err)
+			return block
+		}
+		if disk.Device != "disk" || disk.ReadOnly != nil || disk.Shareable != nil || disk.Source.File == "" {
+			log.Printf("INFO Not cloning %s disk %s of %s", disk.Device, disk.Target.Dev, sourceID)
+			return ""
+		}
+
+		name := fmt.Sprintf("%s-%s.qcow2", targetName, disk.Target.Dev)
+		if len(disks) == 0 {
+			name = targetName + "-disk.qcow2"
+		}
+		target := filepath.Join(filepath.Dir(disk.Source.File), name)
+		disks = append(disks, cloneDisk{Source: disk.Source.File, Target: target})
+
+		// The clone is a qcow2 file whatever the source format
+		block = strings.Replace(block, "'"+disk.Source.File+"'", "'"+xmlEscape(target)+"'", 1)
+		block = strings.Replace(block, "\""+disk.Source.File+"\"", "\""+xmlEscape(target)+"\"", 1)
+		if disk.Driver.Type != "" && disk.Driver.Type != "qcow2" {
+			block = strings.Replace(block, "type='"+disk.Driver.Type+"'", "type='qcow2'", 1)
+		}
+		return block
+	})
+	if renderErr != nil {
+		return "", nil, renderErr
+	}
+
+	cloneXML = strings.Replace(cloneXML, "<name>"+xmlEscape(sourceID)+"</name>", "<name>"+xmlEscape(targetName)+"</name>", 1)
+	cloneXML = cloneUUIDPattern.ReplaceAllString(cloneXML, "")
+	cloneXML = cloneMACPattern.ReplaceAllString(cloneXML, "")
+	cloneXML = cloneSysinfoPattern.ReplaceAllString(cloneXML, "")
+	cloneXML = cloneNVRAMPattern.ReplaceAllString(cloneXML, "$1/>")
+	return cloneXML, disks, nil
+}
+
+// imageInfo returns the format and sizes qemu-img reports for a disk image
+func (p *Provider) imageInfo(ctx context.Context, path string) (imageInfo, error) {
+	var info imageInfo
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "qemu-img", "info", "--force-share", "--output=json", path)
+	if err != nil {
+		return info, fmt.Errorf("failed to inspect image %s: %w", path, err)
+	}
+	if err := json.Unmarshal([]byte(result.Stdout), &info); err != nil {
+		return info, fmt.Errorf("failed to parse image info of %s: %w", path, err)
+	}
+	return info, nil
+}
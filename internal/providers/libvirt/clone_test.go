@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// linkedCloneVirsh keeps the linked clone record of base in $CLONE_RECORD and knows
+// the clone uuid-clone1 until $CLONE_GONE exists
+const linkedCloneVirsh = `#!/bin/sh
+echo "$*" >> "$FAKE_VIRSH_LOG"
+case "$*" in
+  *"list --all --name"*) echo base ;;
+  *"domuuid clone1"*) echo uuid-clone1 ;;
+  *"domname uuid-clone1"*)
+    if [ -e "$CLONE_GONE" ]; then
+      echo "error: failed to get domain 'uuid-clone1'" >&2
+      exit 1
+    fi
+    echo clone1 ;;
+  *"metadata base --uri https://virtrigaud.io/xmlns/libvirt/linked-clones/1.0 --config --remove"*)
+    rm -f "$CLONE_RECORD" ;;
+  *"metadata base --uri https://virtrigaud.io/xmlns/libvirt/linked-clones/1.0 --config --key"*)
+    echo "$*" | sed 's/.*--set //' > "$CLONE_RECORD" ;;
+  *"metadata base --uri https://virtrigaud.io/xmlns/libvirt/linked-clones/1.0"*)
+    if [ ! -e "$CLONE_RECORD" ]; then
+      echo "error: metadata not found: Requested metadata element is not present" >&2
+      exit 1
+    fi
+    cat "$CLONE_RECORD" ;;
+esac
+exit 0
+`
+
+func TestLinkedCloneTemplateIsProtectedWhileClonesExist(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CLONE_RECORD", dir+"/record")
+	t.Setenv("CLONE_GONE", dir+"/gone")
+	p, _ := newScriptedVirshProvider(t, linkedCloneVirsh)
+	ctx := context.Background()
+
+	require.NoError(t, p.recordLinkedClone(ctx, "base", "clone1"))
+	clones, err := p.linkedClones(ctx, "base")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"clone1"}, clones)
+
+	_, err = p.Power(ctx, "base", contracts.PowerOpOn)
+	var providerErr *contracts.ProviderError
+	require.True(t, errors.As(err, &providerErr), "power on: %v", err)
+	assert.Equal(t, contracts.ErrorTypeConflict, providerErr.Type)
+	assert.Contains(t, err.Error(), "clone1")
+
+	_, err = p.Delete(ctx, "base")
+	require.True(t, errors.As(err, &providerErr), "delete: %v", err)
+	assert.Equal(t, contracts.ErrorTypeConflict, providerErr.Type)
+
+	// Once the clone is deleted the template is released and its record dropped
+	require.NoError(t, os.WriteFile(dir+"/gone", nil, 0o644))
+	require.NoError(t, p.checkNoLinkedClones(ctx, "base", "started"))
+	_, err = os.Stat(dir + "/record")
+	assert.True(t, os.IsNotExist(err), "record of deleted clones must be removed")
+}
+
+// poolCloneVirsh serves a shut off source whose disks live in the pools fast and bulk,
+// neither of which is the configured pool
+const poolCloneVirsh = `#!/bin/sh
+echo "$*" >> "$FAKE_VIRSH_LOG"
+case "$*" in
+  *"domstate web2"*) echo "error: failed to get domain 'web2'" >&2; exit 1 ;;
+  *"domstate web"*) echo "shut off" ;;
+  *"dumpxml --inactive web"*) cat <<'XML'
+<domain type='kvm'>
+  <name>web</name>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/fast/web.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/bulk/web-data.qcow2'/>
+      <target dev='vdb' bus='virtio'/>
+    </disk>
+  </devices>
+</domain>
+XML
+  ;;
+  *"pool-list"*) printf 'default\nfast\nbulk\n' ;;
+  *"pool-dumpxml default"*) echo "<pool type='dir'><name>default</name><target><path>/var/lib/libvirt/images</path></target></pool>" ;;
+  *"pool-dumpxml fast"*) echo "<pool type='dir'><name>fast</name><target><path>/fast</path></target></pool>" ;;
+  *"pool-dumpxml bulk"*) echo "<pool type='dir'><name>bulk</name><target><path>/bulk/</path></target></pool>" ;;
+  *"pool-info --bytes default"*) echo "Available:      1000000000000" ;;
+  *"pool-info --bytes fast"*) echo "Available:      5" ;;
+  *"pool-info --bytes bulk"*) echo "Available:      1000" ;;
+esac
+exit 0
+`
+
+func TestFullCloneChecksThePoolHoldingEachCopy(t *testing.T) {
+	p, logPath := newScriptedVirshProvider(t, poolCloneVirsh)
+
+	// Each source image occupies 10 bytes
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(bin+"/qemu-img", []byte("#!/bin/sh\necho '{\"format\":\"qcow2\",\"actual-size\":10}'\n"), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	disks := []cloneDisk{{Target: "/fast/web2-disk.qcow2"}, {Target: "/bulk/web2-vdb.qcow2"}, {Target: "/elsewhere/web2-vdc.qcow2"}}
+	require.NoError(t, p.assignClonePools(context.Background(), disks))
+	assert.Equal(t, []string{"fast", "bulk", ""}, []string{disks[0].Pool, disks[1].Pool, disks[2].Pool})
+
+	// 20 bytes in total fit the configured pool, but fast has no room for its 10 bytes
+	_, err := p.CloneVM(context.Background(), "web", "web2", CloneOptions{})
+	var providerErr *contracts.ProviderError
+	require.True(t, errors.As(err, &providerErr), "got %v", err)
+	assert.Equal(t, contracts.ErrorTypeQuotaExceeded, providerErr.Type)
+	assert.Contains(t, err.Error(), "storage pool fast")
+
+	commands := loggedCommands(t, logPath, "pool-info")
+	assert.NotContains(t, commands, "pool-info --bytes default")
+}
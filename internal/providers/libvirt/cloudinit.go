@@ -24,14 +24,24 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// cloudInitVolumeSuffix names the seed ISO volume of a domain (<name>-cidata.iso)
+	cloudInitVolumeSuffix = "-cidata.iso"
 )
 
 // CloudInitConfig represents cloud-init configuration for libvirt VMs
 type CloudInitConfig struct {
-	UserData   string // YAML cloud-init configuration
-	MetaData   string // Instance metadata (JSON)
-	InstanceID string // Unique instance identifier
-	Hostname   string // VM hostname
+	UserData      string // YAML cloud-init configuration
+	MetaData      string // Instance metadata (YAML)
+	NetworkConfig string // Optional NoCloud network-config (v1 or v2)
+	InstanceID    string // Unique instance identifier
+	Hostname      string // VM hostname
 }
 
 // CloudInitProvider manages cloud-init ISO creation and attachment for libvirt
@@ -78,16 +88,83 @@ func (c *CloudInitProvider) PrepareCloudInit(ctx context.Context, config CloudIn
 		return "", fmt.Errorf("failed to write remote meta-data: %w", err)
 	}
 
-	// Create cloud-init ISO using genisoimage (NoCloud datasource) on remote host
-	isoPath := filepath.Join(remoteDir, "cloud-init.iso")
-	if err := c.createRemoteCloudInitISO(ctx, remoteDir, isoPath); err != nil {
+	// Write network-config file remotely if provided
+	if config.NetworkConfig != "" {
+		networkConfigPath := filepath.Join(remoteDir, "network-config")
+		if err := c.writeRemoteFile(ctx, networkConfigPath, config.NetworkConfig); err != nil {
+			return "", fmt.Errorf("failed to write remote network-config: %w", err)
+		}
+	}
+
+	// Create cloud-init ISO using genisoimage (NoCloud datasource) in a staging
+	// directory next to the seed files, then import it into the storage pool
+	stagingISOPath := filepath.Join(remoteDir+"-iso", "cloud-init.iso")
+	if _, err := c.virshProvider.runVirshCommand(ctx, "!", "mkdir", "-p", filepath.Dir(stagingISOPath)); err != nil {
+		return "", fmt.Errorf("failed to create remote ISO staging directory: %w", err)
+	}
+	defer func() {
+		for _, dir := range []string{remoteDir, filepath.Dir(stagingISOPath)} {
+			if _, err := c.virshProvider.runVirshCommand(ctx, "!", "rm", "-rf", dir); err != nil {
+				log.Printf("WARN Failed to remove cloud-init staging directory %s: %v", dir, err)
+			}
+		}
+	}()
+
+	if err := c.createRemoteCloudInitISO(ctx, remoteDir, stagingISOPath); err != nil {
 		return "", fmt.Errorf("failed to create remote cloud-init ISO: %w", err)
 	}
 
-	log.Printf("INFO Successfully created remote cloud-init ISO: %s", isoPath)
+	isoPath, err := c.importFileToPool(ctx, stagingISOPath, config.InstanceID+cloudInitVolumeSuffix)
+	if err != nil {
+		return "", fmt.Errorf("failed to import cloud-init ISO into pool: %w", err)
+	}
+
+	log.Printf("INFO Successfully created cloud-init ISO volume: %s", isoPath)
 	return isoPath, nil
 }
 
+// importFileToPool uploads a host file into the image pool as a raw volume
+// and returns the volume path. An existing volume with the same name is replaced.
+func (c *CloudInitProvider) importFileToPool(ctx context.Context, filePath, volumeName string) (string, error) {
+	sizeResult, err := c.virshProvider.runVirshCommand(ctx, "!", "stat", "-c", "%s", filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to stat %s: %w", filePath, err)
+	}
+	size := strings.TrimSpace(sizeResult.Stdout)
+
+	// Replace any volume left over from a previous attempt
+	_, _ = c.virshProvider.runVirshCommand(ctx, "vol-delete", volumeName, "--pool", c.virshProvider.storagePool())
+
+	if _, err := c.virshProvider.runVirshCommand(ctx, "vol-create-as", c.virshProvider.storagePool(), volumeName,
+		size+"B", "--format", "raw"); err != nil {
+		return "", fmt.Errorf("failed to create volume %s: %w", volumeName, err)
+	}
+
+	if _, err := c.virshProvider.runVirshCommand(ctx, "vol-upload", volumeName, filePath,
+		"--pool", c.virshProvider.storagePool()); err != nil {
+		_, _ = c.virshProvider.runVirshCommand(ctx, "vol-delete", volumeName, "--pool", c.virshProvider.storagePool())
+		return "", fmt.Errorf("failed to upload %s to volume %s: %w", filePath, volumeName, err)
+	}
+
+	pathResult, err := c.virshProvider.runVirshCommand(ctx, "vol-path", volumeName, "--pool", c.virshProvider.storagePool())
+	if err != nil {
+		return "", fmt.Errorf("failed to get path of volume %s: %w", volumeName, err)
+	}
+
+	return strings.TrimSpace(pathResult.Stdout), nil
+}
+
+// DeleteCloudInitVolume removes the seed ISO volume of an instance from the image pool
+func (c *CloudInitProvider) DeleteCloudInitVolume(ctx context.Context, instanceID string) error {
+	volumeName := instanceID + cloudInitVolumeSuffix
+	if _, err := c.virshProvider.runVirshCommand(ctx, "vol-delete", volumeName, "--pool", c.virshProvider.storagePool()); err != nil {
+		return fmt.Errorf("failed to delete cloud-init volume %s: %w", volumeName, err)
+	}
+
+	log.Printf("INFO Deleted cloud-init volume: %s", volumeName)
+	return nil
+}
+
 // writeRemoteFile writes content to a file on the remote libvirt host
 func (c *CloudInitProvider) writeRemoteFile(ctx context.Context, remotePath, content string) error {
 	// Use cat with heredoc to write content to remote file (handles multiline content)
@@ -236,11 +313,12 @@ func (c *CloudInitProvider) copyISOToRemote(ctx context.Context, localPath, doma
 // getSSHTarget extracts the SSH connection target (user@host) from the provider configuration
 func (c *CloudInitProvider) getSSHTarget() (string, error) {
 	// Get credentials
-	if c.virshProvider.credentials == nil {
+	creds, _ := c.virshProvider.session()
+	if creds == nil {
 		return "", fmt.Errorf("provider credentials not initialized")
 	}
 
-	username := c.virshProvider.credentials.Username
+	username := creds.Username
 	if username == "" {
 		return "", fmt.Errorf("username not configured in provider credentials")
 	}
@@ -304,27 +382,38 @@ func (c *CloudInitProvider) CleanupCloudInit(instanceID string) error {
 	return nil
 }
 
-// ValidateCloudInitData validates the provided cloud-init YAML
+// ValidateCloudInitData validates the provided cloud-init user-data.
+// Scripts (#!) and #cloud-config documents are accepted as-is; anything else must be valid YAML.
 func (c *CloudInitProvider) ValidateCloudInitData(cloudInitData string) error {
-	// Basic validation - check if it looks like valid YAML
-	if strings.TrimSpace(cloudInitData) == "" {
-		return fmt.Errorf("cloud-init data is empty")
+	trimmed := strings.TrimSpace(cloudInitData)
+	if trimmed == "" {
+		return contracts.NewInvalidSpecError("cloud-init user-data is empty", nil)
 	}
 
-	// Check for common cloud-init directives
-	commonDirectives := []string{"#cloud-config", "hostname:", "users:", "packages:", "runcmd:", "write_files:"}
-	hasValidDirective := false
-
-	for _, directive := range commonDirectives {
-		if strings.Contains(cloudInitData, directive) {
-			hasValidDirective = true
-			break
-		}
+	if strings.HasPrefix(trimmed, "#cloud-config") || strings.HasPrefix(trimmed, "#!") {
+		return nil
 	}
 
-	if !hasValidDirective {
-		log.Printf("WARN Cloud-init data may not be valid - no common directives found")
+	if err := validateYAML(cloudInitData); err != nil {
+		return contracts.NewInvalidSpecError("cloud-init user-data must be YAML or start with #cloud-config or #!", err)
 	}
 
 	return nil
 }
+
+// ValidateCloudInitDocument validates an optional meta-data or network-config document
+func (c *CloudInitProvider) ValidateCloudInitDocument(name, document string) error {
+	if strings.TrimSpace(document) == "" {
+		return nil
+	}
+	if err := validateYAML(document); err != nil {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("cloud-init %s is not valid YAML", name), err)
+	}
+	return nil
+}
+
+// validateYAML checks that a document parses as YAML
+func validateYAML(document string) error {
+	var parsed interface{}
+	return yaml.Unmarshal([]byte(document), &parsed)
+}
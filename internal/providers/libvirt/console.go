@@ -0,0 +1,281 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+const (
+	// maxConsoleSession caps how long a console session may stay open
+	maxConsoleSession = time.Hour
+
+	// consoleBufferSize is the chunk size for console output frames
+	consoleBufferSize = 32 * 1024
+)
+
+// supportedConsoleTypes lists the console types OpenConsole accepts
+var supportedConsoleTypes = []string{"serial", "vnc", "spice"}
+
+// consoleSession is an open byte stream to a domain console
+type consoleSession struct {
+	input  io.WriteCloser
+	output io.Reader
+	close  func() error
+}
+
+// OpenConsole streams a serial or graphics console between the client and a domain.
+// The first request must carry ConsoleOpen; later requests carry keystrokes or protocol bytes.
+func (s *Server) OpenConsole(stream providerv1.Provider_OpenConsoleServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	open := first.GetOpen()
+	if open == nil || open.VmId == "" {
+		return contracts.NewInvalidSpecError("first console message must specify the VM to open", nil)
+	}
+
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return fmt.Errorf("libvirt provider not initialized")
+	}
+
+	duration := maxConsoleSession
+	if requested := time.Duration(open.MaxSessionSeconds) * time.Second; requested > 0 && requested < duration {
+		duration = requested
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), duration)
+	defer cancel()
+
+	log.Printf("INFO Opening %s console for VM %s (max duration: %s)", consoleTypeName(open.Type), open.VmId, duration)
+
+	session, err := libvirtProvider.openConsole(ctx, open.VmId, open.Type)
+	if err != nil {
+		return fmt.Errorf("failed to open console: %w", err)
+	}
+	defer func() { _ = session.close() }()
+
+	// Client input -> console
+	go func() {
+		defer func() { _ = session.input.Close() }()
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				cancel()
+				return
+			}
+			if data := req.GetInput(); len(data) > 0 {
+				if _, err := session.input.Write(data); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	// Console output -> client; this goroutine is the only sender until it finishes
+	outputDone := make(chan error, 1)
+	go func() {
+		buf := make([]byte, consoleBufferSize)
+		for {
+			n, err := session.output.Read(buf)
+			if n > 0 {
+				if sendErr := stream.Send(&providerv1.ConsoleResponse{Output: append([]byte(nil), buf[:n]...)}); sendErr != nil {
+					outputDone <- sendErr
+					return
+				}
+			}
+			if err != nil {
+				outputDone <- err
+				return
+			}
+		}
+	}()
+
+	reason := "console closed"
+	select {
+	case err := <-outputDone:
+		if err != nil && !errors.Is(err, io.EOF) {
+			reason = fmt.Sprintf("console stream ended: %v", err)
+		}
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			reason = "maximum session duration reached"
+		} else {
+			reason = "client disconnected"
+		}
+		_ = session.close()
+		<-outputDone
+	}
+
+	log.Printf("INFO Console for VM %s closed: %s", open.VmId, reason)
+	_ = stream.Send(&providerv1.ConsoleResponse{Closed: true, Reason: reason})
+	return nil
+}
+
+// openConsole attaches to the serial console or proxies the graphics port of a domain
+func (p *Provider) openConsole(ctx context.Context, vmID string, consoleType providerv1.ConsoleType) (*consoleSession, error) {
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return nil, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	if state != "running" {
+		return nil, contracts.NewInvalidSpecError(fmt.Sprintf("domain %s is not running (state: %s)", vmID, state), nil)
+	}
+
+	switch consoleType {
+	case providerv1.ConsoleType_CONSOLE_TYPE_UNSPECIFIED, providerv1.ConsoleType_CONSOLE_TYPE_SERIAL:
+		return p.virshProvider.openSerialConsole(ctx, vmID)
+	case providerv1.ConsoleType_CONSOLE_TYPE_VNC, providerv1.ConsoleType_CONSOLE_TYPE_SPICE:
+		graphicsType := consoleTypeName(consoleType)
+		port, err := p.getGraphicsPort(ctx, vmID, graphicsType)
+		if err != nil {
+			return nil, contracts.NewNotFoundError(fmt.Sprintf("domain %s has no %s graphics device", vmID, graphicsType), err)
+		}
+		return p.virshProvider.openGraphicsProxy(ctx, port)
+	default:
+		return nil, contracts.NewNotSupportedError(fmt.Sprintf("unsupported console type: %v", consoleType))
+	}
+}
+
+// openSerialConsole runs "virsh console" under a pseudo-terminal, which virsh requires
+func (v *VirshProvider) openSerialConsole(ctx context.Context, vmID string) (*consoleSession, error) {
+	var cmd *exec.Cmd
+	if user, host, ok := v.passwordSSHTarget(); ok {
+		cmd = exec.CommandContext(ctx, "sshpass", "-e", "ssh", "-tt",
+			"-o", "StrictHostKeyChecking=accept-new",
+			"-o", "UserKnownHostsFile=/tmp/known_hosts",
+			"-o", "LogLevel=ERROR",
+			fmt.Sprintf("%s@%s", user, host),
+			"virsh", "console", shellQuote(vmID), "--force")
+	} else {
+		cmd = exec.CommandContext(ctx, "script", "-qfec",
+			fmt.Sprintf("virsh console %s --force", shellQuote(vmID)), "/dev/null")
+	}
+	cmd.Env = v.commandEnv()
+
+	return startConsoleCommand(cmd)
+}
+
+// openGraphicsProxy connects to a graphics port that listens on the hypervisor loopback
+func (v *VirshProvider) openGraphicsProxy(ctx context.Context, port int) (*consoleSession, error) {
+	target := fmt.Sprintf("127.0.0.1:%d", port)
+
+	if user, host, ok := v.passwordSSHTarget(); ok {
+		cmd := exec.CommandContext(ctx, "sshpass", "-e", "ssh",
+			"-o", "StrictHostKeyChecking=accept-new",
+			"-o", "UserKnownHostsFile=/tmp/known_hosts",
+			"-o", "LogLevel=ERROR",
+			"-W", target,
+			fmt.Sprintf("%s@%s", user, host))
+		cmd.Env = v.commandEnv()
+		return startConsoleCommand(cmd)
+	}
+
+	if parsedURI, err := url.Parse(v.uri); err == nil && strings.Contains(parsedURI.Scheme, "ssh") {
+		// Key-based SSH transport: tunnel through the same host
+		sshTarget := parsedURI.Hostname()
+		if parsedURI.User != nil && parsedURI.User.Username() != "" {
+			sshTarget = parsedURI.User.Username() + "@" + sshTarget
+		}
+		cmd := exec.CommandContext(ctx, "ssh",
+			"-o", "StrictHostKeyChecking=accept-new",
+			"-o", "UserKnownHostsFile=/tmp/known_hosts",
+			"-o", "LogLevel=ERROR",
+			"-W", target, sshTarget)
+		cmd.Env = v.commandEnv()
+		return startConsoleCommand(cmd)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to graphics port %d: %w", port, err)
+	}
+	return &consoleSession{input: conn, output: conn, close: conn.Close}, nil
+}
+
+// startConsoleCommand starts cmd with its stdin/stdout wired to a console session
+func startConsoleCommand(cmd *exec.Cmd) (*consoleSession, error) {
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start console command: %w", err)
+	}
+
+	return &consoleSession{
+		input:  stdin,
+		output: stdout,
+		close: func() error {
+			if cmd.Process != nil {
+				_ = cmd.Process.Kill()
+			}
+			_ = cmd.Wait()
+			return nil
+		},
+	}, nil
+}
+
+// passwordSSHTarget returns the user and host when commands run over sshpass
+func (v *VirshProvider) passwordSSHTarget() (string, string, bool) {
+	creds, _ := v.session()
+	if creds == nil || creds.Password == "" || !strings.Contains(v.uri, "ssh://") {
+		return "", "", false
+	}
+	parsedURI, err := url.Parse(v.uri)
+	if err != nil {
+		return "", "", false
+	}
+	return parsedURI.User.Username(), parsedURI.Host, true
+}
+
+// consoleTypeName returns the lowercase console type name
+func consoleTypeName(t providerv1.ConsoleType) string {
+	switch t {
+	case providerv1.ConsoleType_CONSOLE_TYPE_VNC:
+		return "vnc"
+	case providerv1.ConsoleType_CONSOLE_TYPE_SPICE:
+		return "spice"
+	default:
+		return "serial"
+	}
+}
+
+// shellQuote quotes a value for safe use in a POSIX shell command line
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
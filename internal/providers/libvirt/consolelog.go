@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// DefaultConsoleLogDir is where serial console logs are written unless configured otherwise;
+	// libvirt labels it for QEMU and virtlogd
+	DefaultConsoleLogDir = "/var/log/libvirt/qemu"
+
+	// consoleLogSuffix is appended to the domain name to form the log file name
+	consoleLogSuffix = "-serial.log"
+
+	// defaultConsoleLogBytes is how much of the log GetConsoleLog returns by default
+	defaultConsoleLogBytes = 64 * 1024
+
+	// maxConsoleLogBytes caps how much of the log a single GetConsoleLog call returns
+	maxConsoleLogBytes = 1024 * 1024
+)
+
+// ConsoleLogResult is the end of a serial console log
+type ConsoleLogResult struct {
+	// Data is the tail of the log
+	Data []byte
+	// Path is the log file on the libvirt host
+	Path string
+	// SizeBytes is the current size of the log file
+	SizeBytes int64
+	// Truncated is set when the log holds more than Data
+	Truncated bool
+}
+
+// consoleLogPath returns the serial console log file of a domain
+func (p *Provider) consoleLogPath(name string) string {
+	dir := p.options.ConsoleLogDir
+	if dir == "" {
+		dir = DefaultConsoleLogDir
+	}
+	return path.Join(dir, name+consoleLogSuffix)
+}
+
+// serialLogXML renders the <log> element that copies serial output to a file. The file is
+// written through virtlogd, which rotates it at its max_size (2 MiB by default) and keeps
+// max_backups old files, so a chatty guest cannot fill the host disk.
+func (p *Provider) serialLogXML(req contracts.CreateRequest) string {
+	if !req.SerialLog {
+		return ""
+	}
+	return fmt.Sprintf("\n      <log file='%s' append='on'/>", xmlEscape(p.consoleLogPath(req.Name)))
+}
+
+// GetConsoleLog returns up to maxBytes from the end of a domain's serial console log.
+// A domain that has not started yet has an empty log.
+func (p *Provider) GetConsoleLog(ctx context.Context, vmID string, maxBytes int64) (ConsoleLogResult, error) {
+	var result ConsoleLogResult
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	switch {
+	case maxBytes <= 0:
+		maxBytes = defaultConsoleLogBytes
+	case maxBytes > maxConsoleLogBytes:
+		maxBytes = maxConsoleLogBytes
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	result.Path = domainConsoleLog(domain)
+	if result.Path == "" {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s does not log its serial console; create it with serial logging enabled", vmID), nil)
+	}
+
+	stat, err := p.virshProvider.runVirshCommand(ctx, "!", "stat", "-c", "%s", result.Path)
+	if err != nil {
+		return result, nil
+	}
+	result.SizeBytes, _ = strconv.ParseInt(strings.TrimSpace(stat.Stdout), 10, 64)
+	if result.SizeBytes == 0 {
+		return result, nil
+	}
+
+	tail, err := p.virshProvider.runVirshCommand(ctx, "!", "tail", "-c", strconv.FormatInt(maxBytes, 10), result.Path)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read console log", err)
+	}
+	result.Data = []byte(tail.Stdout)
+	result.Truncated = result.SizeBytes > maxBytes
+	return result, nil
+}
+
+// domainConsoleLog returns the log file of the first serial port that has one
+func domainConsoleLog(domain *domainXML) string {
+	for _, serial := range domain.Devices.Serials {
+		if serial.Log != nil && serial.Log.File != "" {
+			return serial.Log.File
+		}
+	}
+	return ""
+}
+
+// removeConsoleLogs deletes the serial console log of a deleted domain and the files virtlogd
+// rotated out (<log>.0, <log>.1, ...)
+func (p *Provider) removeConsoleLogs(ctx context.Context, domain *domainXML) {
+	logFile := domainConsoleLog(domain)
+	if logFile == "" {
+		return
+	}
+	script := fmt.Sprintf("rm -f %s %s.[0-9]*", shellQuote(logFile), shellQuote(logFile))
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "sh", "-c", p.virshProvider.quoteRemoteArg(script)); err != nil {
+		log.Printf("WARN Failed to remove console log of %s: %v", domain.Name, err)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Phases reported while consolidating snapshots
+const (
+	consolidatePhaseCommit = "commit"
+	consolidatePhasePivot  = "pivot"
+	consolidatePhaseDone   = "done"
+)
+
+// ConsolidateProgress reports the progress of merging a disk's overlays
+type ConsolidateProgress struct {
+	// OperationID is the tracked operation WatchOperation can follow
+	OperationID     string
+	Disk            string
+	Phase           string
+	ProgressPercent int32
+	// ProcessedBytes and EndBytes are the block commit's position and end
+	ProcessedBytes int64
+	EndBytes       int64
+}
+
+// ConsolidateResult describes what a consolidation merged and cleaned up
+type ConsolidateResult struct {
+	// RemovedOverlays are the overlay files committed into their base and deleted
+	RemovedOverlays []string
+	// DeletedSnapshots are the external snapshots whose metadata was removed
+	DeletedSnapshots []string
+	// OperationID is the tracked operation of the consolidation
+	OperationID string
+}
+
+// snapshotOverlays maps disk targets to the overlay files created by external snapshots
+// and the snapshot each file belongs to
+type snapshotOverlays map[string]map[string]string
+
+// ConsolidateSnapshots commits the overlays of external snapshots back into the image they
+// were taken from and pivots the disk onto it, shortening the backing chain again. An empty
+// disk consolidates every disk with overlays. The commit stops at the oldest snapshot base, so
+// shared images further down the chain (e.g. linked clone templates) are never written.
+// Cancelling ctx aborts the running block job and leaves the disk on its overlay.
+func (p *Provider) ConsolidateSnapshots(ctx context.Context, vmID, disk string, onProgress func(ConsolidateProgress)) (result ConsolidateResult, err error) {
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		if domainGone(err) {
+			return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+		}
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+	if state != "running" {
+		return result, contracts.NewNotSupportedError(fmt.Sprintf("consolidating snapshots requires a running domain, %s is %s", vmID, state))
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return result, fmt.Errorf("failed to get domain XML: %w", err)
+	}
+	overlays, snapshotFiles, err := p.externalSnapshotOverlays(ctx, vmID)
+	if err != nil {
+		return result, err
+	}
+
+	if disk != "" {
+		if _, ok := domain.findDisk(disk); !ok {
+			return result, contracts.NewNotFoundError(fmt.Sprintf("disk %s not found on domain %s", disk, vmID), nil)
+		}
+	}
+
+	// The consolidation is tracked so that other clients can follow it with WatchOperation
+	operationID := fmt.Sprintf("task-consolidate-%s", generateTaskID())
+	result.OperationID = operationID
+	p.tasks.start(operationID, fmt.Sprintf("Consolidating snapshots of %s", vmID))
+	defer func() {
+		if err != nil {
+			p.tasks.finish(operationID, err, "Consolidation failed")
+			return
+		}
+		p.tasks.finish(operationID, nil, fmt.Sprintf("Consolidated snapshots of %s", vmID))
+	}()
+	track := func(progress ConsolidateProgress) {
+		progress.OperationID = operationID
+		p.tasks.updateBytes(operationID, progress.ProcessedBytes, progress.EndBytes,
+			fmt.Sprintf("Consolidating disk %s (%s)", progress.Disk, progress.Phase))
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	removed := make(map[string]bool)
+	for _, d := range domain.Devices.Disks {
+		if disk != "" && d.Target.Dev != disk {
+			continue
+		}
+		committed, err := p.consolidateDisk(ctx, vmID, d, overlays[d.Target.Dev], track)
+		if err != nil {
+			return result, err
+		}
+		for _, file := range committed {
+			removed[file] = true
+		}
+		result.RemovedOverlays = append(result.RemovedOverlays, committed...)
+	}
+
+	// Snapshots whose overlays are all gone can no longer be reverted to
+	for name, files := range snapshotFiles {
+		gone := true
+		for _, file := range files {
+			gone = gone && removed[file]
+		}
+		if !gone {
+			continue
+		}
+		if _, err := p.virshProvider.runVirshCommand(ctx, "snapshot-delete", vmID, name, "--metadata"); err != nil {
+			log.Printf("WARN Failed to delete metadata of consolidated snapshot %s of %s: %v", name, vmID, err)
+			continue
+		}
+		result.DeletedSnapshots = append(result.DeletedSnapshots, name)
+	}
+
+	log.Printf("INFO Consolidated snapshots of %s: %d overlays committed, %d snapshots removed",
+		vmID, len(result.RemovedOverlays), len(result.DeletedSnapshots))
+	return result, nil
+}
+
+// consolidateDisk actively commits the snapshot overlays on top of a disk into their base,
+// pivots onto the base and deletes the committed files, which it returns
+func (p *Provider) consolidateDisk(ctx context.Context, vmID string, disk domainDisk, overlays map[string]string, onProgress func(ConsolidateProgress)) ([]string, error) {
+	target := disk.Target.Dev
+	var processed, end int64
+	report := func(phase string, progress int32) {
+		if onProgress != nil {
+			onProgress(ConsolidateProgress{Disk: target, Phase: phase, ProgressPercent: progress,
+				ProcessedBytes: processed, EndBytes: end})
+		}
+	}
+
+	chain := disk.backingChain()
+	depth := 0
+	for depth < len(chain) && overlays[chain[depth]] != "" {
+		depth++
+	}
+	if depth == 0 {
+		log.Printf("DEBUG Disk %s of %s has no snapshot overlays", target, vmID)
+		return nil, nil
+	}
+	if depth == len(chain) {
+		return nil, fmt.Errorf("base image of disk %s of %s not found in its backing chain", target, vmID)
+	}
+	base := chain[depth]
+
+	release, ok := p.blockJobs.acquire(vmID, target)
+	if !ok {
+		return nil, contracts.NewConflictError(fmt.Sprintf("a block job is already running on disk %s of %s", target, vmID), nil)
+	}
+	defer release()
+	if active, err := p.blockJobActive(ctx, vmID, target); err != nil {
+		return nil, fmt.Errorf("failed to query block jobs of disk %s: %w", target, err)
+	} else if active {
+		return nil, contracts.NewConflictError(fmt.Sprintf("a block job is already running on disk %s of %s", target, vmID), nil)
+	}
+
+	log.Printf("INFO Committing %d overlays of disk %s of %s into %s", depth, target, vmID, base)
+	report(consolidatePhaseCommit, 0)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "blockcommit", vmID, target, "--active", "--base", base); err != nil {
+		return nil, fmt.Errorf("failed to start block commit of disk %s: %w", target, err)
+	}
+
+	ready, err := p.waitBlockJob(ctx, vmID, target, func(cur, jobEnd int64) {
+		processed, end = cur, jobEnd
+		report(consolidatePhaseCommit, int32(cur*100/jobEnd))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ready {
+		return nil, fmt.Errorf("block commit of disk %s of %s ended before it could be pivoted", target, vmID)
+	}
+
+	report(consolidatePhasePivot, 100)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "blockjob", vmID, target, "--pivot"); err != nil {
+		p.abortBlockJob(vmID, target)
+		return nil, fmt.Errorf("failed to pivot disk %s onto %s: %w", target, base, err)
+	}
+
+	committed := chain[:depth]
+	for _, file := range committed {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", "--", file); err != nil {
+			log.Printf("WARN Failed to remove committed overlay %s: %v", file, err)
+		}
+	}
+	report(consolidatePhaseDone, 100)
+	return committed, nil
+}
+
+// externalSnapshotOverlays collects the overlay files of a domain's external snapshots,
+// per disk and per snapshot
+func (p *Provider) externalSnapshotOverlays(ctx context.Context, vmID string) (snapshotOverlays, map[string][]string, error) {
+	names, err := p.virshProvider.listSnapshots(ctx, vmID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list snapshots of %s: %w", vmID, err)
+	}
+
+	overlays := make(snapshotOverlays)
+	files := make(map[string][]string)
+	for _, name := range names {
+		result, err := p.virshProvider.runVirshCommand(ctx, "snapshot-dumpxml", vmID, name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get snapshot %s: %w", name, err)
+		}
+		var snap domainSnapshotXML
+		if err := xml.Unmarshal([]byte(result.Stdout), &snap); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse snapshot %s: %w", name, err)
+		}
+		for _, disk := range snap.Disks {
+			file := strings.TrimSpace(disk.Source.File)
+			if disk.Snapshot != "external" || file == "" {
+				continue
+			}
+			if overlays[disk.Name] == nil {
+				overlays[disk.Name] = make(map[string]string)
+			}
+			overlays[disk.Name][file] = name
+			files[name] = append(files[name], file)
+		}
+	}
+	return overlays, files, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// CPU modes accepted in the CPU model of a VM class
+const (
+	cpuModeHostPassthrough = vmspec.CPUModeHostPassthrough
+	cpuModeHostModel       = vmspec.CPUModeHostModel
+	cpuModeCustom          = vmspec.CPUModeCustom
+)
+
+// renderCPUXML renders the <cpu> element for a VM class
+func renderCPUXML(class contracts.VMClass) string {
+	var b strings.Builder
+
+	switch vmspec.CPUMode(class.CPUModel) {
+	case cpuModeHostPassthrough:
+		b.WriteString(`<cpu mode='host-passthrough' check='none' migratable='on'>`)
+	case cpuModeCustom:
+		fmt.Fprintf(&b, `<cpu mode='custom' match='exact' check='partial'>
+    <model fallback='forbid'>%s</model>`, xmlEscape(class.CPUModel.Name))
+	default:
+		b.WriteString(`<cpu mode='host-model' check='partial'>`)
+	}
+
+	if topology := class.CPUTopology; topology != nil {
+		fmt.Fprintf(&b, `
+    <topology sockets='%d' cores='%d' threads='%d'/>`, topology.Sockets, topology.Cores, topology.Threads)
+	}
+
+	if class.PerformanceProfile != nil && class.PerformanceProfile.NestedVirtualization {
+		b.WriteString(`
+    <feature policy='require' name='vmx'/> <!-- Intel VT-x -->
+    <feature policy='require' name='svm'/> <!-- AMD-V -->`)
+	}
+
+	b.WriteString(numaXML(class.NUMACells))
+	b.WriteString(`</cpu>`)
+	return b.String()
+}
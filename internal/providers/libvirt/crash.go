@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// onCrashDestroy is the action libvirt takes on a crash unless a policy is set
+const onCrashDestroy = vmspec.OnCrashDestroy
+
+// domainPanic describes the panic notifier device of a domain
+type domainPanic struct {
+	Model string `xml:"model,attr"`
+}
+
+// onCrashAction returns the <on_crash> action of a request; crashed guests are destroyed by default
+func onCrashAction(action string) string {
+	if action == "" {
+		return onCrashDestroy
+	}
+	return action
+}
+
+// panicXML renders the pvpanic device through which the guest kernel reports a panic.
+// Without it a panicked guest keeps running, so it is only added when a policy is set.
+func panicXML(action string) string {
+	if action == "" {
+		return ""
+	}
+	return "\n    <panic model='isa'>\n      <address type='isa' iobase='0x505'/>\n    </panic>"
+}
+
+// contractOnCrash describes the crash policy of a domain as a create request setting;
+// domains without a panic device never see a crash and report none
+func contractOnCrash(domain *domainXML) string {
+	if len(domain.Devices.Panics) == 0 {
+		return ""
+	}
+	return onCrashAction(strings.TrimSpace(domain.OnCrash))
+}
+
+// getDomainStateReason reads the state of a domain together with the reason libvirt
+// records for it, e.g. "crashed (panicked)" or "paused (watchdog)"
+func (v *VirshProvider) getDomainStateReason(ctx context.Context, domainName string) (state, reason string, err error) {
+	result, err := v.runVirshCommand(ctx, "domstate", domainName, "--reason")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get domain state: %w", err)
+	}
+
+	output := strings.TrimSpace(result.Stdout)
+	open := strings.LastIndex(output, "(")
+	if open < 0 || !strings.HasSuffix(output, ")") {
+		return output, "", nil
+	}
+	return strings.TrimSpace(output[:open]), strings.ToLower(strings.TrimSpace(output[open+1 : len(output)-1])), nil
+}
+
+// domainCrashed reports whether a state and reason describe a crashed guest: preserved
+// domains stay in the crashed state, destroyed ones are shut off with the crashed reason
+func domainCrashed(state, reason string) bool {
+	return state == "crashed" || reason == "crashed" || reason == "panicked"
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// createRollbackTimeout bounds undoing a failed Create
+const createRollbackTimeout = 2 * time.Minute
+
+// storageFullMarkers are fragments of the errors virsh and qemu-img report when a pool runs out of space
+var storageFullMarkers = []string{
+	"no space left on device",
+	"not enough space",
+	"insufficient space",
+	"disk quota exceeded",
+	"out of space",
+}
+
+// createRollback records what a Create has allocated, so that a failure part way
+// through does not leave orphaned volumes or a half-created domain behind
+type createRollback struct {
+	// volumes are the disk volumes Create created or started to create
+	volumes []diskPlan
+	// domain is set once Create starts defining the domain
+	domain string
+	// reservations are the DHCP host entries Create added to libvirt networks
+	reservations []dhcpReservation
+}
+
+// addVolume records a disk volume that is about to be created
+func (r *createRollback) addVolume(plan diskPlan) {
+	r.volumes = append(r.volumes, plan)
+}
+
+// rollbackCreate undoes a failed Create: the domain is undefined and every volume it
+// created, including one whose creation failed half way, is deleted. It runs detached from
+// the request context, whose cancellation or deadline may be what made Create fail.
+func (p *Provider) rollbackCreate(ctx context.Context, rollback *createRollback) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), createRollbackTimeout)
+	defer cancel()
+
+	if rollback.domain != "" {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "dominfo", rollback.domain); err == nil {
+			if err := p.virshProvider.undefineDomain(ctx, rollback.domain, "--nvram"); err != nil {
+				log.Printf("WARN Rollback could not undefine domain %s: %v", rollback.domain, err)
+			}
+		}
+	}
+
+	for _, reservation := range rollback.reservations {
+		if err := p.virshProvider.updateDHCPHost(ctx, "delete", reservation.Network, reservation.ParentIndex, reservation.Host); err != nil {
+			log.Printf("WARN Rollback could not remove DHCP reservation of %s on network %s: %v", reservation.Host.MAC, reservation.Network, err)
+		}
+	}
+
+	refreshed := make(map[string]bool)
+	for _, plan := range rollback.volumes {
+		// Volumes that failed to be created are not known to the pool until it is refreshed
+		if !refreshed[plan.Pool.Name] {
+			refreshed[plan.Pool.Name] = true
+			_, _ = p.virshProvider.runVirshCommand(ctx, "pool-refresh", plan.Pool.Name)
+		}
+		if plan.Path != "" {
+			if _, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", plan.Path); err == nil {
+				log.Printf("INFO Rollback deleted volume %s", plan.Path)
+				continue
+			}
+		}
+		names := []string{plan.VolumeName}
+		if plan.Source != "" {
+			names = append(names, plan.VolumeName+"."+plan.Format)
+		}
+		deleted := false
+		for _, name := range names {
+			if _, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", name, "--pool", plan.Pool.Name); err == nil {
+				log.Printf("INFO Rollback deleted volume %s from pool %s", name, plan.Pool.Name)
+				deleted = true
+			}
+		}
+		if !deleted && plan.Path != "" {
+			log.Printf("WARN Rollback could not delete volume %s; it may have to be removed by hand", plan.Path)
+		}
+	}
+}
+
+// isStorageFullError reports whether an error says that a storage pool ran out of space
+func isStorageFullError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, marker := range storageFullMarkers {
+		if strings.Contains(message, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// storageFullError turns a volume allocation failure caused by a full pool into a
+// QuotaExceeded error reporting the free space of the pool; other errors are returned as is
+func (p *Provider) storageFullError(ctx context.Context, err error, plan diskPlan) error {
+	if !isStorageFullError(err) {
+		return err
+	}
+	message := fmt.Sprintf("storage pool %s ran out of space creating disk %s (%d GiB)", plan.Pool.Name, plan.Target, plan.SizeGiB)
+	if pool, poolErr := p.getPoolCapacity(ctx, plan.Pool.Name); poolErr == nil {
+		message += fmt.Sprintf(": %d of %d bytes available", pool.AvailableBytes, pool.CapacityBytes)
+	}
+	return contracts.NewQuotaExceededError(message, err)
+}
+
+// createDisks creates the volumes of the disks that do not reference an existing one,
+// recording each in rollback before it is created
+func (p *Provider) createDisks(ctx context.Context, storageProvider *StorageProvider, disks []diskPlan, rollback *createRollback) error {
+	for i := range disks {
+		if disks[i].Referenced {
+			log.Printf("INFO Attaching existing volume %s as %s", disks[i].Path, disks[i].Target)
+			continue
+		}
+		rollback.addVolume(disks[i])
+		path, err := p.createDiskVolume(ctx, storageProvider, disks[i])
+		if err != nil {
+			return p.storageFullError(ctx, err, disks[i])
+		}
+		disks[i].Path = path
+		rollback.volumes[len(rollback.volumes)-1].Path = path
+	}
+	return nil
+}
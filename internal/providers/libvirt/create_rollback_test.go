@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// fakeVirsh answers the commands Create issues against a pool with 3 GiB free,
+// failing to allocate the data volume with the error libvirt reports for a full pool
+const fakeVirsh = `#!/bin/sh
+echo "$*" >> "$FAKE_VIRSH_LOG"
+case "$1" in
+pool-info)
+	if [ "$2" = "--bytes" ]; then
+		printf 'Name: default\nState: running\nCapacity: 10737418240\nAllocation: 7516192768\nAvailable: 3221225472\n'
+	else
+		printf 'Name: default\nState: running\n'
+	fi
+	;;
+vol-create-as)
+	if [ "$3" = "vm1-data" ]; then
+		echo "error: Failed to create vol vm1-data" >&2
+		echo "error: cannot allocate 21474836480 bytes in file '/pool/vm1-data': No space left on device" >&2
+		exit 1
+	fi
+	;;
+vol-info)
+	printf 'Name: %s\nType: file\nCapacity: 10.00 GiB\nAllocation: 0.00 B\n' "$2"
+	;;
+vol-path)
+	echo "/pool/$2"
+	;;
+esac
+exit 0
+`
+
+func newFakeVirshProvider(t *testing.T) (*Provider, string) {
+	t.Helper()
+	return newScriptedVirshProvider(t, fakeVirsh)
+}
+
+// newScriptedVirshProvider returns a provider whose virsh is the shell script, which
+// logs its arguments to the returned file
+func newScriptedVirshProvider(t *testing.T, script string) (*Provider, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "virsh.log")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "virsh"), []byte(script), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sudo"), []byte("#!/bin/sh\nexit 0\n"), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("FAKE_VIRSH_LOG", logPath)
+
+	virsh := &VirshProvider{
+		credentials: &Credentials{},
+		uri:         "test:///" + t.Name(),
+		env:         os.Environ(),
+	}
+	return &Provider{virshProvider: virsh}, logPath
+}
+
+func TestCreateDisksRollsBackOnStorageFull(t *testing.T) {
+	p, logPath := newFakeVirshProvider(t)
+
+	var pool poolDefinition
+	pool.Name = "default"
+	disks := []diskPlan{
+		{Target: "vda", Format: "qcow2", SizeGiB: 10, Pool: pool, VolumeName: "vm1-root"},
+		{Target: "vdb", Format: "qcow2", SizeGiB: 20, Pool: pool, VolumeName: "vm1-data"},
+	}
+
+	ctx := context.Background()
+	rollback := &createRollback{}
+	err := p.createDisks(ctx, NewStorageProvider(p.virshProvider), disks, rollback)
+	require.Error(t, err)
+
+	var providerErr *contracts.ProviderError
+	require.True(t, errors.As(err, &providerErr))
+	assert.Equal(t, contracts.ErrorTypeQuotaExceeded, providerErr.Type)
+	assert.Contains(t, providerErr.Message, "storage pool default ran out of space creating disk vdb (20 GiB)")
+	assert.Contains(t, providerErr.Message, "3221225472 of 10737418240 bytes available")
+
+	require.Len(t, rollback.volumes, 2)
+	assert.Equal(t, "/pool/vm1-root", rollback.volumes[0].Path)
+	assert.Empty(t, rollback.volumes[1].Path)
+
+	p.rollbackCreate(ctx, rollback)
+
+	log, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	commands := strings.Split(strings.TrimSpace(string(log)), "\n")
+	assert.Contains(t, commands, "pool-refresh default")
+	assert.Contains(t, commands, "vol-delete /pool/vm1-root")
+	assert.Contains(t, commands, "vol-delete vm1-data --pool default")
+	for _, command := range commands {
+		assert.False(t, strings.HasPrefix(command, "undefine"), "no domain was defined, got %q", command)
+	}
+}
+
+func TestIsStorageFullError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "no space left", err: errors.New("cannot allocate: No space left on device"), want: true},
+		{name: "qemu-img", err: errors.New("qemu-img: error while writing sector 1024: Disk quota exceeded"), want: true},
+		{name: "unrelated", err: errors.New("storage pool 'default' not found"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isStorageFullError(tt.err))
+		})
+	}
+}
+
+func TestCreateFailureKeepsTypedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want contracts.ErrorType
+	}{
+		{name: "invalid spec", err: contracts.NewInvalidSpecError("disk vdb: size is required", nil), want: contracts.ErrorTypeInvalidSpec},
+		{name: "wrapped invalid spec", err: fmt.Errorf("failed to generate domain XML: %w",
+			contracts.NewInvalidSpecError("domain template conflicts with the request", nil)), want: contracts.ErrorTypeInvalidSpec},
+		{name: "missing pool", err: contracts.NewNotFoundError("storage pool fast not found", nil), want: contracts.ErrorTypeNotFound},
+		{name: "storage full", err: contracts.NewQuotaExceededError("storage pool default ran out of space", nil), want: contracts.ErrorTypeQuotaExceeded},
+		{name: "untyped", err: errors.New("virsh define exited with status 1"), want: contracts.ErrorTypeRetryable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var providerErr *contracts.ProviderError
+			require.True(t, errors.As(createFailure(tt.err), &providerErr))
+			assert.Equal(t, tt.want, providerErr.Type)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	// credentialsRuntimeDir holds the client certificates and SASL auth files written for virsh
+	credentialsRuntimeDir = "/tmp/virtrigaud-credentials"
+
+	// credentialsReloadDelay lets a secret update, which touches several files, settle before reloading
+	credentialsReloadDelay = time.Second
+
+	// saslCredentialsName names the credential set of the SASL auth file
+	saslCredentialsName = "virtrigaud"
+)
+
+// CredentialFiles names files the connection credentials are read from, typically the keys
+// of a mounted Kubernetes secret. Values read from them take precedence over the environment,
+// and the files are re-read when they change, so that a rotated secret applies without a restart.
+type CredentialFiles struct {
+	// UsernameFile and PasswordFile hold the SSH or SASL login
+	UsernameFile string
+	PasswordFile string
+	// TLSCertFile, TLSKeyFile and TLSCAFile hold the client certificate, its key and the
+	// CA certificate of qemu+tls connections
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+}
+
+// paths lists the configured files
+func (f CredentialFiles) paths() []string {
+	var paths []string
+	for _, path := range []string{f.UsernameFile, f.PasswordFile, f.TLSCertFile, f.TLSKeyFile, f.TLSCAFile} {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// loadCredentialFiles reads the configured credential files into creds
+func loadCredentialFiles(files CredentialFiles, creds *Credentials) error {
+	for _, file := range []struct {
+		path  string
+		value *string
+	}{
+		{files.UsernameFile, &creds.Username},
+		{files.PasswordFile, &creds.Password},
+		{files.TLSCertFile, &creds.CertData},
+		{files.TLSKeyFile, &creds.KeyData},
+		{files.TLSCAFile, &creds.CAData},
+	} {
+		if file.path == "" {
+			continue
+		}
+		data, err := os.ReadFile(file.path)
+		if err != nil {
+			return fmt.Errorf("failed to read credential file %s: %w", file.path, err)
+		}
+		*file.value = strings.TrimSpace(string(data))
+		log.Printf("INFO Loaded credential from file %s", file.path)
+	}
+	if (creds.CertData == "") != (creds.KeyData == "") {
+		return fmt.Errorf("the TLS client certificate and key must be set together")
+	}
+	return nil
+}
+
+// session returns the credentials and command environment in effect
+func (v *VirshProvider) session() (*Credentials, []string) {
+	v.credsMu.RLock()
+	defer v.credsMu.RUnlock()
+	return v.credentials, v.env
+}
+
+// commandEnv returns the environment virsh and ssh commands run with
+func (v *VirshProvider) commandEnv() []string {
+	_, env := v.session()
+	return env
+}
+
+// writeConnectionFiles writes the files virsh reads credentials from: the client certificates
+// of a TLS connection, whose directory is added to uri as pkipath, and the SASL login of a
+// connection not tunnelled over SSH. It returns the SASL auth file, if one was written.
+func writeConnectionFiles(creds *Credentials, uri *url.URL) (string, error) {
+	dir := filepath.Join(credentialsRuntimeDir, strings.NewReplacer(":", "_", "/", "_").Replace(uri.Host))
+	if dir == credentialsRuntimeDir {
+		dir = filepath.Join(credentialsRuntimeDir, "local")
+	}
+
+	if creds.CertData != "" && strings.Contains(uri.Scheme, "tls") {
+		pkiDir := filepath.Join(dir, "pki")
+		files := []struct{ name, data string }{
+			{"clientcert.pem", creds.CertData},
+			{"clientkey.pem", creds.KeyData},
+			{"cacert.pem", creds.CAData},
+		}
+		for _, file := range files {
+			if file.data == "" {
+				continue
+			}
+			if err := writeSecretFile(filepath.Join(pkiDir, file.name), file.data+"\n"); err != nil {
+				return "", err
+			}
+		}
+		query := uri.Query()
+		query.Set("pkipath", pkiDir)
+		uri.RawQuery = query.Encode()
+	}
+
+	if creds.Username == "" || creds.Password == "" || strings.Contains(uri.Scheme, "ssh") {
+		return "", nil
+	}
+	authFile := filepath.Join(dir, "auth.conf")
+	auth := fmt.Sprintf("[credentials-%s]\nauthname=%s\npassword=%s\n\n[auth-libvirt-default]\ncredentials=%s\n",
+		saslCredentialsName, creds.Username, creds.Password, saslCredentialsName)
+	if err := writeSecretFile(authFile, auth); err != nil {
+		return "", err
+	}
+	return authFile, nil
+}
+
+// writeSecretFile replaces a file readable only by the provider, so that virsh never sees it half written
+func writeSecretFile(path, data string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(data), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
+
+// startCredentialsWatch starts reloading the credential files when they change, once per provider.
+// The directories are watched rather than the files, since Kubernetes updates a mounted secret
+// by swapping a symlink.
+func (v *VirshProvider) startCredentialsWatch() {
+	if v.config == nil || len(v.config.CredentialFiles.paths()) == 0 {
+		return
+	}
+
+	v.watchMu.Lock()
+	defer v.watchMu.Unlock()
+	if v.credsWatch != nil {
+		return
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("WARN Failed to watch credential files, changes need a restart: %v", err)
+		return
+	}
+	watched := make(map[string]bool)
+	for _, path := range v.config.CredentialFiles.paths() {
+		dir := filepath.Dir(path)
+		if watched[dir] {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			log.Printf("WARN Failed to watch credential directory %s, changes need a restart: %v", dir, err)
+			continue
+		}
+		watched[dir] = true
+	}
+	v.credsWatch = watcher
+	go v.watchCredentialFiles(watcher)
+}
+
+// watchCredentialFiles reloads the credentials once the events of a change have settled
+func (v *VirshProvider) watchCredentialFiles(watcher *fsnotify.Watcher) {
+	reload := time.NewTimer(credentialsReloadDelay)
+	reload.Stop()
+	defer reload.Stop()
+
+	for {
+		select {
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			reload.Reset(credentialsReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("WARN Credential file watch error: %v", err)
+		case <-reload.C:
+			v.reloadCredentials()
+		}
+	}
+}
+
+// reloadCredentials re-reads the credentials and applies them to commands started from now on.
+// Credentials that cannot be read are logged and the current ones kept. The pooled SSH
+// ControlMaster sockets were authenticated with the old credentials, so the pool is
+// invalidated and each slot redials on its next use; a revoked key stops working then
+// rather than when the socket idles out. The SSH username is part of the connection URI
+// and only changes with a restart.
+func (v *VirshProvider) reloadCredentials() {
+	creds, err := v.loadCredentials()
+	if err != nil {
+		log.Printf("WARN Failed to reload credentials, keeping the current ones: %v", err)
+		return
+	}
+
+	v.credsMu.Lock()
+	defer v.credsMu.Unlock()
+	if v.credentials != nil && *creds == *v.credentials {
+		return
+	}
+
+	parsedURI, err := url.Parse(v.uri)
+	if err != nil {
+		log.Printf("WARN Failed to reload credentials, keeping the current ones: %v", err)
+		return
+	}
+	authFile, err := writeConnectionFiles(creds, parsedURI)
+	if err != nil {
+		log.Printf("WARN Failed to reload credentials, keeping the current ones: %v", err)
+		return
+	}
+	if strings.Contains(parsedURI.Scheme, "ssh") && v.credentials != nil && creds.Username != v.credentials.Username {
+		log.Printf("WARN The SSH username changed; it takes effect when the provider restarts")
+	}
+
+	env := withEnv(v.env, "SSHPASS", creds.Password)
+	if authFile != "" {
+		env = withEnv(env, "LIBVIRT_AUTH_FILE", authFile)
+	}
+	v.credentials = creds
+	v.env = env
+	getConnPool(v.uri).invalidate()
+	log.Printf("INFO Reloaded libvirt credentials from %s", strings.Join(v.config.CredentialFiles.paths(), ", "))
+}
+
+// withEnv returns a copy of env with key set to value; an empty value removes key
+func withEnv(env []string, key, value string) []string {
+	result := make([]string, 0, len(env)+1)
+	for _, entry := range env {
+		if !strings.HasPrefix(entry, key+"=") {
+			result = append(result, entry)
+		}
+	}
+	if value != "" {
+		result = append(result, key+"="+value)
+	}
+	return result
+}
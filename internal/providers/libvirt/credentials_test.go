@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadCredentialsRedialsPooledConnections(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	t.Setenv("LIBVIRT_POOL_SIZE", "1")
+	for _, key := range []string{"LIBVIRT_USERNAME", "LIBVIRT_PASSWORD", "LIBVIRT_SSH_PRIVATE_KEY"} {
+		t.Setenv(key, "")
+	}
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("old-password\n"), 0o600))
+
+	v := &VirshProvider{
+		config:      &ProviderConfig{CredentialFiles: CredentialFiles{PasswordFile: passwordFile}},
+		uri:         "qemu+ssh://admin@" + t.Name() + "/system",
+		credentials: &Credentials{Password: "old-password"},
+	}
+	pool := getConnPool(v.uri)
+	t.Cleanup(func() {
+		poolsMu.Lock()
+		delete(pools, v.uri)
+		poolsMu.Unlock()
+	})
+	ctx := context.Background()
+	acquire := func() *pooledConn {
+		conn, err := pool.acquire(ctx)
+		require.NoError(t, err)
+		return conn
+	}
+
+	conn := acquire()
+	establish(t, pool, conn)
+
+	// Unchanged credentials keep the connection
+	v.reloadCredentials()
+	conn = acquire()
+	assert.True(t, conn.established)
+	assert.FileExists(t, conn.controlPath)
+	pool.release(conn)
+
+	// While a connection is checked out the credentials rotate; it is redialed on its next use
+	conn = acquire()
+	require.NoError(t, os.WriteFile(passwordFile, []byte("new-password\n"), 0o600))
+	v.reloadCredentials()
+	assert.Equal(t, "new-password", v.credentials.Password)
+	pool.release(conn)
+
+	conn = acquire()
+	defer pool.release(conn)
+	assert.False(t, conn.established, "connection authenticated with the old credentials was reused")
+	assert.NoFileExists(t, conn.controlPath)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// networkDefinition is the subset of a libvirt network definition needed for DHCP reservations
+type networkDefinition struct {
+	Name string             `xml:"name"`
+	IPs  []networkIPElement `xml:"ip"`
+}
+
+// networkIPElement is an <ip> element of a network with its optional DHCP server
+type networkIPElement struct {
+	Address string `xml:"address,attr"`
+	Netmask string `xml:"netmask,attr"`
+	Prefix  string `xml:"prefix,attr"`
+	DHCP    *struct {
+		Hosts []dhcpHost `xml:"host"`
+	} `xml:"dhcp"`
+}
+
+// dhcpHost is an <ip>/<dhcp>/<host> entry of a network
+type dhcpHost struct {
+	MAC  string `xml:"mac,attr"`
+	Name string `xml:"name,attr"`
+	IP   string `xml:"ip,attr"`
+}
+
+// dhcpReservation is a DHCP host entry tying the MAC of a VM interface to a static IP
+type dhcpReservation struct {
+	Network string
+	// ParentIndex is the position of the <ip> element the entry belongs to
+	ParentIndex int
+	Host        dhcpHost
+}
+
+// xml renders the host entry as net-update expects it
+func (h dhcpHost) xml() string {
+	s := fmt.Sprintf("<host mac='%s'", xmlEscape(h.MAC))
+	if h.Name != "" {
+		s += fmt.Sprintf(" name='%s'", xmlEscape(h.Name))
+	}
+	if h.IP != "" {
+		s += fmt.Sprintf(" ip='%s'", xmlEscape(h.IP))
+	}
+	return s + "/>"
+}
+
+// subnet returns the IPv4 subnet of the element
+func (e networkIPElement) subnet() (*net.IPNet, error) {
+	ip := net.ParseIP(e.Address).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("not an IPv4 address: %q", e.Address)
+	}
+	var mask net.IPMask
+	switch {
+	case e.Prefix != "":
+		bits, err := strconv.Atoi(e.Prefix)
+		if err != nil || bits < 0 || bits > 32 {
+			return nil, fmt.Errorf("invalid prefix %q", e.Prefix)
+		}
+		mask = net.CIDRMask(bits, 32)
+	case e.Netmask != "":
+		netmask := net.ParseIP(e.Netmask).To4()
+		if netmask == nil {
+			return nil, fmt.Errorf("invalid netmask %q", e.Netmask)
+		}
+		mask = net.IPMask(netmask)
+	default:
+		mask = ip.DefaultMask()
+	}
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}, nil
+}
+
+// getNetworkDefinition reads the definition of a libvirt network
+func (v *VirshProvider) getNetworkDefinition(ctx context.Context, network string) (*networkDefinition, error) {
+	result, err := v.runVirshCommand(ctx, "net-dumpxml", network)
+	if err != nil {
+		return nil, err
+	}
+	var def networkDefinition
+	if err := xml.Unmarshal([]byte(result.Stdout), &def); err != nil {
+		return nil, fmt.Errorf("failed to parse definition of network %s: %w", network, err)
+	}
+	return &def, nil
+}
+
+// planDHCPReservations resolves the DHCP host entries for the attachments of a create request
+// that ask for a static IP. The IP must be a host address of a DHCP-enabled subnet of the
+// libvirt network and must not be reserved for another MAC. Entries that already exist with the
+// same MAC and IP, e.g. left by an earlier attempt, are not planned again.
+func (p *Provider) planDHCPReservations(ctx context.Context, networks []contracts.NetworkAttachment) ([]dhcpReservation, error) {
+	var reservations []dhcpReservation
+	definitions := make(map[string]*networkDefinition)
+	planned := make(map[string]string)
+
+	for _, attachment := range p.withNetworkDefaults(networks) {
+		if attachment.StaticIP == "" {
+			continue
+		}
+		if networkSourceType(attachment) != networkSourceNetwork {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf(
+				"network %q: static IP %s can only be reserved on a libvirt-managed network", attachment.Name, attachment.StaticIP), nil)
+		}
+		ip := net.ParseIP(attachment.StaticIP).To4()
+		if ip == nil {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("network %q: invalid static IP %q", attachment.Name, attachment.StaticIP), nil)
+		}
+		// assignMACAddresses has given every interface a normalized MAC by now
+		mac := attachment.MacAddress
+		if owner, ok := planned[ip.String()]; ok && owner != mac {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("static IP %s is requested for more than one interface", ip), nil)
+		}
+		planned[ip.String()] = mac
+
+		def, ok := definitions[attachment.NetworkName]
+		if !ok {
+			var err error
+			if def, err = p.virshProvider.getNetworkDefinition(ctx, attachment.NetworkName); err != nil {
+				return nil, contracts.NewNotFoundError(fmt.Sprintf("network %s is not defined", attachment.NetworkName), err)
+			}
+			definitions[attachment.NetworkName] = def
+		}
+
+		reservation, exists, err := planDHCPReservation(def, ip, mac)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			reservations = append(reservations, reservation)
+		}
+	}
+	return reservations, nil
+}
+
+// planDHCPReservation places a reservation of ip for mac in a network definition;
+// exists reports that the network already holds exactly this entry
+func planDHCPReservation(def *networkDefinition, ip net.IP, mac string) (reservation dhcpReservation, exists bool, err error) {
+	for index, element := range def.IPs {
+		subnet, err := element.subnet()
+		if err != nil || !subnet.Contains(ip) {
+			continue
+		}
+		if element.DHCP == nil {
+			return reservation, false, contracts.NewInvalidSpecError(fmt.Sprintf(
+				"network %s does not serve DHCP on %s; static IP %s cannot be reserved", def.Name, subnet, ip), nil)
+		}
+
+		network := binary.BigEndian.Uint32(subnet.IP)
+		broadcast := network | ^binary.BigEndian.Uint32(subnet.Mask)
+		host := binary.BigEndian.Uint32(ip)
+		ones, _ := subnet.Mask.Size()
+		if ones < 31 && (host == network || host == broadcast) {
+			return reservation, false, contracts.NewInvalidSpecError(fmt.Sprintf(
+				"static IP %s is not a host address of %s", ip, subnet), nil)
+		}
+		if ip.Equal(net.ParseIP(element.Address)) {
+			return reservation, false, contracts.NewInvalidSpecError(fmt.Sprintf(
+				"static IP %s is the address of network %s itself", ip, def.Name), nil)
+		}
+
+		for _, existing := range element.DHCP.Hosts {
+			sameIP := ip.Equal(net.ParseIP(existing.IP))
+			sameMAC := strings.EqualFold(existing.MAC, mac)
+			switch {
+			case sameIP && sameMAC:
+				return reservation, true, nil
+			case sameIP:
+				return reservation, false, contracts.NewConflictError(fmt.Sprintf(
+					"static IP %s is already reserved on network %s for %s", ip, def.Name, existing.MAC), nil)
+			case sameMAC:
+				return reservation, false, contracts.NewConflictError(fmt.Sprintf(
+					"MAC %s already has a DHCP reservation for %s on network %s", mac, existing.IP, def.Name), nil)
+			}
+		}
+
+		return dhcpReservation{
+			Network:     def.Name,
+			ParentIndex: index,
+			Host:        dhcpHost{MAC: mac, IP: ip.String()},
+		}, false, nil
+	}
+	return reservation, false, contracts.NewInvalidSpecError(fmt.Sprintf(
+		"static IP %s is not within any subnet of network %s", ip, def.Name), nil)
+}
+
+// updateDHCPHost runs net-update on the ip-dhcp-host section of a network. The change is
+// persisted and, when the network is active, also applied to its running dnsmasq.
+func (v *VirshProvider) updateDHCPHost(ctx context.Context, command, network string, parentIndex int, host dhcpHost) error {
+	args := []string{"net-update", network, command, "ip-dhcp-host", v.quoteRemoteArg(host.xml()),
+		"--parent-index", strconv.Itoa(parentIndex), "--config"}
+	if info, err := v.runVirshCommand(ctx, "net-info", network); err == nil && parseColonFields(info.Stdout)["Active"] == "yes" {
+		args = append(args, "--live")
+	}
+	_, err := v.runVirshCommand(ctx, args...)
+	return err
+}
+
+// addDHCPReservations adds the planned host entries, recording each in rollback once added
+func (p *Provider) addDHCPReservations(ctx context.Context, reservations []dhcpReservation, rollback *createRollback) error {
+	for _, reservation := range reservations {
+		log.Printf("INFO Reserving %s for %s on network %s", reservation.Host.IP, reservation.Host.MAC, reservation.Network)
+		if err := p.virshProvider.updateDHCPHost(ctx, "add-last", reservation.Network, reservation.ParentIndex, reservation.Host); err != nil {
+			return fmt.Errorf("failed to reserve %s on network %s: %w", reservation.Host.IP, reservation.Network, err)
+		}
+		rollback.reservations = append(rollback.reservations, reservation)
+	}
+	return nil
+}
+
+// removeDHCPReservations deletes the host entries tied to the interfaces of a domain from
+// the libvirt networks they are attached to. Failures are logged, not returned.
+func (p *Provider) removeDHCPReservations(ctx context.Context, domain *domainXML) {
+	macsByNetwork := make(map[string]map[string]bool)
+	for _, iface := range domain.Devices.Interfaces {
+		if iface.Type != "network" || iface.Source.Network == "" || iface.MAC.Address == "" {
+			continue
+		}
+		if macsByNetwork[iface.Source.Network] == nil {
+			macsByNetwork[iface.Source.Network] = make(map[string]bool)
+		}
+		macsByNetwork[iface.Source.Network][strings.ToLower(iface.MAC.Address)] = true
+	}
+
+	for network, macs := range macsByNetwork {
+		def, err := p.virshProvider.getNetworkDefinition(ctx, network)
+		if err != nil {
+			log.Printf("WARN Failed to read network %s to remove DHCP reservations: %v", network, err)
+			continue
+		}
+		for index, element := range def.IPs {
+			if element.DHCP == nil {
+				continue
+			}
+			for _, host := range element.DHCP.Hosts {
+				if !macs[strings.ToLower(host.MAC)] {
+					continue
+				}
+				if err := p.virshProvider.updateDHCPHost(ctx, "delete", network, index, host); err != nil {
+					log.Printf("WARN Failed to remove DHCP reservation of %s on network %s: %v", host.MAC, network, err)
+					continue
+				}
+				log.Printf("INFO Removed DHCP reservation of %s (%s) on network %s", host.MAC, host.IP, network)
+			}
+		}
+	}
+}
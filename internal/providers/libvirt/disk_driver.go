@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Disk bus, cache, I/O and discard settings. Defaults (virtio, cache none) are safe for
+// every image format and avoid host page cache double-buffering.
+const (
+	diskBusVirtio = vmspec.DiskBusVirtio
+	diskBusSCSI   = vmspec.DiskBusSCSI
+	diskBusSATA   = vmspec.DiskBusSATA
+
+	diskCacheNone       = "none"
+	diskCacheDirectSync = "directsync"
+
+	diskIONative = "native"
+
+	diskDiscardUnmap = "unmap"
+)
+
+var (
+	supportedDiskBuses   = []string{diskBusVirtio, diskBusSCSI, diskBusSATA}
+	supportedDiskCaches  = []string{diskCacheNone, "writeback", "writethrough", diskCacheDirectSync, "unsafe"}
+	supportedDiskIOModes = []string{diskIONative, "threads"}
+	supportedDiscards    = []string{diskDiscardUnmap, "ignore"}
+)
+
+// diskDriver holds the resolved bus and driver settings of a disk
+type diskDriver struct {
+	Bus     string
+	Cache   string
+	IO      string
+	Discard string
+}
+
+// resolveDiskDriver applies the defaults to the settings of a disk spec
+func resolveDiskDriver(disk contracts.DiskSpec) diskDriver {
+	driver := diskDriver{
+		Bus:     strings.ToLower(disk.Bus),
+		Cache:   strings.ToLower(disk.CacheMode),
+		IO:      strings.ToLower(disk.IOMode),
+		Discard: strings.ToLower(disk.Discard),
+	}
+	if driver.Bus == "" {
+		driver.Bus = diskBusVirtio
+	}
+	if driver.Cache == "" {
+		driver.Cache = diskCacheNone
+	}
+	// Shared disks must not be cached by the host of one of the VMs
+	if disk.Shareable && driver.Cache != diskCacheNone && driver.Cache != diskCacheDirectSync {
+		driver.Cache = diskCacheNone
+	}
+	return driver
+}
+
+// validateDiskDriver checks the bus and driver settings of a disk stored in the given format
+func validateDiskDriver(disk contracts.DiskSpec, format string) error {
+	driver := resolveDiskDriver(disk)
+	for _, setting := range []struct {
+		name      string
+		value     string
+		supported []string
+	}{
+		{"bus", driver.Bus, supportedDiskBuses},
+		{"cache mode", driver.Cache, supportedDiskCaches},
+		{"io mode", driver.IO, supportedDiskIOModes},
+		{"discard", driver.Discard, supportedDiscards},
+	} {
+		if setting.value == "" || slices.Contains(setting.supported, setting.value) {
+			continue
+		}
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: unsupported %s %q (supported: %s)",
+			disk.Name, setting.name, setting.value, strings.Join(setting.supported, ", ")), nil)
+	}
+
+	if driver.IO == diskIONative && driver.Cache != diskCacheNone && driver.Cache != diskCacheDirectSync {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"disk %q: io mode native requires cache mode none or directsync, got %s", disk.Name, driver.Cache), nil)
+	}
+	if driver.Discard == diskDiscardUnmap && format != "qcow2" && (format != "raw" || (disk.Type != "thin" && disk.AllocationPolicy != allocationThin)) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"disk %q: discard unmap requires a qcow2 or thin-provisioned raw disk, got %s (type %q)", disk.Name, format, disk.Type), nil)
+	}
+	return nil
+}
+
+// driverXML renders the <driver> element of a disk in the given format
+func (d diskDriver) driverXML(format string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<driver name='qemu' type='%s' cache='%s'", format, d.Cache)
+	if d.IO != "" {
+		fmt.Fprintf(&b, " io='%s'", d.IO)
+	}
+	if d.Discard != "" {
+		fmt.Fprintf(&b, " discard='%s'", d.Discard)
+	}
+	b.WriteString("/>")
+	return b.String()
+}
+
+// targetDev returns the device name of the index-th disk on the driver's bus (vda, sdb, ...)
+func (d diskDriver) targetDev(index int) string {
+	prefix := "vd"
+	if d.Bus != diskBusVirtio {
+		prefix = "sd"
+	}
+	return fmt.Sprintf("%s%c", prefix, 'a'+index)
+}
+
+// renderDiskXML renders a disk element for a file or, on block pools, a block device.
+// The virtio root disk keeps its fixed PCI slot; other disks are addressed by libvirt.
+func renderDiskXML(disk contracts.DiskSpec, target, format, diskPath string, root bool) string {
+	driver := resolveDiskDriver(disk)
+	log.Printf("DEBUG Disk %s settings: bus=%s cache=%s io=%s discard=%s", target, driver.Bus, driver.Cache, driver.IO, driver.Discard)
+
+	address := ""
+	if root && driver.Bus == diskBusVirtio {
+		address = "\n      <address type='pci' domain='0x0000' bus='0x00' slot='0x07' function='0x0'/>"
+	}
+
+	typ, source := "file", fmt.Sprintf("<source file='%s'/>", xmlEscape(diskPath))
+	if strings.HasPrefix(diskPath, "/dev/") {
+		typ, source = "block", fmt.Sprintf("<source dev='%s'/>", xmlEscape(diskPath))
+	}
+
+	shareable := ""
+	if disk.Shareable {
+		shareable = "\n      <shareable/>"
+	}
+
+	return fmt.Sprintf(`    <disk type='%s' device='disk'>
+      %s
+      %s
+      <target dev='%s' bus='%s'/>%s%s
+    </disk>`, typ, driver.driverXML(format), source, target, driver.Bus, shareable, address)
+}
+
+// scsiControllerXML renders the virtio-scsi controller needed by disks on the SCSI bus
+func scsiControllerXML(disks []contracts.DiskSpec) string {
+	for _, disk := range disks {
+		if resolveDiskDriver(disk).Bus == diskBusSCSI {
+			return `
+    <controller type='scsi' index='0' model='virtio-scsi'/>`
+		}
+	}
+	return ""
+}
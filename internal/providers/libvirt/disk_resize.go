@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// defaultLogicalBlockSize is used when a disk does not declare <blockio>
+const defaultLogicalBlockSize = 512
+
+// guestRescanCommand asks the guest kernel to re-read block device capacities.
+// virtio-blk picks up the new size on its own; SCSI disks need an explicit rescan.
+const guestRescanCommand = "for f in /sys/class/block/*/device/rescan; do [ -w \"$f\" ] && echo 1 > \"$f\"; done; true"
+
+// ResizeDisk grows the disk attached at target to sizeBytes.
+// Running domains are resized online with blockresize; stopped domains have
+// their backing volume resized. It returns false when the disk already has the requested size.
+func (p *Provider) ResizeDisk(ctx context.Context, vmID, target string, sizeBytes int64) (bool, error) {
+	if p.virshProvider == nil {
+		return false, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if target == "" || sizeBytes <= 0 {
+		return false, contracts.NewInvalidSpecError("disk resize requires a target device and a positive size", nil)
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return false, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+
+	disk, ok := domain.findDisk(target)
+	if !ok || disk.Device == "cdrom" || disk.Device == "floppy" {
+		return false, contracts.NewNotFoundError(fmt.Sprintf("domain %s has no disk at target %s", vmID, target), nil)
+	}
+	if disk.ReadOnly != nil {
+		return false, contracts.NewInvalidSpecError(fmt.Sprintf("disk %s is read-only and cannot be resized", target), nil)
+	}
+	if disk.Shareable != nil {
+		return false, contracts.NewInvalidSpecError(fmt.Sprintf("disk %s is shared and cannot be resized", target), nil)
+	}
+
+	blockSize := int64(defaultLogicalBlockSize)
+	if disk.BlockIO != nil && disk.BlockIO.LogicalBlockSize > 0 {
+		blockSize = disk.BlockIO.LogicalBlockSize
+	}
+	if sizeBytes%blockSize != 0 {
+		return false, contracts.NewInvalidSpecError(
+			fmt.Sprintf("size %d is not a multiple of the %d byte logical block size of disk %s", sizeBytes, blockSize, target), nil)
+	}
+
+	currentBytes, err := p.virshProvider.getBlockCapacity(ctx, vmID, target)
+	if err != nil {
+		return false, contracts.NewRetryableError(fmt.Sprintf("failed to read capacity of disk %s", target), err)
+	}
+	if sizeBytes < currentBytes {
+		return false, contracts.NewInvalidSpecError(
+			fmt.Sprintf("cannot shrink disk %s from %d to %d bytes", target, currentBytes, sizeBytes), nil)
+	}
+	if sizeBytes == currentBytes {
+		return false, nil
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return false, contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	log.Printf("INFO Resizing disk %s of %s: %d -> %d bytes", target, vmID, currentBytes, sizeBytes)
+
+	size := fmt.Sprintf("%dB", sizeBytes)
+	if state == "running" {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "blockresize", vmID, target, size); err != nil {
+			return false, fmt.Errorf("failed to resize disk %s: %w", target, err)
+		}
+		p.rescanGuestDisks(ctx, vmID)
+	} else {
+		path := disk.sourcePath()
+		if path == "" {
+			return false, contracts.NewNotSupportedError(fmt.Sprintf("disk %s has no resizable backing volume", target))
+		}
+		if _, err := p.virshProvider.runVirshCommand(ctx, "vol-resize", path, size); err != nil {
+			return false, fmt.Errorf("failed to resize volume %s: %w", path, err)
+		}
+	}
+
+	log.Printf("INFO Successfully resized disk %s of %s", target, vmID)
+	return true, nil
+}
+
+// getBlockCapacity returns the guest-visible capacity of a disk in bytes
+func (v *VirshProvider) getBlockCapacity(ctx context.Context, vmID, target string) (int64, error) {
+	result, err := v.runVirshCommand(ctx, "domblkinfo", vmID, target)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == "Capacity" {
+			return strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("capacity not reported for %s", target)
+}
+
+// rescanGuestDisks asks the guest agent, when present, to rescan block devices.
+// Failures are logged only: the resize itself has already succeeded.
+func (p *Provider) rescanGuestDisks(ctx context.Context, vmID string) {
+	guestAgent := NewGuestAgentProvider(p.virshProvider)
+	if !guestAgent.isGuestAgentAvailable(ctx, vmID) {
+		log.Printf("DEBUG Guest agent not available for %s, skipping block device rescan", vmID)
+		return
+	}
+	if _, err := guestAgent.ExecuteGuestCommand(ctx, vmID, guestRescanCommand); err != nil {
+		log.Printf("WARN Guest block device rescan failed for %s: %v", vmID, err)
+	}
+}
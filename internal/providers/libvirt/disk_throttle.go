@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// domainIOTune is the <iotune> element of a domain disk; libvirt omits unset limits
+type domainIOTune struct {
+	TotalBytesPerSec int64 `xml:"total_bytes_sec"`
+	ReadBytesPerSec  int64 `xml:"read_bytes_sec"`
+	WriteBytesPerSec int64 `xml:"write_bytes_sec"`
+	TotalIOPS        int64 `xml:"total_iops_sec"`
+	ReadIOPS         int64 `xml:"read_iops_sec"`
+	WriteIOPS        int64 `xml:"write_iops_sec"`
+}
+
+// iotuneXML renders the <iotune> element of a disk; a throttle without limits renders nothing
+func iotuneXML(throttle contracts.DiskThrottle) string {
+	var b strings.Builder
+	for _, limit := range []struct {
+		element string
+		value   int64
+	}{
+		{"total_bytes_sec", throttle.TotalBytesPerSec},
+		{"read_bytes_sec", throttle.ReadBytesPerSec},
+		{"write_bytes_sec", throttle.WriteBytesPerSec},
+		{"total_iops_sec", throttle.TotalIOPS},
+		{"read_iops_sec", throttle.ReadIOPS},
+		{"write_iops_sec", throttle.WriteIOPS},
+	} {
+		if limit.value > 0 {
+			fmt.Fprintf(&b, "\n        <%s>%d</%s>", limit.element, limit.value, limit.element)
+		}
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	return "\n      <iotune>" + b.String() + "\n      </iotune>"
+}
+
+// withDiskThrottle adds the <iotune> element of a throttle to a rendered disk
+func withDiskThrottle(diskXML string, throttle contracts.DiskThrottle) string {
+	closing := "\n    </disk>"
+	return strings.Replace(diskXML, closing, iotuneXML(throttle)+closing, 1)
+}
+
+// checkDiskThrottleTargets verifies that every throttle names one of the given disk targets
+func checkDiskThrottleTargets(throttles []contracts.DiskThrottle, targets []string) error {
+	for _, throttle := range throttles {
+		found := false
+		for _, target := range targets {
+			if target == throttle.Target {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("cannot throttle disk %s: the VM has no such disk (disks: %s)",
+				throttle.Target, strings.Join(targets, ", ")), nil)
+		}
+	}
+	return nil
+}
+
+// diskTargets lists the guest device names of the disks of a domain; CD-ROMs cannot be throttled
+func (d *domainXML) diskTargets() []string {
+	var targets []string
+	for _, disk := range d.Devices.Disks {
+		if disk.Device == "disk" && disk.Target.Dev != "" {
+			targets = append(targets, disk.Target.Dev)
+		}
+	}
+	return targets
+}
+
+// diskThrottles returns the I/O limits of the throttled disks of a domain. For a running
+// domain the live definition holds the limits in effect, including changes made with virsh.
+func (d *domainXML) diskThrottles() []contracts.DiskThrottle {
+	var throttles []contracts.DiskThrottle
+	for _, disk := range d.Devices.Disks {
+		if disk.IOTune == nil || disk.Target.Dev == "" {
+			continue
+		}
+		throttle := contracts.DiskThrottle{
+			Target:           disk.Target.Dev,
+			TotalBytesPerSec: disk.IOTune.TotalBytesPerSec,
+			ReadBytesPerSec:  disk.IOTune.ReadBytesPerSec,
+			WriteBytesPerSec: disk.IOTune.WriteBytesPerSec,
+			TotalIOPS:        disk.IOTune.TotalIOPS,
+			ReadIOPS:         disk.IOTune.ReadIOPS,
+			WriteIOPS:        disk.IOTune.WriteIOPS,
+		}
+		if throttle == (contracts.DiskThrottle{Target: disk.Target.Dev}) {
+			continue
+		}
+		throttles = append(throttles, throttle)
+	}
+	return throttles
+}
+
+// applyDiskThrottles sets the I/O limits of each throttled disk with blkdeviotune. Every
+// limit is passed, so limits a throttle leaves at 0 are removed. Changes apply to the
+// definitions of scope, and to a running domain without a reboot.
+func (p *Provider) applyDiskThrottles(ctx context.Context, vmID string, throttles []contracts.DiskThrottle, scope changeScope) error {
+	for _, throttle := range throttles {
+		args := []string{"blkdeviotune", vmID, throttle.Target,
+			"--total-bytes-sec", strconv.FormatInt(throttle.TotalBytesPerSec, 10),
+			"--read-bytes-sec", strconv.FormatInt(throttle.ReadBytesPerSec, 10),
+			"--write-bytes-sec", strconv.FormatInt(throttle.WriteBytesPerSec, 10),
+			"--total-iops-sec", strconv.FormatInt(throttle.TotalIOPS, 10),
+			"--read-iops-sec", strconv.FormatInt(throttle.ReadIOPS, 10),
+			"--write-iops-sec", strconv.FormatInt(throttle.WriteIOPS, 10)}
+		args = append(args, scope.flags()...)
+		if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+			return fmt.Errorf("failed to throttle disk %s: %w", throttle.Target, err)
+		}
+		log.Printf("INFO Throttled disk %s of domain %s (bytes/s total=%d read=%d write=%d, IOPS total=%d read=%d write=%d)",
+			throttle.Target, vmID, throttle.TotalBytesPerSec, throttle.ReadBytesPerSec, throttle.WriteBytesPerSec,
+			throttle.TotalIOPS, throttle.ReadIOPS, throttle.WriteIOPS)
+	}
+	return nil
+}
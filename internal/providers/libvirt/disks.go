@@ -0,0 +1,370 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	diskFormatQcow2 = "qcow2"
+	diskFormatRaw   = "raw"
+
+	// maxDisksPerBusPrefix is the number of target names (vda..vdz, sda..sdz) per prefix
+	maxDisksPerBusPrefix = 26
+)
+
+// diskTargetPattern matches the guest device names Create assigns
+var diskTargetPattern = regexp.MustCompile(`^(vd|sd)[a-z]$`)
+
+// diskPlan is a disk of a create request with its target device, format, source and pool resolved
+type diskPlan struct {
+	Spec   contracts.DiskSpec
+	Target string
+	Format string
+	Source string
+	// SourceFormat is the format of the source image; empty detects it
+	SourceFormat string
+	SizeGiB      int
+	Pool         poolDefinition
+	CreatePool   bool
+	VolumeName   string
+	// Path is the host path of the volume, set once it is created or resolved
+	Path string
+	// Referenced marks an existing volume that was attached, not created, and is kept on delete
+	Referenced bool
+}
+
+// requestDisks returns the disks of a create request; the first one is the root disk
+func requestDisks(req contracts.CreateRequest) []contracts.DiskSpec {
+	if len(req.Disks) > 0 {
+		return req.Disks
+	}
+	return []contracts.DiskSpec{{}}
+}
+
+// diskFormat returns the volume format of a disk spec
+func diskFormat(disk contracts.DiskSpec) string {
+	if disk.Format == "" {
+		return diskFormatQcow2
+	}
+	return strings.ToLower(disk.Format)
+}
+
+// validateDisks checks the format, size, driver settings and target names of the disks of a request
+func validateDisks(disks []contracts.DiskSpec) error {
+	for i, disk := range disks {
+		if err := validateAllocationPolicy(disk); err != nil {
+			return err
+		}
+		if disk.Shareable && i == 0 {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: the root disk cannot be shareable", disk.Name), nil)
+		}
+		if isReferencedDisk(disk) {
+			if err := validateReferencedDisk(disk, i == 0); err != nil {
+				return err
+			}
+			if err := validateDiskDriver(disk, strings.ToLower(disk.Format)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		format := diskFormat(disk)
+		switch {
+		case format != diskFormatQcow2 && format != diskFormatRaw:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: unsupported format %q (supported: qcow2, raw)", disk.Name, disk.Format), nil)
+		case i == 0 && format != diskFormatQcow2:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: the root disk must be qcow2, got %s", disk.Name, format), nil)
+		case disk.SourceFormat != "" && disk.SourceImage == "":
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: a source format needs a source image", disk.Name), nil)
+		case disk.SizeGiB < 0:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: size must not be negative", disk.Name), nil)
+		case i > 0 && disk.SourceImage == "" && disk.SizeGiB == 0:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: an empty data disk needs a size", disk.Name), nil)
+		}
+		if err := validateSourceFormat(disk.SourceFormat); err != nil {
+			return fmt.Errorf("disk %q: %w", disk.Name, err)
+		}
+		if err := validateDiskDriver(disk, format); err != nil {
+			return err
+		}
+	}
+	_, err := assignDiskTargets(disks)
+	return err
+}
+
+// shareableDiskWarnings reports shareable disks whose settings are unsafe or were adjusted:
+// qcow2 metadata is not safe for concurrent writers, and host caching is turned off
+func shareableDiskWarnings(plans []diskPlan) []string {
+	var warnings []string
+	for _, plan := range plans {
+		if !plan.Spec.Shareable {
+			continue
+		}
+		if plan.Format == diskFormatQcow2 {
+			warnings = append(warnings, fmt.Sprintf("disk %s is shareable but qcow2, which is not safe for concurrent access; use raw", plan.Target))
+		}
+		if requested := strings.ToLower(plan.Spec.CacheMode); requested != "" && requested != resolveDiskDriver(plan.Spec).Cache {
+			warnings = append(warnings, fmt.Sprintf("disk %s is shareable, using cache mode none instead of %s", plan.Target, requested))
+		}
+	}
+	return warnings
+}
+
+// assignDiskTargets returns the guest device of every disk. Explicit targets are kept; the
+// others get the next free name on their bus prefix (vda, vdb, ... for virtio, sda, ... otherwise).
+func assignDiskTargets(disks []contracts.DiskSpec) ([]string, error) {
+	targets := make([]string, len(disks))
+	used := make(map[string]bool, len(disks))
+
+	for i, disk := range disks {
+		if disk.Target == "" {
+			continue
+		}
+		target := strings.ToLower(disk.Target)
+		prefix := resolveDiskDriver(disk).targetDev(0)[:2]
+		if !diskTargetPattern.MatchString(target) || !strings.HasPrefix(target, prefix) {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: target %q must be %sa..%sz on the %s bus",
+				disk.Name, disk.Target, prefix, prefix, resolveDiskDriver(disk).Bus), nil)
+		}
+		if used[target] {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: target %s is used by another disk", disk.Name, target), nil)
+		}
+		used[target] = true
+		targets[i] = target
+	}
+
+	for i, disk := range disks {
+		if targets[i] != "" {
+			continue
+		}
+		driver := resolveDiskDriver(disk)
+		for index := 0; targets[i] == "" && index < maxDisksPerBusPrefix; index++ {
+			if target := driver.targetDev(index); !used[target] {
+				used[target] = true
+				targets[i] = target
+			}
+		}
+		if targets[i] == "" {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: no free target name on the %s bus", disk.Name, driver.Bus), nil)
+		}
+	}
+	return targets, nil
+}
+
+// planDisks resolves every disk of a create request. The root disk is created from the VM image
+// unless it names its own source image and defaults to the size of the VM class.
+// Disks referencing an existing volume are checked to exist and get its path and format.
+func (p *Provider) planDisks(ctx context.Context, req contracts.CreateRequest) ([]diskPlan, error) {
+	disks := requestDisks(req)
+	targets, err := assignDiskTargets(disks)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]diskPlan, len(disks))
+	for i, disk := range disks {
+		plan := diskPlan{
+			Spec:         disk,
+			Target:       targets[i],
+			Format:       diskFormat(disk),
+			Source:       disk.SourceImage,
+			SizeGiB:      int(disk.SizeGiB),
+			VolumeName:   fmt.Sprintf("%s-disk-%s", req.Name, targets[i]),
+			SourceFormat: strings.ToLower(disk.SourceFormat),
+		}
+		if isReferencedDisk(disk) {
+			plan.Referenced = true
+			if plan.Path, plan.Format, err = p.resolveReferencedDisk(ctx, disk); err != nil {
+				return nil, err
+			}
+			plans[i] = plan
+			continue
+		}
+		if i == 0 {
+			plan.VolumeName = req.Name + "-disk"
+			if plan.Source == "" {
+				plan.Source = p.extractImageSpec(req)
+				plan.SourceFormat = strings.ToLower(req.Image.Format)
+				if err := validateSourceFormat(plan.SourceFormat); err != nil {
+					return nil, err
+				}
+			}
+			if plan.SizeGiB == 0 {
+				plan.SizeGiB = p.extractDiskSize(req)
+			}
+		}
+
+		requested := disk.Pool
+		if requested == "" {
+			requested = req.StoragePool
+		}
+		plan.Pool, plan.CreatePool, err = p.selectStoragePool(ctx, requested, plan.Format)
+		if err != nil {
+			return nil, err
+		}
+		if err := validatePoolAllocation(plan.Pool, disk.AllocationPolicy); err != nil {
+			return nil, err
+		}
+		plans[i] = plan
+	}
+	return plans, nil
+}
+
+// checkDiskCapacity verifies that the disks stored in each pool fit its free space.
+// Pools still to be created and pools whose free space is unknown yield warnings instead.
+func (p *Provider) checkDiskCapacity(ctx context.Context, plans []diskPlan) ([]string, error) {
+	required := make(map[string]int64)
+	var order []string
+	for _, plan := range plans {
+		if plan.CreatePool || plan.Referenced {
+			continue
+		}
+		if _, ok := required[plan.Pool.Name]; !ok {
+			order = append(order, plan.Pool.Name)
+		}
+		// The converted copy of a source image takes up to its virtual size
+		size := int64(plan.SizeGiB) * 1024 * 1024 * 1024
+		if imageSize := p.sourceImageSize(ctx, plan); imageSize > size {
+			size = imageSize
+		}
+		required[plan.Pool.Name] += size
+	}
+
+	var warnings []string
+	for _, name := range order {
+		pool, err := p.getPoolCapacity(ctx, name)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("could not determine free space of pool %s: %v", name, err))
+			continue
+		}
+		if required[name] > pool.AvailableBytes {
+			return warnings, contracts.NewQuotaExceededError(fmt.Sprintf("storage pool %s has %d bytes available, %d bytes required",
+				name, pool.AvailableBytes, required[name]), nil)
+		}
+	}
+	return warnings, nil
+}
+
+// ensureDiskPools makes every pool used by the disks usable, creating auto-created pools once
+func (p *Provider) ensureDiskPools(ctx context.Context, plans []diskPlan) error {
+	ensured := make(map[string]bool)
+	for _, plan := range plans {
+		if plan.Referenced || ensured[plan.Pool.Name] {
+			continue
+		}
+		if err := p.ensureStoragePool(ctx, plan.Pool, plan.CreatePool); err != nil {
+			return fmt.Errorf("failed to ensure storage pool %s: %w", plan.Pool.Name, err)
+		}
+		ensured[plan.Pool.Name] = true
+	}
+	return nil
+}
+
+// createDiskVolume creates the volume of a disk from its source image, or empty, and returns its path
+func (p *Provider) createDiskVolume(ctx context.Context, storageProvider *StorageProvider, plan diskPlan) (string, error) {
+	var volume *StorageVolume
+	var err error
+
+	switch {
+	case plan.Source != "" && p.needsConversion(ctx, &plan):
+		log.Printf("INFO Creating disk %s by converting image %s to %s", plan.Target, plan.Source, plan.Format)
+		path, err := p.createConvertedVolume(ctx, storageProvider, plan)
+		if err != nil {
+			return "", fmt.Errorf("failed to create disk %s from image: %w", plan.Target, err)
+		}
+		return path, nil
+	case plan.Source == "":
+		log.Printf("INFO Creating empty disk volume: %s", plan.VolumeName)
+		volume, err = storageProvider.CreateVolume(ctx, plan.Pool.Name, plan.VolumeName, plan.Format, plan.SizeGiB, plan.Spec.AllocationPolicy)
+	case strings.HasPrefix(plan.Source, "http://") || strings.HasPrefix(plan.Source, "https://"):
+		log.Printf("INFO Downloading cloud image from URL: %s", plan.Source)
+		volume, err = storageProvider.DownloadCloudImage(ctx, plan.Source, plan.VolumeName, plan.Pool.Name, plan.SizeGiB)
+	case strings.HasPrefix(plan.Source, "/"):
+		log.Printf("INFO Creating disk from local template file: %s", plan.Source)
+		volume, err = storageProvider.CreateVolumeFromImageFile(ctx, plan.Source, plan.VolumeName, plan.Pool.Name, plan.SizeGiB)
+	default:
+		log.Printf("INFO Creating disk from predefined template: %s", plan.Source)
+		volume, err = storageProvider.CreateVolumeFromTemplate(ctx, plan.Source, plan.VolumeName, plan.Pool.Name, plan.SizeGiB)
+	}
+	if err != nil {
+		if plan.Source != "" {
+			return "", fmt.Errorf("failed to create disk %s from image: %w", plan.Target, err)
+		}
+		return "", fmt.Errorf("failed to create disk volume %s: %w", plan.VolumeName, err)
+	}
+	return volume.Path, nil
+}
+
+// renderDisksXML renders the root and data disks, giving the root disk the disk boot order
+// and throttled disks their I/O limits, followed by the virtio-scsi controller when a disk
+// uses the SCSI bus
+func renderDisksXML(plans []diskPlan, bootOrder int, throttles []contracts.DiskThrottle) string {
+	var b strings.Builder
+	specs := make([]contracts.DiskSpec, 0, len(plans))
+	for i, plan := range plans {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		diskXML := renderDiskXML(plan.Spec, plan.Target, plan.Format, plan.Path, i == 0)
+		if i == 0 {
+			diskXML = withBootOrder(diskXML, "disk", bootOrder)
+		}
+		for _, throttle := range throttles {
+			if throttle.Target == plan.Target {
+				diskXML = withDiskThrottle(diskXML, throttle)
+			}
+		}
+		b.WriteString(diskXML)
+		specs = append(specs, plan.Spec)
+	}
+	b.WriteString(scsiControllerXML(specs))
+	return b.String()
+}
+
+// plannedDiskPath predicts the path of a disk volume in a directory pool;
+// image-backed volumes get a .qcow2 suffix
+func plannedDiskPath(poolPath string, plan diskPlan) string {
+	path := filepath.Join(poolPath, plan.VolumeName)
+	if plan.Source != "" {
+		path += "." + plan.Format
+	}
+	return path
+}
+
+// deleteBlockVolumes removes the block volumes (e.g. LVM) of a deleted domain, which
+// are not covered by deleting disk files. Volumes attached by reference are kept.
+func (p *Provider) deleteBlockVolumes(ctx context.Context, domain *domainXML, referenced map[string]bool) {
+	for _, disk := range domain.Devices.Disks {
+		if disk.Type != "block" || disk.Device != "disk" || disk.Source.Dev == "" || referenced[disk.Source.Dev] {
+			continue
+		}
+		if _, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", disk.Source.Dev); err != nil {
+			log.Printf("WARN Failed to delete volume %s: %v", disk.Source.Dev, err)
+			continue
+		}
+		log.Printf("INFO Successfully deleted volume: %s", disk.Source.Dev)
+	}
+}
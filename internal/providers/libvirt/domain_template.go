@@ -0,0 +1,317 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// xmlNode is an element of a parsed XML document. Names keep the prefix they were
+// written with, so that documents are merged and rendered without resolving namespaces.
+type xmlNode struct {
+	Name     string
+	Attrs    []xml.Attr
+	Text     string
+	Children []*xmlNode
+}
+
+// qualifiedName joins the prefix and local part of a raw XML name
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// parseXMLTree parses a document into an element tree, checking that it is well-formed.
+// Comments, processing instructions and whitespace between elements are dropped.
+func parseXMLTree(document string) (*xmlNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{Name: qualifiedName(t.Name), Attrs: t.Copy().Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else if root != nil {
+				return nil, fmt.Errorf("unexpected second root element <%s>", node.Name)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			// RawToken does not match end tags to start tags
+			if len(stack) == 0 || stack[len(stack)-1].Name != qualifiedName(t.Name) {
+				return nil, fmt.Errorf("unexpected closing tag </%s>", qualifiedName(t.Name))
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text == "" {
+				continue
+			}
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unexpected text outside the root element")
+			}
+			stack[len(stack)-1].Text += text
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("document has no root element")
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("element <%s> is not closed", stack[len(stack)-1].Name)
+	}
+	return root, nil
+}
+
+// attr returns the value of an attribute, if set
+func (n *xmlNode) attr(name string) (string, bool) {
+	for _, attr := range n.Attrs {
+		if qualifiedName(attr.Name) == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// matches reports whether two sibling elements describe the same setting
+func (n *xmlNode) matches(other *xmlNode) bool {
+	if n.Name != other.Name {
+		return false
+	}
+	name, ok := n.attr("name")
+	otherName, otherOK := other.attr("name")
+	return ok == otherOK && name == otherName
+}
+
+// render writes the element and its children, indented by indent
+func (n *xmlNode) render(b *strings.Builder, indent string) {
+	b.WriteString(indent + "<" + n.Name)
+	for _, attr := range n.Attrs {
+		fmt.Fprintf(b, " %s='%s'", qualifiedName(attr.Name), xmlEscape(attr.Value))
+	}
+	switch {
+	case len(n.Children) > 0:
+		b.WriteString(">\n")
+		for _, child := range n.Children {
+			child.render(b, indent+"  ")
+		}
+		b.WriteString(indent + "</" + n.Name + ">\n")
+	case n.Text != "":
+		b.WriteString(">" + xmlEscape(n.Text) + "</" + n.Name + ">\n")
+	default:
+		b.WriteString("/>\n")
+	}
+}
+
+// mergeTemplateNode merges a template element into the matching generated element
+func mergeTemplateNode(generated, template *xmlNode) {
+	for _, attr := range template.Attrs {
+		if _, ok := generated.attr(qualifiedName(attr.Name)); !ok {
+			generated.Attrs = append(generated.Attrs, attr)
+		}
+	}
+	if generated.Text == "" && len(generated.Children) == 0 {
+		generated.Text = template.Text
+	}
+
+	// Devices are added by kind rather than merged, see the precedence rules above
+	if generated.Name == "devices" {
+		present := make(map[string]bool, len(generated.Children))
+		for _, device := range generated.Children {
+			present[device.Name] = true
+		}
+		for _, device := range template.Children {
+			if !present[device.Name] {
+				generated.Children = append(generated.Children, device)
+			}
+		}
+		return
+	}
+
+	existing := generated.Children
+	for _, child := range template.Children {
+		matched := false
+		for _, candidate := range existing {
+			if candidate.matches(child) {
+				mergeTemplateNode(candidate, child)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			generated.Children = append(generated.Children, child)
+		}
+	}
+	if generated.Text != "" && len(generated.Children) > 0 {
+		generated.Text = ""
+	}
+}
+
+// ParseDomainTemplate checks that a domain template is well-formed, has a <domain> root
+// and leaves the per-VM elements to the provider
+func ParseDomainTemplate(template string) error {
+	_, err := parseDomainTemplate(template)
+	return err
+}
+
+// parseDomainTemplate parses and checks a domain template
+func parseDomainTemplate(template string) (*xmlNode, error) {
+	root, err := parseXMLTree(template)
+	if err != nil {
+		return nil, fmt.Errorf("domain template is not well-formed XML: %w", err)
+	}
+	if root.Name != "domain" {
+		return nil, fmt.Errorf("domain template root element must be <domain>, got <%s>", root.Name)
+	}
+	for _, child := range root.Children {
+		if child.Name == "name" || child.Name == "uuid" {
+			return nil, fmt.Errorf("domain template must not set the per-VM element <%s>", child.Name)
+		}
+	}
+	return root, nil
+}
+
+// applyDomainTemplate merges a generated domain definition into the configured template, a
+// base <domain> definition loaded from the provider config, so that org-wide settings
+// (security labels, a hidden hypervisor CPUID, a panic notifier, ...) apply to all VMs.
+// The generated per-VM definition takes precedence:
+//
+//   - Attributes and text set by the generated definition win; the template only fills in
+//     attributes and text the generated element lacks.
+//   - Child elements are matched by their qualified name and, when present, their name
+//     attribute (e.g. <feature name='hypervisor'/>). Matched elements are merged with the
+//     same rules; template elements without a match are added.
+//   - Devices are never merged: a template device is only added when the generated
+//     definition has no device of the same kind, so it cannot alter or duplicate the disks,
+//     interfaces and controllers of a VM.
+//
+// Namespaced elements must use the prefixes of the generated definition (qemu for the QEMU
+// command-line namespace). The template cannot set the per-VM <name> and <uuid>.
+// Without a template the definition is returned unchanged.
+func (p *Provider) applyDomainTemplate(definition string) (string, error) {
+	if strings.TrimSpace(p.options.DomainTemplate) == "" {
+		return definition, nil
+	}
+
+	template, err := parseDomainTemplate(p.options.DomainTemplate)
+	if err != nil {
+		return "", err
+	}
+	generated, err := parseXMLTree(definition)
+	if err != nil {
+		return "", fmt.Errorf("generated domain XML is not well-formed: %w", err)
+	}
+	mergeTemplateNode(generated, template)
+
+	var b strings.Builder
+	generated.render(&b, "")
+	merged := b.String()
+
+	// The merged document must still decode as a domain definition
+	var domain domainXML
+	if err := xml.Unmarshal([]byte(merged), &domain); err != nil {
+		return "", contracts.NewInvalidSpecError("domain merged with the template is not a valid definition", err)
+	}
+	return merged, nil
+}
+
+// templatedDomain is the subset of a merged domain definition checked against the host
+type templatedDomain struct {
+	Type string `xml:"type,attr"`
+	VCPU int32  `xml:"vcpu"`
+	OS   struct {
+		Type struct {
+			Arch    string `xml:"arch,attr"`
+			Machine string `xml:"machine,attr"`
+		} `xml:"type"`
+	} `xml:"os"`
+	CPU struct {
+		Mode string `xml:"mode,attr"`
+	} `xml:"cpu"`
+	Graphics []struct {
+		Type string `xml:"type,attr"`
+	} `xml:"devices>graphics"`
+}
+
+// checkTemplatedDomain verifies a domain merged with the configured template against the
+// host's domain capabilities (virConnectGetDomainCapabilities): the virtualization type,
+// architecture and machine, the vCPU limit, the CPU mode and the display types
+func (p *Provider) checkTemplatedDomain(ctx context.Context, definition string) error {
+	if strings.TrimSpace(p.options.DomainTemplate) == "" {
+		return nil
+	}
+
+	var domain templatedDomain
+	if err := xml.Unmarshal([]byte(definition), &domain); err != nil {
+		return contracts.NewInvalidSpecError("domain merged with the template is not a valid definition", err)
+	}
+
+	args := []string{"domcapabilities"}
+	if domain.Type != "" {
+		args = append(args, "--virttype", domain.Type)
+	}
+	if domain.OS.Type.Arch != "" {
+		args = append(args, "--arch", domain.OS.Type.Arch)
+	}
+	if domain.OS.Type.Machine != "" {
+		args = append(args, "--machine", domain.OS.Type.Machine)
+	}
+	result, err := p.virshProvider.runVirshCommand(ctx, args...)
+	if err != nil {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("host cannot run the templated domain (type %q, arch %q, machine %q)",
+			domain.Type, domain.OS.Type.Arch, domain.OS.Type.Machine), err)
+	}
+	var caps domainCapabilities
+	if err := xml.Unmarshal([]byte(result.Stdout), &caps); err != nil {
+		return fmt.Errorf("failed to parse host domain capabilities: %w", err)
+	}
+
+	if caps.VCPU.Max > 0 && domain.VCPU > caps.VCPU.Max {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("templated domain has %d vCPUs, the host supports at most %d", domain.VCPU, caps.VCPU.Max), nil)
+	}
+	if domain.CPU.Mode != "" {
+		for _, mode := range caps.CPU.Modes {
+			if mode.Name == domain.CPU.Mode && mode.Supported != "yes" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("templated domain uses CPU mode %s, which the host does not support", domain.CPU.Mode), nil)
+			}
+		}
+	}
+	for _, graphics := range domain.Graphics {
+		if !caps.supportsGraphics(graphics.Type) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("templated domain exposes a %s display, which the host does not support", graphics.Type), nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// domainXML is the subset of the libvirt domain definition the provider inspects
+type domainXML struct {
+	XMLName xml.Name      `xml:"domain"`
+	Name    string        `xml:"name"`
+	UUID    string        `xml:"uuid"`
+	Memory  domainMemory  `xml:"memory"`
+	VCPU    int32         `xml:"vcpu"`
+	OS      domainOS      `xml:"os"`
+	Clock   domainClock   `xml:"clock"`
+	OnCrash string        `xml:"on_crash"`
+	Devices domainDevices `xml:"devices"`
+}
+
+// domainMemory is a memory size with its unit (libvirt reports KiB)
+type domainMemory struct {
+	Unit  string `xml:"unit,attr"`
+	Value int64  `xml:",chardata"`
+}
+
+// MiB returns the memory size in MiB
+func (m domainMemory) MiB() int64 {
+	switch strings.ToLower(m.Unit) {
+	case "b", "bytes":
+		return m.Value / (1024 * 1024)
+	case "mib", "m":
+		return m.Value
+	case "gib", "g":
+		return m.Value * 1024
+	default:
+		return m.Value / 1024
+	}
+}
+
+// domainOS holds the firmware and boot configuration of a domain definition
+type domainOS struct {
+	Firmware         string                  `xml:"firmware,attr"`
+	FirmwareFeatures []domainFirmwareFeature `xml:"firmware>feature"`
+	Loader           domainLoader            `xml:"loader"`
+	NVRAM            string                  `xml:"nvram"`
+	Boot             []domainBoot            `xml:"boot"`
+}
+
+// domainFirmwareFeature is a feature of automatically selected firmware (e.g. secure-boot)
+type domainFirmwareFeature struct {
+	Enabled string `xml:"enabled,attr"`
+	Name    string `xml:"name,attr"`
+}
+
+// domainLoader is the firmware image of a UEFI domain; BIOS domains have none
+type domainLoader struct {
+	Secure string `xml:"secure,attr"`
+	Path   string `xml:",chardata"`
+}
+
+// firmware returns the firmware type of the domain (bios, uefi or uefi-secure)
+func (d *domainXML) firmware() string {
+	switch {
+	case strings.TrimSpace(d.OS.Loader.Path) == "" && d.OS.Firmware != "efi":
+		return firmwareBIOS
+	case d.OS.Loader.Secure == "yes":
+		return firmwareUEFISecure
+	}
+	for _, feature := range d.OS.FirmwareFeatures {
+		if feature.Name == "secure-boot" && feature.Enabled == "yes" {
+			return firmwareUEFISecure
+		}
+	}
+	return firmwareUEFI
+}
+
+// domainDevices holds the device list of a domain definition
+type domainDevices struct {
+	Disks      []domainDisk      `xml:"disk"`
+	Interfaces []domainInterface `xml:"interface"`
+	TPMs       []domainTPM       `xml:"tpm"`
+	HostDevs   []domainHostdev   `xml:"hostdev"`
+	RNGs       []domainRNG       `xml:"rng"`
+	Serials    []domainSerial    `xml:"serial"`
+	Graphics   []domainGraphics  `xml:"graphics"`
+	Videos     []domainVideo     `xml:"video"`
+	Inputs     []domainInput     `xml:"input"`
+	Watchdogs  []domainWatchdog  `xml:"watchdog"`
+	Panics     []domainPanic     `xml:"panic"`
+}
+
+// domainSerial describes a serial port of a domain
+type domainSerial struct {
+	Type string `xml:"type,attr"`
+	Log  *struct {
+		File string `xml:"file,attr"`
+	} `xml:"log"`
+}
+
+// domainRNG describes a random number generator attached to a domain
+type domainRNG struct {
+	Model string `xml:"model,attr"`
+	Rate  *struct {
+		Bytes  int64 `xml:"bytes,attr"`
+		Period int64 `xml:"period,attr"`
+	} `xml:"rate"`
+	Backend struct {
+		Model string `xml:"model,attr"`
+		Path  string `xml:",chardata"`
+	} `xml:"backend"`
+}
+
+// domainHostdev describes a host device passed through to a domain
+type domainHostdev struct {
+	Mode    string `xml:"mode,attr"`
+	Type    string `xml:"type,attr"`
+	Managed string `xml:"managed,attr"`
+	Source  struct {
+		Address struct {
+			Domain   string `xml:"domain,attr"`
+			Bus      string `xml:"bus,attr"`
+			Slot     string `xml:"slot,attr"`
+			Function string `xml:"function,attr"`
+			Device   string `xml:"device,attr"`
+		} `xml:"address"`
+		Vendor struct {
+			ID string `xml:"id,attr"`
+		} `xml:"vendor"`
+		Product struct {
+			ID string `xml:"id,attr"`
+		} `xml:"product"`
+	} `xml:"source"`
+}
+
+// domainTPM describes a TPM device attached to a domain
+type domainTPM struct {
+	Model   string `xml:"model,attr"`
+	Backend struct {
+		Type    string `xml:"type,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"backend"`
+}
+
+// domainInterface describes a network interface attached to a domain
+type domainInterface struct {
+	Type string `xml:"type,attr"`
+	MAC  struct {
+		Address string `xml:"address,attr"`
+	} `xml:"mac"`
+	Source struct {
+		Network string `xml:"network,attr"`
+		Bridge  string `xml:"bridge,attr"`
+	} `xml:"source"`
+	Model struct {
+		Type string `xml:"type,attr"`
+	} `xml:"model"`
+	Target struct {
+		Dev string `xml:"dev,attr"`
+	} `xml:"target"`
+	Bandwidth *domainBandwidth `xml:"bandwidth"`
+	Boot      *domainBootOrder `xml:"boot"`
+}
+
+// domainDisk describes a disk or CD-ROM attached to a domain
+type domainDisk struct {
+	Type   string `xml:"type,attr"`
+	Device string `xml:"device,attr"`
+	Driver struct {
+		Name    string `xml:"name,attr"`
+		Type    string `xml:"type,attr"`
+		Cache   string `xml:"cache,attr"`
+		IO      string `xml:"io,attr"`
+		Discard string `xml:"discard,attr"`
+	} `xml:"driver"`
+	Source struct {
+		File   string `xml:"file,attr"`
+		Dev    string `xml:"dev,attr"`
+		Pool   string `xml:"pool,attr"`
+		Volume string `xml:"volume,attr"`
+	} `xml:"source"`
+	Target struct {
+		Dev string `xml:"dev,attr"`
+		Bus string `xml:"bus,attr"`
+	} `xml:"target"`
+	BlockIO *struct {
+		LogicalBlockSize  int64 `xml:"logical_block_size,attr"`
+		PhysicalBlockSize int64 `xml:"physical_block_size,attr"`
+	} `xml:"blockio"`
+	ReadOnly     *struct{}           `xml:"readonly"`
+	Shareable    *struct{}           `xml:"shareable"`
+	BackingStore *domainBackingStore `xml:"backingStore"`
+	Boot         *domainBootOrder    `xml:"boot"`
+	IOTune       *domainIOTune       `xml:"iotune"`
+}
+
+// domainBackingStore is one image of a disk's backing chain, as reported for running domains
+type domainBackingStore struct {
+	Source struct {
+		File string `xml:"file,attr"`
+	} `xml:"source"`
+	BackingStore *domainBackingStore `xml:"backingStore"`
+}
+
+// backingChain returns the files of a disk from the active image down to the base
+func (d domainDisk) backingChain() []string {
+	chain := []string{d.Source.File}
+	for store := d.BackingStore; store != nil && store.Source.File != ""; store = store.BackingStore {
+		chain = append(chain, store.Source.File)
+	}
+	return chain
+}
+
+// sourcePath returns the host path backing the disk, if any
+func (d domainDisk) sourcePath() string {
+	if d.Source.File != "" {
+		return d.Source.File
+	}
+	return d.Source.Dev
+}
+
+// findDisk returns the disk attached at the given target device
+func (d *domainXML) findDisk(target string) (*domainDisk, bool) {
+	for i := range d.Devices.Disks {
+		if d.Devices.Disks[i].Target.Dev == target {
+			return &d.Devices.Disks[i], true
+		}
+	}
+	return nil, false
+}
+
+// getDomainXML reads and parses the live definition of a domain
+func (v *VirshProvider) getDomainXML(ctx context.Context, domainName string) (*domainXML, error) {
+	return v.dumpDomainXML(ctx, "dumpxml", domainName)
+}
+
+// getPersistentDomainXML reads and parses the definition a domain boots with next,
+// which is what "virsh edit" changes
+func (v *VirshProvider) getPersistentDomainXML(ctx context.Context, domainName string) (*domainXML, error) {
+	return v.dumpDomainXML(ctx, "dumpxml", "--inactive", domainName)
+}
+
+// dumpDomainXML runs a dumpxml command and parses its output
+func (v *VirshProvider) dumpDomainXML(ctx context.Context, args ...string) (*domainXML, error) {
+	result, err := v.runVirshCommand(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump domain XML: %w", err)
+	}
+
+	var domain domainXML
+	if err := xml.Unmarshal([]byte(result.Stdout), &domain); err != nil {
+		return nil, fmt.Errorf("failed to parse domain XML: %w", err)
+	}
+	return &domain, nil
+}
+
+// xmlEscape escapes a value for use in XML text or a quoted attribute
+func xmlEscape(value string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// DryRunResult describes what Create would do for a request
+type DryRunResult struct {
+	// DomainXML is the definition Create would pass to virsh define
+	DomainXML string
+	// Warnings lists non-fatal findings (e.g. the domain already exists)
+	Warnings []string
+}
+
+// DryRunCreate validates a create request against the host and renders the
+// domain XML without allocating storage or defining the domain
+func (p *Provider) DryRunCreate(ctx context.Context, req contracts.CreateRequest) (DryRunResult, error) {
+	log.Printf("INFO Dry-run create for VM: %s", req.Name)
+
+	var result DryRunResult
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if req.Name == "" {
+		return result, contracts.NewInvalidSpecError("VM name is required", nil)
+	}
+
+	req, result.Warnings = vmspec.Default(req)
+
+	if err := vmspec.Validate(req); err != nil {
+		return result, err
+	}
+	if err := p.validateCloudInitPayload(req); err != nil {
+		return result, err
+	}
+	if err := validateDisks(req.Disks); err != nil {
+		return result, err
+	}
+	if err := validateHostDevices(req.HostDevices); err != nil {
+		return result, err
+	}
+	if err := p.validateNetworkSources(req.Networks); err != nil {
+		return result, err
+	}
+	if err := validateBootSpec(req); err != nil {
+		return result, err
+	}
+	if err := p.checkQEMUPassthrough(req.QemuArgs); err != nil {
+		return result, err
+	}
+	if err := p.checkTPMAvailable(ctx, req.Class); err != nil {
+		return result, err
+	}
+	if err := p.checkHostDevicesAvailable(ctx, req.Name, req.HostDevices); err != nil {
+		return result, err
+	}
+	if err := p.checkGraphicsAvailable(ctx, req.Graphics); err != nil {
+		return result, err
+	}
+	if err := p.checkTuningAvailable(ctx, req.Class); err != nil {
+		return result, err
+	}
+	if err := p.checkHugepagesAvailable(ctx, req.Class); err != nil {
+		return result, err
+	}
+
+	existing, err := p.findExistingDomain(ctx, req)
+	if err != nil {
+		return result, err
+	}
+	if existing != nil {
+		if diffs := createSpecDiff(existing, req); len(diffs) > 0 {
+			return result, existingDomainConflict(existing, diffs)
+		}
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("domain %s already exists and matches the request; Create would return it unchanged", existing.Name))
+	}
+
+	storageProvider := NewStorageProvider(p.virshProvider)
+	disks, err := p.planDisks(ctx, req)
+	if err != nil {
+		return result, err
+	}
+
+	// Volumes are predicted at <pool path>/<volume name>; image-backed ones get a .qcow2 suffix
+	poolPaths := make(map[string]string)
+	for i, disk := range disks {
+		if disk.Referenced {
+			continue
+		}
+		poolPath, ok := poolPaths[disk.Pool.Name]
+		if !ok {
+			poolPath = disk.Pool.Target.Path
+			if disk.CreatePool {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("storage pool %s does not exist; Create would define a %s pool at %s",
+					disk.Pool.Name, disk.Pool.Type, disk.Pool.Target.Path))
+			} else if pool, err := storageProvider.GetPoolInfo(ctx, disk.Pool.Name); err != nil {
+				return result, contracts.NewNotFoundError(fmt.Sprintf("storage pool %s not found", disk.Pool.Name), err)
+			} else if pool.Path != "" {
+				poolPath = pool.Path
+			}
+			if poolPath == "" {
+				poolPath = "/var/lib/libvirt/images"
+			}
+			poolPaths[disk.Pool.Name] = poolPath
+		}
+
+		if disk.Source != "" {
+			if err := p.checkImageAvailable(ctx, storageProvider, disk.Source); err != nil {
+				return result, err
+			}
+		}
+		disks[i].Path = plannedDiskPath(poolPath, disk)
+	}
+
+	warnings, err := p.checkDiskCapacity(ctx, disks)
+	result.Warnings = append(result.Warnings, warnings...)
+	result.Warnings = append(result.Warnings, shareableDiskWarnings(disks)...)
+	if err != nil {
+		return result, err
+	}
+
+	for _, network := range p.withNetworkDefaults(req.Networks) {
+		if err := p.checkNetworkAvailable(ctx, network); err != nil {
+			return result, err
+		}
+	}
+	if req.UUID == "" {
+		req.UUID = p.generateUUID()
+	}
+	if req.Networks, err = p.assignMACAddresses(ctx, req.UUID, req.Networks); err != nil {
+		return result, err
+	}
+	if _, err := p.planDHCPReservations(ctx, req.Networks); err != nil {
+		return result, err
+	}
+
+	firmware, err := p.resolveFirmware(ctx, req.Class)
+	if err != nil {
+		return result, err
+	}
+
+	volumes := domainStorage{Disks: disks, Firmware: firmware}
+	seedPath := poolPaths[disks[0].Pool.Name]
+	if isIgnitionUserData(req.UserData) {
+		volumes.IgnitionPath = filepath.Join(seedPath, req.Name+ignitionVolumeSuffix)
+	} else {
+		volumes.CloudInitISOPath = filepath.Join(seedPath, req.Name+cloudInitVolumeSuffix)
+	}
+
+	domainXML, err := p.generateDomainXMLWithStorage(req, volumes)
+	if err != nil {
+		return result, fmt.Errorf("failed to generate domain XML: %w", err)
+	}
+	if err := p.checkTemplatedDomain(ctx, domainXML); err != nil {
+		return result, err
+	}
+	result.DomainXML = domainXML
+
+	log.Printf("INFO Dry-run create for VM %s succeeded (%d warnings)", req.Name, len(result.Warnings))
+	return result, nil
+}
+
+// checkImageAvailable verifies that the source image of a create request can be resolved
+func (p *Provider) checkImageAvailable(ctx context.Context, storageProvider *StorageProvider, imageSpec string) error {
+	switch {
+	case strings.HasPrefix(imageSpec, "http://") || strings.HasPrefix(imageSpec, "https://"):
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "wget", "--spider", "-q", imageSpec); err != nil {
+			return contracts.NewNotFoundError(fmt.Sprintf("image URL not reachable: %s", imageSpec), err)
+		}
+	case strings.HasPrefix(imageSpec, "/"):
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-f", imageSpec); err != nil {
+			return contracts.NewNotFoundError(fmt.Sprintf("source image file not found: %s", imageSpec), err)
+		}
+	default:
+		for _, template := range storageProvider.GetPredefinedTemplates() {
+			if template.Name == imageSpec {
+				return nil
+			}
+		}
+		return contracts.NewNotFoundError(fmt.Sprintf("template not found: %s", imageSpec), nil)
+	}
+	return nil
+}
+
+// checkNetworkAvailable verifies that the libvirt network, bridge, host NIC or virtual function
+// of an attachment exists
+func (p *Provider) checkNetworkAvailable(ctx context.Context, network contracts.NetworkAttachment) error {
+	switch networkSourceType(network) {
+	case networkSourceBridge:
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-d", hostNetDir+"/"+network.Bridge); err != nil {
+			return contracts.NewNotFoundError(fmt.Sprintf("bridge %s not found on host", network.Bridge), err)
+		}
+	case networkSourceDirect:
+		return p.checkDirectDeviceAvailable(ctx, network.SourceDevice)
+	case networkSourceHostdev:
+		return p.checkVirtualFunctionAvailable(ctx, network.SourceDevice)
+	case networkSourceNetwork:
+		if _, err := p.virshProvider.runVirshCommand(ctx, "net-info", network.NetworkName); err != nil {
+			return contracts.NewNotFoundError(fmt.Sprintf("network %s is not defined", network.NetworkName), err)
+		}
+	}
+	return nil
+}
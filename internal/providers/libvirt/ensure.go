@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// EnsureResult reports how EnsureVM converged a VM
+type EnsureResult struct {
+	// ID is the provider-specific VM identifier
+	ID string
+	// Created reports that the VM did not exist and was created
+	Created bool
+	// Actions lists the actions taken, in order; empty when the VM had already converged
+	Actions []string
+	// PowerState is the power state observed after converging
+	PowerState contracts.PowerState
+}
+
+// EnsureVM converges a VM to the desired spec and power state: it creates the VM when it
+// is missing, reconfigures drifted resources and powers it on or off as needed. Drift that
+// only a recreate can fix (firmware, TPM, UUID) is reported as a conflict. An empty power
+// state leaves the power state unchanged. Repeated calls with the same input are no-ops.
+func (p *Provider) EnsureVM(ctx context.Context, desired contracts.CreateRequest, power contracts.PowerState) (EnsureResult, error) {
+	result := EnsureResult{ID: desired.Name}
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if power != "" && power != contracts.PowerStateOn && power != contracts.PowerStateOff {
+		return result, contracts.NewInvalidSpecError(fmt.Sprintf("unsupported desired power state %q (supported: On, Off)", power), nil)
+	}
+	if err := vmspec.ValidateUUID(desired); err != nil {
+		return result, err
+	}
+
+	existing, err := p.findExistingDomain(ctx, desired)
+	if err != nil {
+		return result, err
+	}
+
+	if existing == nil {
+		resp, err := p.Create(ctx, desired)
+		if err != nil {
+			return result, err
+		}
+		result.ID = resp.ID
+		result.Created = !resp.AlreadyExists
+		result.Actions = append(result.Actions, "created")
+	} else {
+		result.ID = existing.Name
+		if diffs := immutableSpecDiff(existing, desired); len(diffs) > 0 {
+			return result, existingDomainConflict(existing, diffs)
+		}
+		actions, err := p.ensureSpec(ctx, existing, desired)
+		if err != nil {
+			return result, err
+		}
+		result.Actions = append(result.Actions, actions...)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, result.ID)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+	if action, op := powerAction(state, power); op != "" {
+		if _, err := p.Power(ctx, result.ID, op); err != nil {
+			return result, err
+		}
+		result.Actions = append(result.Actions, action)
+		if state, err = p.virshProvider.getDomainState(ctx, result.ID); err != nil {
+			return result, contracts.NewRetryableError("failed to get domain state", err)
+		}
+	}
+	result.PowerState = p.mapLibvirtPowerState(state)
+
+	log.Printf("INFO Ensured VM %s (power: %s, actions: %s)", result.ID, result.PowerState, strings.Join(result.Actions, ", "))
+	return result, nil
+}
+
+// ensureSpec reconfigures the resources, interfaces, USB devices and metadata of an existing domain that
+// drifted from the desired spec and returns the actions taken
+func (p *Provider) ensureSpec(ctx context.Context, domain *domainXML, desired contracts.CreateRequest) ([]string, error) {
+	var actions []string
+
+	metadataChanged := false
+	if desired.VMMetadata != nil {
+		current, err := p.virshProvider.getVMMetadata(ctx, domain.Name)
+		if err != nil {
+			return actions, contracts.NewRetryableError("failed to read VM metadata", err)
+		}
+		metadataChanged = !maps.Equal(current, desired.VMMetadata)
+	}
+
+	affinityChanged := false
+	if desired.AffinityGroups != nil || desired.AntiAffinityGroups != nil {
+		affinity, antiAffinity, err := p.virshProvider.getAffinityGroups(ctx, domain.Name)
+		if err != nil {
+			return actions, contracts.NewRetryableError("failed to read VM affinity groups", err)
+		}
+		affinityChanged = !slices.Equal(affinity, desired.AffinityGroups) || !slices.Equal(antiAffinity, desired.AntiAffinityGroups)
+	}
+
+	if diffs := resourceSpecDiff(domain, desired); len(diffs) > 0 || metadataChanged || affinityChanged {
+		reconfigure := desired
+		reconfigure.Networks = nil
+		if !metadataChanged {
+			reconfigure.VMMetadata = nil
+		}
+		if !affinityChanged {
+			reconfigure.AffinityGroups, reconfigure.AntiAffinityGroups = nil, nil
+		}
+		if _, err := p.Reconfigure(ctx, domain.Name, reconfigure); err != nil {
+			return actions, err
+		}
+		if len(diffs) > 0 {
+			actions = append(actions, "reconfigured ("+strings.Join(diffs, "; ")+")")
+		}
+		if metadataChanged {
+			actions = append(actions, "updated metadata")
+		}
+		if affinityChanged {
+			actions = append(actions, "updated affinity groups")
+		}
+	}
+
+	if diffs := networkSpecDiff(domain, desired.Networks); len(diffs) > 0 {
+		if err := p.ReconcileNetworkInterfaces(ctx, domain.Name, desired.Networks); err != nil {
+			return actions, err
+		}
+		actions = append(actions, "reconciled network interfaces ("+strings.Join(diffs, "; ")+")")
+	}
+
+	if diffs := usbSpecDiff(domain, desired.HostDevices); len(diffs) > 0 {
+		if err := p.ReconcileUSBDevices(ctx, domain.Name, desired.HostDevices); err != nil {
+			return actions, err
+		}
+		actions = append(actions, "reconciled USB devices ("+strings.Join(diffs, "; ")+")")
+	}
+
+	return actions, nil
+}
+
+// powerAction returns the power operation that moves a domain in the given libvirt state to
+// the desired power state, or an empty operation when no change is needed
+func powerAction(state string, desired contracts.PowerState) (string, contracts.PowerOp) {
+	running := state == "running"
+	switch {
+	case desired == contracts.PowerStateOn && !running:
+		return "powered on", contracts.PowerOpOn
+	case desired == contracts.PowerStateOff && running:
+		return "powered off", contracts.PowerOpOff
+	default:
+		return "", ""
+	}
+}
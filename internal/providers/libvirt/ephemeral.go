@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// ephemeralPollInterval is how often the reaper of an ephemeral VM checks whether its domain is gone
+const ephemeralPollInterval = 5 * time.Second
+
+// startTransientDomain starts the domain from its XML file without defining it, so libvirt
+// forgets the domain as soon as it stops
+func (p *Provider) startTransientDomain(ctx context.Context, domainName string) error {
+	remotePath := fmt.Sprintf("/tmp/%s-domain.xml", domainName)
+
+	result, err := p.virshProvider.runVirshCommand(ctx, "create", remotePath)
+	if err != nil {
+		return fmt.Errorf("failed to create transient domain: %w, output: %s", err, result.Stderr)
+	}
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", remotePath); err != nil {
+		log.Printf("WARN Failed to cleanup domain XML file: %v", err)
+	}
+
+	log.Printf("INFO Successfully started transient domain: %s", domainName)
+	return nil
+}
+
+// watchEphemeralDomain records the resources of a running ephemeral VM and starts the reaper
+// that removes them once the domain stops. A VM that is already watched is left alone.
+func (p *Provider) watchEphemeralDomain(ctx context.Context, domainName string) {
+	resources := p.collectDomainResources(ctx, domainName)
+	if _, loaded := p.ephemeral.LoadOrStore(domainName, resources); loaded {
+		return
+	}
+
+	// The RPC context ends when the call returns, so the reaper runs detached
+	go p.reapEphemeralDomain(context.Background(), domainName, resources)
+}
+
+// reapEphemeralDomain waits for a transient domain to disappear and removes its disks, seed
+// ISO and other resources. It stops without cleaning up when Delete takes the VM over.
+func (p *Provider) reapEphemeralDomain(ctx context.Context, domainName string, resources *domainResources) {
+	for sleepContext(ctx, ephemeralPollInterval) {
+		if current, ok := p.ephemeral.Load(domainName); !ok || current != resources {
+			return
+		}
+		_, err := p.virshProvider.getDomainState(ctx, domainName)
+		if !domainGone(err) {
+			continue
+		}
+		if !p.ephemeral.CompareAndDelete(domainName, resources) {
+			return
+		}
+
+		log.Printf("INFO Ephemeral VM %s stopped, removing its resources", domainName)
+		p.removeDomainResources(ctx, domainName, resources)
+		log.Printf("INFO Successfully removed ephemeral VM and all resources: %s", domainName)
+		return
+	}
+}
+
+// resumeEphemeralReapers watches the running transient domains owned by this provider
+// instance, whose reapers did not survive a provider restart. Resources of VMs that stopped
+// while no provider was running are left to garbage collection.
+func (p *Provider) resumeEphemeralReapers(ctx context.Context) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "list", "--transient", "--name")
+	if err != nil {
+		log.Printf("WARN Failed to list transient domains: %v", err)
+		return
+	}
+
+	for _, name := range strings.Split(result.Stdout, "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if owner, err := p.virshProvider.getDomainOwner(ctx, name); err != nil || owner != p.instanceID() {
+			continue
+		}
+		log.Printf("INFO Resuming reaper of ephemeral VM %s", name)
+		p.watchEphemeralDomain(ctx, name)
+	}
+}
+
+// removeTransientState removes the UEFI NVRAM store and TPM state a transient domain leaves behind
+func (p *Provider) removeTransientState(ctx context.Context, domain *domainXML) {
+	if domain.OS.NVRAM != "" {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", domain.OS.NVRAM); err != nil {
+			log.Printf("WARN Failed to remove NVRAM %s of domain %s: %v", domain.OS.NVRAM, domain.Name, err)
+		}
+	}
+	if hasEmulatedTPM(domain) && domain.UUID != "" {
+		stateDir := swtpmStateDir + "/" + domain.UUID
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-rf", stateDir); err != nil {
+			log.Printf("WARN Failed to remove TPM state %s of domain %s: %v", stateDir, domain.Name, err)
+		}
+	}
+}
+
+// changeScope says which definitions of a domain a change applies to
+type changeScope struct {
+	// live applies the change to the running domain as well
+	live bool
+	// transient marks a domain without a persistent definition, whose changes are only live
+	transient bool
+}
+
+// flags returns the virsh flags that apply a change in the scope. libvirt rejects --config
+// for a transient domain, so its changes are passed with --live alone.
+func (s changeScope) flags() []string {
+	switch {
+	case s.transient:
+		return []string{"--live"}
+	case s.live:
+		return []string{"--config", "--live"}
+	default:
+		return []string{"--config"}
+	}
+}
+
+// domainChangeScope returns the scope of a change to a domain in its current state
+func (v *VirshProvider) domainChangeScope(ctx context.Context, domainName string) (changeScope, error) {
+	result, err := v.runVirshCommand(ctx, "dominfo", domainName)
+	if err != nil {
+		return changeScope{}, err
+	}
+	info := parseColonFields(result.Stdout)
+	return changeScope{live: info["State"] == "running", transient: info["Persistent"] == "no"}, nil
+}
+
+// isTransientDomain reports whether a domain runs without a persistent definition
+func (v *VirshProvider) isTransientDomain(ctx context.Context, domainName string) (bool, error) {
+	result, err := v.runVirshCommand(ctx, "dominfo", domainName)
+	if err != nil {
+		return false, err
+	}
+	return parseColonFields(result.Stdout)["Persistent"] == "no", nil
+}
+
+// domainGone reports whether err is libvirt saying the domain does not exist
+func domainGone(err error) bool {
+	if err == nil {
+		return false
+	}
+	code, _, _ := classifyError(err)
+	return code == providerv1.ErrorCode_ERROR_CODE_NOT_FOUND
+}
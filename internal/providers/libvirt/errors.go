@@ -0,0 +1,209 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+// Subset of libvirt virErrorNumber values the provider distinguishes
+const (
+	virErrInternalError     = 1
+	virErrNoMemory          = 2
+	virErrNoSupport         = 3
+	virErrInvalidConn       = 6
+	virErrInvalidArg        = 8
+	virErrOperationFailed   = 9
+	virErrXMLError          = 27
+	virErrNoDomain          = 42
+	virErrNoNetwork         = 43
+	virErrNoStoragePool     = 49
+	virErrNoStorageVol      = 50
+	virErrOperationInvalid  = 55
+	virErrConfigUnsupported = 67
+	virErrOperationTimeout  = 68
+	virErrNoDomainSnapshot  = 72
+	virErrAgentUnresponsive = 86
+	virErrResourceBusy      = 87
+	virErrStorageVolExist   = 90
+)
+
+// virshErrorPatterns maps virsh error text to the libvirt error it reports.
+// virsh only prints the formatted message, so the number is recovered from its text.
+// Conditions libvirt reports without a dedicated number (e.g. ENOSPC) use number 0.
+var virshErrorPatterns = []struct {
+	pattern string
+	number  int
+	code    providerv1.ErrorCode
+}{
+	{"domain snapshot not found", virErrNoDomainSnapshot, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"domain not found", virErrNoDomain, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"failed to get domain", virErrNoDomain, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"storage pool not found", virErrNoStoragePool, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"storage volume not found", virErrNoStorageVol, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"network not found", virErrNoNetwork, providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+	{"storage volume already exists", virErrStorageVolExist, providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS},
+	{"already exists", virErrOperationFailed, providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS},
+	{"no space left on device", 0, providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+	{"not enough space", 0, providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+	{"cannot allocate memory", virErrNoMemory, providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+	{"out of memory", virErrNoMemory, providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+	{"xml error", virErrXMLError, providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC},
+	{"invalid argument", virErrInvalidArg, providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC},
+	{"unsupported configuration", virErrConfigUnsupported, providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC},
+	{"requested operation is not valid", virErrOperationInvalid, providerv1.ErrorCode_ERROR_CODE_INVALID_STATE},
+	{"this function is not supported", virErrNoSupport, providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED},
+	{"guest agent is not responding", virErrAgentUnresponsive, providerv1.ErrorCode_ERROR_CODE_TRANSIENT},
+	{"resource busy", virErrResourceBusy, providerv1.ErrorCode_ERROR_CODE_TRANSIENT},
+	{"timed out", virErrOperationTimeout, providerv1.ErrorCode_ERROR_CODE_TRANSIENT},
+	{"invalid connection", virErrInvalidConn, providerv1.ErrorCode_ERROR_CODE_TRANSIENT},
+	{"failed to connect", virErrInvalidConn, providerv1.ErrorCode_ERROR_CODE_TRANSIENT},
+	{"internal error", virErrInternalError, providerv1.ErrorCode_ERROR_CODE_INTERNAL},
+	{"operation failed", virErrOperationFailed, providerv1.ErrorCode_ERROR_CODE_INTERNAL},
+}
+
+// classifyVirshError returns the error code and libvirt error number reported in virsh stderr
+func classifyVirshError(stderr string) (providerv1.ErrorCode, int) {
+	lower := strings.ToLower(stderr)
+	for _, p := range virshErrorPatterns {
+		if strings.Contains(lower, p.pattern) {
+			return p.code, p.number
+		}
+	}
+	return providerv1.ErrorCode_ERROR_CODE_UNSPECIFIED, 0
+}
+
+// errorCodeForType maps a contracts error type to the provider error taxonomy
+func errorCodeForType(t contracts.ErrorType) providerv1.ErrorCode {
+	switch t {
+	case contracts.ErrorTypeNotFound:
+		return providerv1.ErrorCode_ERROR_CODE_NOT_FOUND
+	case contracts.ErrorTypeConflict:
+		return providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS
+	case contracts.ErrorTypeQuotaExceeded:
+		return providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED
+	case contracts.ErrorTypeInvalidSpec:
+		return providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC
+	case contracts.ErrorTypeInvalidState:
+		return providerv1.ErrorCode_ERROR_CODE_INVALID_STATE
+	case contracts.ErrorTypeNotSupported:
+		return providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED
+	case contracts.ErrorTypeUnauthorized:
+		return providerv1.ErrorCode_ERROR_CODE_PERMISSION_DENIED
+	case contracts.ErrorTypeRetryable, contracts.ErrorTypeUnavailable,
+		contracts.ErrorTypeTimeout, contracts.ErrorTypeRateLimit:
+		return providerv1.ErrorCode_ERROR_CODE_TRANSIENT
+	default:
+		return providerv1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+}
+
+// grpcCodeFor maps the provider error taxonomy to a gRPC status code
+func grpcCodeFor(code providerv1.ErrorCode) codes.Code {
+	switch code {
+	case providerv1.ErrorCode_ERROR_CODE_NOT_FOUND:
+		return codes.NotFound
+	case providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS:
+		return codes.AlreadyExists
+	case providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED:
+		return codes.ResourceExhausted
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC:
+		return codes.InvalidArgument
+	case providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED:
+		return codes.Unimplemented
+	case providerv1.ErrorCode_ERROR_CODE_TRANSIENT:
+		return codes.Unavailable
+	case providerv1.ErrorCode_ERROR_CODE_PERMISSION_DENIED:
+		return codes.PermissionDenied
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_STATE:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// classifyError determines the error code, retryability and libvirt error number for err.
+// A virsh failure takes precedence because it carries the most specific cause.
+func classifyError(err error) (providerv1.ErrorCode, bool, int) {
+	var virshErr *VirshError
+	if errors.As(err, &virshErr) {
+		if code, number := classifyVirshError(virshErr.Stderr); code != providerv1.ErrorCode_ERROR_CODE_UNSPECIFIED {
+			return code, code == providerv1.ErrorCode_ERROR_CODE_TRANSIENT, number
+		}
+	}
+
+	var providerErr *contracts.ProviderError
+	if errors.As(err, &providerErr) {
+		if code := errorCodeForType(providerErr.Type); code != providerv1.ErrorCode_ERROR_CODE_UNSPECIFIED {
+			return code, providerErr.IsRetryable(), 0
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return providerv1.ErrorCode_ERROR_CODE_TRANSIENT, true, 0
+	}
+
+	return providerv1.ErrorCode_ERROR_CODE_INTERNAL, false, 0
+}
+
+// ToStatusError converts a provider error into a gRPC status carrying an ErrorDetail.
+// Errors that already are gRPC statuses are returned unchanged.
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code, retryable, number := classifyError(err)
+	st := status.New(grpcCodeFor(code), err.Error())
+	withDetails, detailErr := st.WithDetails(&providerv1.ErrorDetail{
+		Code:                code,
+		Message:             err.Error(),
+		Retryable:           retryable,
+		ProviderErrorNumber: int32(number),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// UnaryErrorInterceptor translates handler errors into structured gRPC statuses
+func UnaryErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatusError(err)
+	}
+}
+
+// StreamErrorInterceptor translates streaming handler errors into structured gRPC statuses
+func StreamErrorInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return ToStatusError(handler(srv, ss))
+	}
+}
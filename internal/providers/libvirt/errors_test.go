@@ -0,0 +1,149 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
+)
+
+func virshFailure(stderr string) error {
+	return &VirshError{Command: "virsh test", ExitCode: 1, Stderr: stderr}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		code      providerv1.ErrorCode
+		retryable bool
+		number    int
+	}{
+		{name: "no domain", err: virshFailure("error: failed to get domain 'vm1'\nerror: Domain not found: no domain with matching name 'vm1'"),
+			code: providerv1.ErrorCode_ERROR_CODE_NOT_FOUND, number: virErrNoDomain},
+		{name: "no snapshot before no domain", err: virshFailure("error: Domain snapshot not found: no domain snapshot with matching name 's1'"),
+			code: providerv1.ErrorCode_ERROR_CODE_NOT_FOUND, number: virErrNoDomainSnapshot},
+		{name: "no storage volume", err: virshFailure("error: Storage volume not found: no storage vol with matching path '/x'"),
+			code: providerv1.ErrorCode_ERROR_CODE_NOT_FOUND, number: virErrNoStorageVol},
+		{name: "volume exists", err: virshFailure("error: storage volume already exists: vm1-disk"),
+			code: providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS, number: virErrStorageVolExist},
+		{name: "pool full", err: virshFailure("error: cannot allocate 1073741824 bytes: No space left on device"),
+			code: providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+		{name: "xml error", err: virshFailure("error: XML error: Invalid value for attribute 'unit'"),
+			code: providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC, number: virErrXMLError},
+		{name: "operation invalid", err: virshFailure("error: Requested operation is not valid: domain is not running"),
+			code: providerv1.ErrorCode_ERROR_CODE_INVALID_STATE, number: virErrOperationInvalid},
+		{name: "unsupported", err: virshFailure("error: this function is not supported by the connection driver"),
+			code: providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED, number: virErrNoSupport},
+		{name: "agent unresponsive", err: virshFailure("error: Guest agent is not responding: QEMU guest agent is not connected"),
+			code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true, number: virErrAgentUnresponsive},
+		{name: "invalid connection", err: virshFailure("error: invalid connection pointer in virConnectListAllDomains"),
+			code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true, number: virErrInvalidConn},
+		{name: "internal error", err: virshFailure("error: internal error: unable to execute QEMU command"),
+			code: providerv1.ErrorCode_ERROR_CODE_INTERNAL, number: virErrInternalError},
+		{name: "wrapped virsh error", err: fmt.Errorf("failed to start domain: %w", virshFailure("error: Domain not found")),
+			code: providerv1.ErrorCode_ERROR_CODE_NOT_FOUND, number: virErrNoDomain},
+		{name: "unrecognised virsh error falls back to the wrapping type",
+			err:  contracts.NewInvalidStateError("cannot suspend", virshFailure("error: something unexpected")),
+			code: providerv1.ErrorCode_ERROR_CODE_INVALID_STATE},
+		{name: "virsh error takes precedence over the wrapping type",
+			err:  contracts.NewRetryableError("failed to create VM", virshFailure("error: XML error: bad")),
+			code: providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC, number: virErrXMLError},
+
+		{name: "not found", err: contracts.NewNotFoundError("gone", nil), code: providerv1.ErrorCode_ERROR_CODE_NOT_FOUND},
+		{name: "conflict", err: contracts.NewConflictError("exists", nil), code: providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS},
+		{name: "quota", err: contracts.NewQuotaExceededError("full", nil), code: providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED},
+		{name: "invalid spec", err: contracts.NewInvalidSpecError("bad", nil), code: providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC},
+		{name: "invalid state", err: contracts.NewInvalidStateError("off", nil), code: providerv1.ErrorCode_ERROR_CODE_INVALID_STATE},
+		{name: "not supported", err: contracts.NewNotSupportedError("no"), code: providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED},
+		{name: "unauthorized", err: contracts.NewUnauthorizedError("denied", nil), code: providerv1.ErrorCode_ERROR_CODE_PERMISSION_DENIED},
+		{name: "retryable", err: contracts.NewRetryableError("later", nil), code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "unavailable", err: contracts.NewUnavailableError("down", nil), code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "timeout", err: contracts.NewTimeoutError("slow", nil), code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "rate limit", err: contracts.NewRateLimitError("busy", nil), code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "wrapped provider error", err: fmt.Errorf("failed to reconfigure VM: %w", contracts.NewInvalidSpecError("bad", nil)),
+			code: providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC},
+
+		{name: "deadline", err: fmt.Errorf("waiting: %w", context.DeadlineExceeded), code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "canceled", err: context.Canceled, code: providerv1.ErrorCode_ERROR_CODE_TRANSIENT, retryable: true},
+		{name: "plain error", err: errors.New("something broke"), code: providerv1.ErrorCode_ERROR_CODE_INTERNAL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, retryable, number := classifyError(tt.err)
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.retryable, retryable)
+			assert.Equal(t, tt.number, number)
+		})
+	}
+}
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "not found", err: virshFailure("error: Domain not found"), code: codes.NotFound},
+		{name: "already exists", err: contracts.NewConflictError("exists", nil), code: codes.AlreadyExists},
+		{name: "quota", err: contracts.NewQuotaExceededError("full", nil), code: codes.ResourceExhausted},
+		{name: "invalid spec", err: contracts.NewInvalidSpecError("bad", nil), code: codes.InvalidArgument},
+		{name: "invalid state", err: contracts.NewInvalidStateError("off", nil), code: codes.FailedPrecondition},
+		{name: "unsupported", err: contracts.NewNotSupportedError("no"), code: codes.Unimplemented},
+		{name: "permission denied", err: contracts.NewUnauthorizedError("denied", nil), code: codes.PermissionDenied},
+		{name: "transient", err: contracts.NewRetryableError("later", nil), code: codes.Unavailable},
+		{name: "plain error", err: errors.New("something broke"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(ToStatusError(tt.err))
+			require.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+
+			require.Len(t, st.Details(), 1)
+			detail, ok := st.Details()[0].(*providerv1.ErrorDetail)
+			require.True(t, ok)
+			code, retryable, _ := classifyError(tt.err)
+			assert.Equal(t, code, detail.Code)
+			assert.Equal(t, retryable, detail.Retryable)
+		})
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, ToStatusError(nil))
+	})
+	t.Run("status unchanged", func(t *testing.T) {
+		err := status.Error(codes.Aborted, "aborted")
+		assert.Equal(t, err, ToStatusError(err))
+	})
+	t.Run("libvirt error number", func(t *testing.T) {
+		st, _ := status.FromError(ToStatusError(virshFailure("error: Domain not found")))
+		detail := st.Details()[0].(*providerv1.ErrorDetail)
+		assert.Equal(t, int32(virErrNoDomain), detail.ProviderErrorNumber)
+	})
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Domain event types sent by WatchEvents
+const (
+	// DomainEventState replays the current state of a domain on subscribe and after a reconnect
+	DomainEventState = "state"
+
+	DomainEventDefined   = "defined"
+	DomainEventUndefined = "undefined"
+	DomainEventStarted   = "started"
+	DomainEventStopped   = "stopped"
+	DomainEventPaused    = "paused"
+	DomainEventResumed   = "resumed"
+	DomainEventCrashed   = "crashed"
+)
+
+// lifecycleEventPattern matches a line of `virsh event --event lifecycle`, e.g.
+// "event 'lifecycle' for domain 'vm1': Stopped Destroyed"; older virsh does not quote the name
+var lifecycleEventPattern = regexp.MustCompile(`event 'lifecycle' for domain '?(.+?)'?: (\S+)\s*(.*)$`)
+
+// DomainEvent is a lifecycle event of a domain, or its replayed current state
+type DomainEvent struct {
+	VMID string
+	// Type is one of the DomainEvent* constants
+	Type string
+	// Detail is the lowercased libvirt event detail, e.g. "booted", "destroyed" or "panicked";
+	// replayed states carry the reason of the state instead
+	Detail string
+	// State is the domain state after the event, when known
+	State string
+	// Replay is set for events sent on subscribe or after a reconnect rather than by libvirt
+	Replay bool
+	Time   time.Time
+}
+
+// WatchEvents streams lifecycle events of the watched domains (all domains when vmIDs is empty)
+// until ctx is done or send fails. virsh cannot register domain event callbacks itself, so a
+// `virsh event --loop` session does it on a dedicated connection. The current state of every
+// watched domain is replayed on subscribe, and again whenever the session is reopened after
+// libvirt went away, so clients never miss a transition.
+func (p *Provider) WatchEvents(ctx context.Context, vmIDs []string, send func(DomainEvent) error) error {
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	watched := make(map[string]bool, len(vmIDs))
+	for _, vmID := range vmIDs {
+		watched[vmID] = true
+	}
+
+	var sendErr error
+	forward := func(event DomainEvent) error {
+		if len(watched) > 0 && !watched[event.VMID] {
+			return nil
+		}
+		if err := send(event); err != nil {
+			sendErr = err
+			return err
+		}
+		return nil
+	}
+
+	pool := getConnPool(p.virshProvider.uri)
+	conn := pool.streamConn()
+	defer pool.close(conn)
+
+	log.Printf("INFO Watching domain events (vms=%v)", vmIDs)
+	backoff := reconnectInitialBackoff
+	for {
+		start := time.Now()
+		err := p.runDomainEventSession(ctx, pool, conn, vmIDs, forward)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+
+		log.Printf("WARN Domain event session ended (%v), reconnecting", err)
+		if time.Since(start) > reconnectMaxBackoff {
+			backoff = reconnectInitialBackoff
+		}
+		if !sleepContext(ctx, backoff) {
+			return nil
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// runDomainEventSession opens a lifecycle event session, replays the current domain state
+// and forwards events until the session ends or forward fails
+func (p *Provider) runDomainEventSession(ctx context.Context, pool *connPool, conn *pooledConn,
+	vmIDs []string, forward func(DomainEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pool.close(conn)
+	cmd, _, err := p.virshProvider.buildCommand(ctx, pool, conn, "event", "--event", "lifecycle", "--loop")
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	cmd.Stderr = io.Discard
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start event session: %w", err)
+	}
+	defer func() {
+		cancel()
+		_ = cmd.Wait()
+	}()
+
+	// Events raised while the state is replayed wait in the pipe, so none is lost in between
+	if err := p.replayDomainState(ctx, vmIDs, forward); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		event, ok := parseLifecycleEvent(scanner.Text())
+		if !ok {
+			continue
+		}
+		if err := forward(event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("event session ended")
+}
+
+// replayDomainState sends the current state of the watched domains as DomainEventState events.
+// Watched domains that do not exist are skipped; their defined event follows once created.
+func (p *Provider) replayDomainState(ctx context.Context, vmIDs []string, forward func(DomainEvent) error) error {
+	var domains []VirshDomain
+	if len(vmIDs) == 0 {
+		all, err := p.virshProvider.listDomains(ctx)
+		if err != nil {
+			return err
+		}
+		domains = all
+	} else {
+		for _, vmID := range vmIDs {
+			state, err := p.virshProvider.getDomainState(ctx, vmID)
+			if err != nil {
+				log.Printf("DEBUG Not replaying state of domain %s: %v", vmID, err)
+				continue
+			}
+			domains = append(domains, VirshDomain{Name: vmID, State: state})
+		}
+	}
+
+	for _, domain := range domains {
+		event := DomainEvent{
+			VMID:   domain.Name,
+			Type:   DomainEventState,
+			State:  domain.State,
+			Replay: true,
+			Time:   time.Now(),
+		}
+		// The reason tells a crashed guest apart from a clean shutdown the watcher missed
+		if domain.State != "running" {
+			if _, reason, err := p.virshProvider.getDomainStateReason(ctx, domain.Name); err == nil {
+				event.Detail = reason
+			}
+		}
+		if err := forward(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseLifecycleEvent converts a line of virsh event output into a DomainEvent;
+// events that do not change the domain state (e.g. Shutdown Finished) are dropped
+func parseLifecycleEvent(line string) (DomainEvent, bool) {
+	match := lifecycleEventPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return DomainEvent{}, false
+	}
+
+	event := DomainEvent{
+		VMID:   match[1],
+		Detail: strings.ToLower(strings.TrimSpace(match[3])),
+		Time:   time.Now(),
+	}
+	switch match[2] {
+	case "Defined":
+		event.Type = DomainEventDefined
+	case "Undefined":
+		event.Type = DomainEventUndefined
+	case "Started":
+		event.Type, event.State = DomainEventStarted, "running"
+	case "Resumed":
+		event.Type, event.State = DomainEventResumed, "running"
+	case "Suspended":
+		event.Type, event.State = DomainEventPaused, "paused"
+	case "PMSuspended":
+		event.Type, event.State = DomainEventPaused, "pmsuspended"
+	case "Crashed":
+		event.Type, event.State = DomainEventCrashed, "crashed"
+	case "Stopped":
+		event.Type, event.State = DomainEventStopped, "shut off"
+		if event.Detail == "crashed" {
+			event.Type = DomainEventCrashed
+		}
+	default:
+		return DomainEvent{}, false
+	}
+	return event, true
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// volumeDefinition is the subset of a storage volume definition (vol-dumpxml) the provider uses
+type volumeDefinition struct {
+	XMLName xml.Name `xml:"volume"`
+	Target  struct {
+		Path   string `xml:"path"`
+		Format struct {
+			Type string `xml:"type,attr"`
+		} `xml:"format"`
+	} `xml:"target"`
+	BackingStore struct {
+		Path string `xml:"path"`
+	} `xml:"backingStore"`
+}
+
+// isReferencedDisk reports whether a disk spec attaches an existing volume instead of creating one
+func isReferencedDisk(disk contracts.DiskSpec) bool {
+	return disk.Volume != "" || disk.Path != ""
+}
+
+// validateReferencedDisk checks the reference of a disk attaching an existing volume
+func validateReferencedDisk(disk contracts.DiskSpec, root bool) error {
+	switch {
+	case root:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: the root disk cannot reference an existing volume", disk.Name), nil)
+	case disk.Volume != "" && disk.Path != "":
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: volume and path are mutually exclusive", disk.Name), nil)
+	case disk.SourceImage != "":
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: an existing volume cannot have a source image", disk.Name), nil)
+	case disk.Volume != "" && disk.Pool == "":
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: volume %s needs the pool it belongs to", disk.Name, disk.Volume), nil)
+	case disk.Path != "" && !filepath.IsAbs(disk.Path):
+		return contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: path %s must be absolute", disk.Name, disk.Path), nil)
+	}
+	return nil
+}
+
+// resolveReferencedDisk verifies that the volume referenced by a disk exists and returns
+// its path and format. The format is read from the pool for managed volumes; unmanaged
+// block devices are raw and unmanaged files must state their format.
+func (p *Provider) resolveReferencedDisk(ctx context.Context, disk contracts.DiskSpec) (string, string, error) {
+	var definition volumeDefinition
+	managed := false
+
+	if disk.Volume != "" {
+		result, err := p.virshProvider.runVirshCommand(ctx, "vol-dumpxml", "--pool", disk.Pool, disk.Volume)
+		if err != nil {
+			return "", "", contracts.NewNotFoundError(fmt.Sprintf("disk %q: volume %s not found in pool %s", disk.Name, disk.Volume, disk.Pool), err)
+		}
+		if err := xml.Unmarshal([]byte(result.Stdout), &definition); err != nil {
+			return "", "", fmt.Errorf("failed to parse volume %s: %w", disk.Volume, err)
+		}
+		managed = true
+	} else {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-e", disk.Path); err != nil {
+			return "", "", contracts.NewNotFoundError(fmt.Sprintf("disk %q: %s not found on host", disk.Name, disk.Path), err)
+		}
+		definition.Target.Path = disk.Path
+		// Paths inside a pool are managed volumes whose format libvirt knows
+		if result, err := p.virshProvider.runVirshCommand(ctx, "vol-dumpxml", disk.Path); err == nil {
+			managed = xml.Unmarshal([]byte(result.Stdout), &definition) == nil
+		}
+	}
+
+	// Writes of one VM to an overlay would not be seen through the backing image of another
+	if disk.Shareable && definition.BackingStore.Path != "" {
+		return "", "", contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: %s is an overlay of %s and cannot be shared",
+			disk.Name, definition.Target.Path, definition.BackingStore.Path), nil)
+	}
+
+	format := strings.ToLower(disk.Format)
+	// Block pools (LVM, iSCSI) report their volumes without a format or as "none"
+	if detected := definition.Target.Format.Type; managed && detected != "" && detected != "none" {
+		if format != "" && format != detected {
+			return "", "", contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: volume %s is %s, not %s", disk.Name, definition.Target.Path, detected, format), nil)
+		}
+		format = detected
+	}
+	if format == "" {
+		if !strings.HasPrefix(definition.Target.Path, "/dev/") {
+			return "", "", contracts.NewInvalidSpecError(fmt.Sprintf("disk %q: the format of %s is unknown, set it on the disk", disk.Name, definition.Target.Path), nil)
+		}
+		format = diskFormatRaw
+	}
+	return definition.Target.Path, format, nil
+}
+
+// diskVolumeRecords records which disk volumes of a new domain the provider created
+func diskVolumeRecords(plans []diskPlan) []volumeRecord {
+	records := make([]volumeRecord, 0, len(plans))
+	for _, plan := range plans {
+		records = append(records, volumeRecord{Path: plan.Path, Owned: !plan.Referenced})
+	}
+	return records
+}
+
+// referencedVolumes returns the paths of volumes a domain attached by reference, which Delete keeps
+func (p *Provider) referencedVolumes(ctx context.Context, domainName string) (map[string]bool, error) {
+	records, err := p.virshProvider.getVolumeRecords(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	referenced := make(map[string]bool)
+	for _, record := range records {
+		if !record.Owned {
+			referenced[record.Path] = true
+		}
+	}
+	return referenced, nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	v1beta1 "github.com/projectbeskar/virtrigaud/api/infra.virtrigaud.io/v1beta1"
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// invalidNameChars matches runs of characters not allowed in Kubernetes object names
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ExportOptions names the objects the exported manifests reference
+type ExportOptions struct {
+	// Namespace of the exported objects (omitted when empty)
+	Namespace string
+	// ProviderName is the Provider resource the VM is managed by (default "libvirt")
+	ProviderName string
+	// ClassName is the name of the exported VMClass (default: the VM name)
+	ClassName string
+}
+
+// ExportResult holds the manifests describing a VM
+type ExportResult struct {
+	// Manifest is a multi-document YAML with a VMClass and a VirtualMachine
+	Manifest string
+	// Unrepresented lists configuration the custom resources cannot express
+	Unrepresented []string
+}
+
+// ExportSpec serializes the current configuration of a domain as the VMClass and
+// VirtualMachine resources that describe it. The root disk is referenced as an imported
+// disk so that applying the manifests reuses it. Configuration without a field in the
+// resources is listed in Unrepresented and as comments at the top of the manifest.
+func (p *Provider) ExportSpec(ctx context.Context, vmID string, opts ExportOptions) (ExportResult, error) {
+	var result ExportResult
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	metadata, err := p.virshProvider.getVMMetadata(ctx, domain.Name)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read domain metadata", err)
+	}
+	state, err := p.virshProvider.getDomainState(ctx, domain.Name)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	spec, unrepresented := p.normalizedSpec(ctx, domain, metadata)
+
+	vmName := kubernetesName(domain.Name)
+	if vmName != domain.Name {
+		unrepresented = append(unrepresented, fmt.Sprintf("name: domain %q is exported as %q", domain.Name, vmName))
+	}
+	className := opts.ClassName
+	if className == "" {
+		className = vmName
+	}
+	providerName := opts.ProviderName
+	if providerName == "" {
+		providerName = "libvirt"
+	}
+
+	class := v1beta1.VMClass{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "VMClass"},
+		ObjectMeta: metav1.ObjectMeta{Name: className, Namespace: opts.Namespace},
+		Spec: v1beta1.VMClassSpec{
+			CPU:    spec.Class.CPU,
+			Memory: *resource.NewQuantity(int64(spec.Class.MemoryMiB)*1024*1024, resource.BinarySI),
+		},
+	}
+	switch spec.Class.Firmware {
+	case firmwareUEFI, firmwareUEFISecure:
+		class.Spec.Firmware = v1beta1.FirmwareTypeUEFI
+	default:
+		class.Spec.Firmware = v1beta1.FirmwareTypeBIOS
+	}
+	if profile := spec.Class.SecurityProfile; profile != nil {
+		class.Spec.SecurityProfile = &v1beta1.SecurityProfile{
+			SecureBoot: profile.SecureBoot,
+			TPMEnabled: profile.TPMEnabled,
+			TPMVersion: profile.TPMVersion,
+		}
+	}
+
+	vm := v1beta1.VirtualMachine{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.GroupVersion.String(), Kind: "VirtualMachine"},
+		ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: opts.Namespace},
+		Spec: v1beta1.VirtualMachineSpec{
+			ProviderRef: v1beta1.ObjectRef{Name: providerName},
+			ClassRef:    v1beta1.ObjectRef{Name: className},
+			PowerState:  v1beta1.PowerState(p.mapLibvirtPowerState(state)),
+		},
+	}
+	if vm.Spec.PowerState != v1beta1.PowerStateOn && vm.Spec.PowerState != v1beta1.PowerStateOff {
+		unrepresented = append(unrepresented, fmt.Sprintf("powerState: domain state %q has no equivalent", state))
+		vm.Spec.PowerState = ""
+	}
+
+	for i, disk := range spec.Disks {
+		if i > 0 {
+			vm.Spec.Disks = append(vm.Spec.Disks, v1beta1.DiskSpec{Name: kubernetesName(disk.Name), SizeGiB: disk.SizeGiB})
+			continue
+		}
+
+		// The root disk is reused as an imported disk
+		class.Spec.DiskDefaults = &v1beta1.DiskDefaults{
+			Size: *resource.NewQuantity(int64(disk.SizeGiB)*1024*1024*1024, resource.BinarySI),
+		}
+		if source, ok := domain.findDisk(disk.Name); ok {
+			path := source.sourcePath()
+			vm.Spec.ImportedDisk = &v1beta1.ImportedDiskRef{
+				DiskID:  strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+				Path:    path,
+				Format:  source.Driver.Type,
+				Source:  "manual",
+				SizeGiB: disk.SizeGiB,
+			}
+		}
+		driver := resolveDiskDriver(disk)
+		if driver != resolveDiskDriver(contracts.DiskSpec{}) {
+			unrepresented = append(unrepresented, fmt.Sprintf("disk %s: bus=%s cache=%s io=%s discard=%s",
+				disk.Name, driver.Bus, driver.Cache, driver.IO, driver.Discard))
+		}
+	}
+
+	for _, network := range spec.Networks {
+		ref := v1beta1.VMNetworkRef{
+			Name:       kubernetesName(network.Name),
+			MACAddress: network.MacAddress,
+		}
+		if target := network.NetworkName + network.Bridge; target != "" {
+			// Refers to a VMNetworkAttachment that must map to the same libvirt network or bridge
+			ref.NetworkRef = &v1beta1.ObjectRef{Name: kubernetesName(target), Namespace: opts.Namespace}
+		}
+		vm.Spec.Networks = append(vm.Spec.Networks, ref)
+		if network.Bandwidth != nil {
+			unrepresented = append(unrepresented, fmt.Sprintf("network %s: bandwidth limits", network.MacAddress))
+		}
+		if network.Model != "" && network.Model != "virtio" {
+			unrepresented = append(unrepresented, fmt.Sprintf("network %s: model %s (set it on the VMNetworkAttachment)", network.MacAddress, network.Model))
+		}
+	}
+
+	if spec.RNG != nil {
+		unrepresented = append(unrepresented, fmt.Sprintf("rng: disabled=%t rate=%d bytes/%d ms", spec.RNG.Disabled, spec.RNG.RateBytes, spec.RNG.RatePeriodMs))
+	}
+	if spec.UUID != "" {
+		unrepresented = append(unrepresented, fmt.Sprintf("uuid: %s", spec.UUID))
+	}
+	for _, key := range slices.Sorted(maps.Keys(metadata)) {
+		unrepresented = append(unrepresented, fmt.Sprintf("metadata %s=%s", key, metadata[key]))
+	}
+
+	var b strings.Builder
+	for _, note := range unrepresented {
+		fmt.Fprintf(&b, "# not represented: %s\n", strings.ReplaceAll(note, "\n", " "))
+	}
+	for i, obj := range []interface{}{class, vm} {
+		if i > 0 {
+			b.WriteString("---\n")
+		}
+		doc, err := manifestYAML(obj)
+		if err != nil {
+			return result, fmt.Errorf("failed to encode manifest: %w", err)
+		}
+		b.Write(doc)
+	}
+
+	result.Manifest = b.String()
+	result.Unrepresented = unrepresented
+	return result, nil
+}
+
+// manifestYAML encodes a resource without its status and server-populated metadata
+func manifestYAML(obj interface{}) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "status")
+	if meta, ok := fields["metadata"].(map[string]interface{}); ok {
+		delete(meta, "creationTimestamp")
+	}
+	return yaml.Marshal(fields)
+}
+
+// kubernetesName turns a libvirt name into a valid Kubernetes object name
+func kubernetesName(name string) string {
+	name = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	if name == "" {
+		return "vm"
+	}
+	return name
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Firmware types accepted in the Firmware field of a VM class
+const (
+	firmwareBIOS       = vmspec.FirmwareBIOS
+	firmwareUEFI       = vmspec.FirmwareUEFI
+	firmwareUEFISecure = vmspec.FirmwareUEFISecure
+)
+
+// nvramDir is where per-VM UEFI variable stores are created
+const nvramDir = "/var/lib/libvirt/qemu/nvram"
+
+// firmwarePaths describes an OVMF build: the read-only code and the variable store template
+type firmwarePaths struct {
+	Loader       string
+	VarsTemplate string
+	Secure       bool
+}
+
+// ovmfCandidates lists the OVMF builds shipped by common distributions, in order of preference.
+// Secure boot variants pair the secboot code with a store that has the Microsoft keys enrolled.
+var ovmfCandidates = map[string][]firmwarePaths{
+	firmwareUEFI: {
+		{Loader: "/usr/share/OVMF/OVMF_CODE_4M.fd", VarsTemplate: "/usr/share/OVMF/OVMF_VARS_4M.fd"},
+		{Loader: "/usr/share/OVMF/OVMF_CODE.fd", VarsTemplate: "/usr/share/OVMF/OVMF_VARS.fd"},
+		{Loader: "/usr/share/edk2/ovmf/OVMF_CODE.fd", VarsTemplate: "/usr/share/edk2/ovmf/OVMF_VARS.fd"},
+		{Loader: "/usr/share/edk2/x64/OVMF_CODE.4m.fd", VarsTemplate: "/usr/share/edk2/x64/OVMF_VARS.4m.fd"},
+	},
+	firmwareUEFISecure: {
+		{Loader: "/usr/share/OVMF/OVMF_CODE_4M.secboot.fd", VarsTemplate: "/usr/share/OVMF/OVMF_VARS_4M.ms.fd", Secure: true},
+		{Loader: "/usr/share/OVMF/OVMF_CODE.secboot.fd", VarsTemplate: "/usr/share/OVMF/OVMF_VARS.ms.fd", Secure: true},
+		{Loader: "/usr/share/edk2/ovmf/OVMF_CODE.secboot.fd", VarsTemplate: "/usr/share/edk2/ovmf/OVMF_VARS.secboot.fd", Secure: true},
+		{Loader: "/usr/share/edk2/x64/OVMF_CODE.secboot.4m.fd", VarsTemplate: "/usr/share/edk2/x64/OVMF_VARS.4m.fd", Secure: true},
+	},
+}
+
+// resolveFirmware locates the OVMF build for the firmware of a VM class on the host.
+// BIOS guests use SeaBIOS, which needs no configuration, and yield nil.
+func (p *Provider) resolveFirmware(ctx context.Context, class contracts.VMClass) (*firmwarePaths, error) {
+	firmware, err := vmspec.FirmwareType(class)
+	if err != nil {
+		return nil, err
+	}
+	if firmware == firmwareBIOS {
+		return nil, nil
+	}
+
+	for _, candidate := range ovmfCandidates[firmware] {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-f", candidate.Loader, "-a", "-f", candidate.VarsTemplate); err == nil {
+			log.Printf("DEBUG Using %s firmware %s (variables template %s)", firmware, candidate.Loader, candidate.VarsTemplate)
+			found := candidate
+			return &found, nil
+		}
+	}
+	return nil, contracts.NewNotSupportedError(fmt.Sprintf(
+		"%s firmware requested but no OVMF build was found on the libvirt host (install the ovmf or edk2-ovmf package)", firmware))
+}
+
+// firmwareOSXML renders the <os> contents for a domain, ending with the given boot entries;
+// UEFI guests get a per-VM NVRAM store
+func firmwareOSXML(firmware *firmwarePaths, name, bootXML string) string {
+	if firmware == nil {
+		return `    <type arch='x86_64' machine='pc'>hvm</type>` + bootXML
+	}
+
+	// Secure boot needs SMM, which is only available on the q35 machine type
+	machine, secure := "pc", "no"
+	if firmware.Secure {
+		machine, secure = "q35", "yes"
+	}
+	return fmt.Sprintf(`    <type arch='x86_64' machine='%s'>hvm</type>
+    <loader readonly='yes' type='pflash' secure='%s'>%s</loader>
+    <nvram template='%s'>%s/%s_VARS.fd</nvram>%s`, machine, secure, xmlEscape(firmware.Loader),
+		xmlEscape(firmware.VarsTemplate), nvramDir, xmlEscape(name), bootXML)
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// DefaultGarbageMinAge is how old an orphaned artifact must be before it is collected, so
+// that the volumes of a Create still in progress are never mistaken for leftovers
+const DefaultGarbageMinAge = time.Hour
+
+// Kinds of artifacts CollectGarbage recognises
+const (
+	GarbageKindVolume    = "volume"
+	GarbageKindCloudInit = "cloud-init"
+	GarbageKindIgnition  = "ignition"
+	GarbageKindNVRAM     = "nvram"
+	GarbageKindStaging   = "staging"
+)
+
+// cloudInitStagingDir is where cloud-init seed files are staged before being imported into a pool
+const cloudInitStagingDir = "/tmp/virtrigaud-cloudinit"
+
+// diskVolumePattern matches the disk volumes Create names after their VM:
+// <vm>-disk for the root disk and <vm>-disk-<target> for data disks, suffixed with the
+// format when built from an image
+var diskVolumePattern = regexp.MustCompile(`^(.+)-disk(?:-[hsv]d[a-z]+)?(?:\.(?:qcow2|raw))?$`)
+
+// GarbageOptions controls CollectGarbage
+type GarbageOptions struct {
+	// DryRun reports the orphaned artifacts without removing them
+	DryRun bool
+	// MinAge skips artifacts modified more recently (default DefaultGarbageMinAge)
+	MinAge time.Duration
+}
+
+// GarbageArtifact is an artifact left behind by a VM that no longer exists
+type GarbageArtifact struct {
+	Kind string
+	// VMID is the VM the artifact was created for
+	VMID string
+	// Pool is the storage pool holding a volume; empty for host files
+	Pool      string
+	Path      string
+	SizeBytes int64
+	// Removed is set once the artifact has been deleted
+	Removed bool
+	// Error is why the artifact could not be deleted
+	Error string
+}
+
+// GarbageReport lists the orphaned artifacts CollectGarbage found
+type GarbageReport struct {
+	Artifacts []GarbageArtifact
+	// ReclaimedBytes is the space freed, or that would be freed on a dry run
+	ReclaimedBytes int64
+}
+
+// garbageArtifactOwner returns the VM a pool volume was created for and its kind,
+// or "" for volumes that virtrigaud did not name
+func garbageArtifactOwner(volumeName string) (string, string) {
+	switch {
+	case strings.HasSuffix(volumeName, cloudInitVolumeSuffix):
+		return strings.TrimSuffix(volumeName, cloudInitVolumeSuffix), GarbageKindCloudInit
+	case strings.HasSuffix(volumeName, ignitionVolumeSuffix):
+		return strings.TrimSuffix(volumeName, ignitionVolumeSuffix), GarbageKindIgnition
+	}
+	if match := diskVolumePattern.FindStringSubmatch(volumeName); match != nil {
+		return match[1], GarbageKindVolume
+	}
+	return "", ""
+}
+
+// CollectGarbage finds the volumes, seed images, NVRAM stores and staging directories
+// named after VMs that no longer exist and removes them. An artifact is only collected
+// when no domain on the host refers to it, neither by a device nor through the volume
+// ownership record in its metadata, and it is older than the minimum age.
+func (p *Provider) CollectGarbage(ctx context.Context, opts GarbageOptions) (GarbageReport, error) {
+	var report GarbageReport
+
+	if p.virshProvider == nil {
+		return report, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if opts.MinAge <= 0 {
+		opts.MinAge = DefaultGarbageMinAge
+	}
+
+	domains, inUse, err := p.artifactsInUse(ctx)
+	if err != nil {
+		return report, err
+	}
+	orphaned := func(owner, path string) bool {
+		return owner != "" && !domains[owner] && !inUse[path]
+	}
+
+	var candidates []GarbageArtifact
+	pools, err := p.virshProvider.listStoragePools(ctx)
+	if err != nil {
+		return report, contracts.NewRetryableError("failed to list storage pools", err)
+	}
+	for _, pool := range pools {
+		volumes, err := p.listPoolVolumes(ctx, pool.Name)
+		if err != nil {
+			log.Printf("WARN Skipping storage pool %s during garbage collection: %v", pool.Name, err)
+			continue
+		}
+		names := make([]string, 0, len(volumes))
+		for name := range volumes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			volumePath := volumes[name]
+			owner, kind := garbageArtifactOwner(name)
+			if !orphaned(owner, volumePath) || inUse[pool.Name+"/"+name] {
+				continue
+			}
+			candidates = append(candidates, GarbageArtifact{
+				Kind:      kind,
+				VMID:      owner,
+				Pool:      pool.Name,
+				Path:      volumePath,
+				SizeBytes: p.volumeAllocation(ctx, pool.Name, name),
+			})
+		}
+	}
+
+	for _, file := range p.listHostFiles(ctx, nvramDir) {
+		owner := strings.TrimSuffix(file, "_VARS.fd")
+		nvramPath := path.Join(nvramDir, file)
+		if owner != file && orphaned(owner, nvramPath) {
+			candidates = append(candidates, GarbageArtifact{Kind: GarbageKindNVRAM, VMID: owner, Path: nvramPath})
+		}
+	}
+	for _, dir := range p.listHostFiles(ctx, cloudInitStagingDir) {
+		stagingPath := path.Join(cloudInitStagingDir, dir)
+		if orphaned(dir, stagingPath) {
+			candidates = append(candidates, GarbageArtifact{Kind: GarbageKindStaging, VMID: dir, Path: stagingPath})
+		}
+	}
+
+	for _, artifact := range candidates {
+		age, err := p.artifactAge(ctx, artifact.Path)
+		if err != nil || age < opts.MinAge {
+			continue
+		}
+		if !opts.DryRun {
+			if err := p.removeGarbageArtifact(ctx, artifact); err != nil {
+				log.Printf("WARN Failed to remove orphaned %s %s: %v", artifact.Kind, artifact.Path, err)
+				artifact.Error = err.Error()
+				report.Artifacts = append(report.Artifacts, artifact)
+				continue
+			}
+			artifact.Removed = true
+			log.Printf("INFO Removed orphaned %s %s of VM %s", artifact.Kind, artifact.Path, artifact.VMID)
+		}
+		report.ReclaimedBytes += artifact.SizeBytes
+		report.Artifacts = append(report.Artifacts, artifact)
+	}
+
+	log.Printf("INFO Garbage collection found %d orphaned artifact(s), %d bytes (dry run: %t)",
+		len(report.Artifacts), report.ReclaimedBytes, opts.DryRun)
+	return report, nil
+}
+
+// artifactsInUse returns the names of all domains on the host and every path and pool/volume
+// their persistent and live definitions refer to. Collection is refused when a definition
+// cannot be read, since its artifacts could not be told apart from orphans.
+func (p *Provider) artifactsInUse(ctx context.Context) (map[string]bool, map[string]bool, error) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, nil, contracts.NewRetryableError("failed to list domains", err)
+	}
+
+	domains := make(map[string]bool)
+	inUse := make(map[string]bool)
+	for _, name := range strings.Split(result.Stdout, "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		domains[name] = true
+
+		for _, args := range [][]string{{"dumpxml", name}, {"dumpxml", "--inactive", name}} {
+			dump, err := p.virshProvider.runVirshCommand(ctx, args...)
+			if err != nil {
+				return nil, nil, contracts.NewRetryableError(fmt.Sprintf("failed to read definition of domain %s", name), err)
+			}
+			var domain domainXML
+			if err := xml.Unmarshal([]byte(dump.Stdout), &domain); err != nil {
+				return nil, nil, contracts.NewRetryableError(fmt.Sprintf("failed to parse definition of domain %s", name), err)
+			}
+			for _, disk := range domain.Devices.Disks {
+				inUse[disk.Source.File] = true
+				inUse[disk.Source.Dev] = true
+				if disk.Source.Pool != "" {
+					inUse[disk.Source.Pool+"/"+disk.Source.Volume] = true
+				}
+			}
+			inUse[strings.TrimSpace(domain.OS.NVRAM)] = true
+		}
+
+		records, err := p.virshProvider.getVolumeRecords(ctx, name)
+		if err != nil {
+			return nil, nil, contracts.NewRetryableError(fmt.Sprintf("failed to read volume ownership of domain %s", name), err)
+		}
+		for _, record := range records {
+			inUse[record.Path] = true
+		}
+	}
+	delete(inUse, "")
+	return domains, inUse, nil
+}
+
+// listPoolVolumes returns the volumes of a storage pool by name with their paths
+func (p *Provider) listPoolVolumes(ctx context.Context, poolName string) (map[string]string, error) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "vol-list", poolName)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make(map[string]string)
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] == "Name" || strings.HasPrefix(fields[0], "---") {
+			continue
+		}
+		volumes[fields[0]] = strings.Join(fields[1:], " ")
+	}
+	return volumes, nil
+}
+
+// volumeAllocation returns the bytes a volume occupies, or 0 when it cannot be read
+func (p *Provider) volumeAllocation(ctx context.Context, poolName, volumeName string) int64 {
+	result, err := p.virshProvider.runVirshCommand(ctx, "vol-info", "--bytes", volumeName, "--pool", poolName)
+	if err != nil {
+		return 0
+	}
+	size, err := parseStorageSize(parseColonFields(result.Stdout)["Allocation"])
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
+// listHostFiles lists the entries of a host directory; a missing directory yields none
+func (p *Provider) listHostFiles(ctx context.Context, dir string) []string {
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "ls", "-1", dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, name := range strings.Split(result.Stdout, "\n") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// artifactAge returns how long ago a host file or volume was last modified
+func (p *Provider) artifactAge(ctx context.Context, artifactPath string) (time.Duration, error) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "stat", "-c", "%Y", artifactPath)
+	if err != nil {
+		return 0, err
+	}
+	modified, err := strconv.ParseInt(strings.TrimSpace(result.Stdout), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse modification time of %s: %w", artifactPath, err)
+	}
+	return time.Since(time.Unix(modified, 0)), nil
+}
+
+// removeGarbageArtifact deletes an orphaned volume through its pool, or a host file or directory
+func (p *Provider) removeGarbageArtifact(ctx context.Context, artifact GarbageArtifact) error {
+	if artifact.Pool != "" {
+		_, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", artifact.Path)
+		return err
+	}
+	_, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "rm", "-rf", artifact.Path)
+	return err
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Video adapter models a VM can be given
+const (
+	videoModelCirrus = vmspec.VideoModelCirrus
+	videoModelQXL    = vmspec.VideoModelQXL
+	videoModelVirtio = vmspec.VideoModelVirtio
+	videoModelNone   = vmspec.VideoModelNone
+)
+
+// Remote display protocols a VM console can be exposed over
+const (
+	displayVNC   = vmspec.DisplayVNC
+	displaySPICE = vmspec.DisplaySPICE
+)
+
+const (
+	// displayListenAddress keeps remote displays on the host's loopback interface
+	displayListenAddress = "127.0.0.1"
+
+	// defaultCirrusVRAMKiB is the video memory of a cirrus adapter without an explicit size
+	defaultCirrusVRAMKiB = 16384
+)
+
+// domainGraphics describes a remote display of a domain
+type domainGraphics struct {
+	Type string `xml:"type,attr"`
+}
+
+// domainVideo describes a video adapter of a domain
+type domainVideo struct {
+	Model struct {
+		Type string `xml:"type,attr"`
+		VRAM int32  `xml:"vram,attr"`
+	} `xml:"model"`
+}
+
+// checkGraphicsAvailable verifies that the host's QEMU supports the requested display
+func (p *Provider) checkGraphicsAvailable(ctx context.Context, graphics *contracts.GraphicsConfig) error {
+	if graphics == nil || graphics.Display != displaySPICE {
+		return nil
+	}
+
+	caps, err := p.virshProvider.getDomainCapabilities(ctx)
+	if err != nil {
+		return contracts.NewRetryableError("failed to read host domain capabilities", err)
+	}
+	if caps.supportsGraphics(displaySPICE) {
+		return nil
+	}
+	return contracts.NewNotSupportedError("SPICE display requested but the QEMU on the libvirt host does not support it")
+}
+
+// graphicsXML renders the remote display, video adapter and, for VMs with a display, the
+// sound card. Without a display only the serial console is available.
+func graphicsXML(graphics *contracts.GraphicsConfig) string {
+	var out string
+	if graphics != nil && graphics.Display != "" {
+		password := ""
+		if graphics.Password != "" {
+			password = fmt.Sprintf(" passwd='%s'", xmlEscape(graphics.Password))
+		}
+		out += fmt.Sprintf(`
+    <graphics type='%s' autoport='yes' listen='%s'%s>
+      <listen type='address' address='%s'/>
+    </graphics>
+    <sound model='ich6'>
+      <address type='pci' domain='0x0000' bus='0x00' slot='0x04' function='0x0'/>
+    </sound>`, graphics.Display, displayListenAddress, password, displayListenAddress)
+	}
+
+	model := vmspec.VideoModel(graphics)
+	if model == videoModelNone {
+		return out + `
+    <video>
+      <model type='none'/>
+    </video>`
+	}
+
+	vram := ""
+	if graphics.VRAMKiB > 0 {
+		vram = fmt.Sprintf(" vram='%d'", graphics.VRAMKiB)
+	} else if model == videoModelCirrus {
+		vram = fmt.Sprintf(" vram='%d'", defaultCirrusVRAMKiB)
+	}
+	return out + fmt.Sprintf(`
+    <video>
+      <model type='%s'%s heads='1' primary='yes'/>
+    </video>`, model, vram)
+}
+
+// contractGraphics describes the display and video adapter of a domain as a create request
+// setting. libvirt does not reveal display passwords, so they are not part of it.
+func contractGraphics(domain *domainXML) *contracts.GraphicsConfig {
+	var graphics contracts.GraphicsConfig
+	for _, display := range domain.Devices.Graphics {
+		if display.Type == displayVNC || display.Type == displaySPICE {
+			graphics.Display = display.Type
+			break
+		}
+	}
+	if len(domain.Devices.Videos) > 0 {
+		video := domain.Devices.Videos[0].Model
+		graphics.VideoModel = video.Type
+		// Sizes the provider or libvirt picks by default are left out of the spec
+		if video.Type != videoModelVirtio && (video.Type != videoModelCirrus || video.VRAM != defaultCirrusVRAMKiB) {
+			graphics.VRAMKiB = video.VRAM
+		}
+	}
+	if graphics.Display == "" && (graphics.VideoModel == "" || graphics.VideoModel == videoModelNone) {
+		return nil
+	}
+	return &graphics
+}
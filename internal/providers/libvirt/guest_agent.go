@@ -403,6 +403,8 @@ func (g *GuestAgentProvider) ExecuteGuestCommand(ctx context.Context, domainName
 
 	for {
 		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
 		case <-timeout:
 			return "", fmt.Errorf("command execution timeout")
 		case <-ticker.C:
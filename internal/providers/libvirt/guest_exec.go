@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// defaultGuestExecTimeout is how long a guest command may run when the request sets no timeout
+	defaultGuestExecTimeout = time.Minute
+
+	// maxGuestExecTimeout caps the timeout a request may ask for
+	maxGuestExecTimeout = 10 * time.Minute
+
+	// maxGuestExecOutputBytes caps the stdout and stderr returned for a guest command
+	maxGuestExecOutputBytes = 1024 * 1024
+
+	// guestExecPollInterval is how often guest-exec-status is polled
+	guestExecPollInterval = 500 * time.Millisecond
+)
+
+// GuestExecResult is the outcome of a command run inside a guest
+type GuestExecResult struct {
+	// ExitCode is the exit status of the command
+	ExitCode int32
+	// Stdout and Stderr hold the captured output, capped at maxGuestExecOutputBytes each
+	Stdout []byte
+	Stderr []byte
+	// StdoutTruncated and StderrTruncated report output dropped by the cap or the guest agent
+	StdoutTruncated bool
+	StderrTruncated bool
+}
+
+// guestExecStatus is the reply to guest-exec-status
+type guestExecStatus struct {
+	Exited       bool   `json:"exited"`
+	ExitCode     int32  `json:"exitcode"`
+	OutData      string `json:"out-data"`
+	ErrData      string `json:"err-data"`
+	OutTruncated bool   `json:"out-truncated"`
+	ErrTruncated bool   `json:"err-truncated"`
+}
+
+// ExecInGuest runs a command inside a running guest through the QEMU guest agent and waits
+// for it to exit. Running commands is refused unless the provider was started with
+// --allow-guest-exec. The guest agent cannot stop a command, so one that outlives the
+// timeout keeps running in the guest.
+func (p *Provider) ExecInGuest(ctx context.Context, vmID, path string, args []string, timeout time.Duration) (GuestExecResult, error) {
+	var result GuestExecResult
+
+	if !p.options.AllowGuestExec {
+		return result, contracts.NewUnauthorizedError("guest command execution is disabled; start the provider with --allow-guest-exec", nil)
+	}
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if path == "" {
+		return result, contracts.NewInvalidSpecError("command path is required", nil)
+	}
+	switch {
+	case timeout <= 0:
+		timeout = defaultGuestExecTimeout
+	case timeout > maxGuestExecTimeout:
+		timeout = maxGuestExecTimeout
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		if domainGone(err) {
+			return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+		}
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+	if state != "running" {
+		return result, contracts.NewInvalidSpecError(fmt.Sprintf("domain %s is %s; commands can only run in running guests", vmID, state), nil)
+	}
+
+	// Arguments may carry secrets, so only the executable is logged
+	log.Printf("INFO Executing %s in guest %s (%d arguments, timeout %s)", path, vmID, len(args), timeout)
+	return p.runGuestCommand(ctx, vmID, path, args, timeout)
+}
+
+// runGuestCommand starts a command through guest-exec and polls until it exits or the timeout passes
+func (p *Provider) runGuestCommand(ctx context.Context, vmID, path string, args []string, timeout time.Duration) (GuestExecResult, error) {
+	var result GuestExecResult
+
+	if args == nil {
+		args = []string{}
+	}
+	var started struct {
+		PID int64 `json:"pid"`
+	}
+	if err := p.guestAgentCommand(ctx, vmID, "guest-exec", map[string]any{
+		"path":           path,
+		"arg":            args,
+		"capture-output": true,
+	}, &started); err != nil {
+		return result, contracts.NewRetryableError(fmt.Sprintf("guest agent of %s did not start the command", vmID), err)
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(guestExecPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-execCtx.Done():
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			return result, contracts.NewTimeoutError(fmt.Sprintf("command %s (pid %d) in guest %s did not exit within %s", path, started.PID, vmID, timeout), nil)
+		case <-ticker.C:
+			var status guestExecStatus
+			if err := p.guestAgentCommand(execCtx, vmID, "guest-exec-status", map[string]any{"pid": started.PID}, &status); err != nil {
+				log.Printf("DEBUG Failed to poll command %d in guest %s: %v", started.PID, vmID, err)
+				continue
+			}
+			if !status.Exited {
+				continue
+			}
+
+			result.ExitCode = status.ExitCode
+			result.Stdout, result.StdoutTruncated = decodeGuestOutput(status.OutData, status.OutTruncated)
+			result.Stderr, result.StderrTruncated = decodeGuestOutput(status.ErrData, status.ErrTruncated)
+			log.Printf("INFO Command %s in guest %s exited with code %d", path, vmID, result.ExitCode)
+			return result, nil
+		}
+	}
+}
+
+// guestAgentCommand sends a command to the guest agent and decodes its "return" value into out
+func (p *Provider) guestAgentCommand(ctx context.Context, vmID, execute string, arguments, out any) error {
+	payload, err := json.Marshal(map[string]any{"execute": execute, "arguments": arguments})
+	if err != nil {
+		return fmt.Errorf("failed to encode guest agent command: %w", err)
+	}
+
+	reply, err := p.virshProvider.runVirshCommand(ctx, "qemu-agent-command", vmID, p.virshProvider.quoteRemoteArg(string(payload)))
+	if err != nil {
+		return err
+	}
+
+	response := struct {
+		Return any `json:"return"`
+	}{Return: out}
+	if err := json.Unmarshal([]byte(reply.Stdout), &response); err != nil {
+		return fmt.Errorf("failed to parse %s reply: %w", execute, err)
+	}
+	return nil
+}
+
+// decodeGuestOutput decodes base64 output captured by the guest agent and applies the size cap
+func decodeGuestOutput(data string, truncated bool) ([]byte, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return []byte(data), truncated
+	}
+	if len(decoded) > maxGuestExecOutputBytes {
+		return decoded[:maxGuestExecOutputBytes], true
+	}
+	return decoded, truncated
+}
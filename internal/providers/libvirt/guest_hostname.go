@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+const (
+	// hostnamectlPath is the guest command that sets the hostname. The guest agent has no
+	// command of its own for it, so a systemd guest that allows guest-exec is required.
+	hostnamectlPath = "/usr/bin/hostnamectl"
+
+	// setHostnameTimeout is how long hostnamectl may run in the guest
+	setHostnameTimeout = 30 * time.Second
+)
+
+// GetGuestHostname returns the hostname the guest reports through its guest agent.
+// A guest that is not running or whose agent is not connected yields an Unavailable error.
+func (p *Provider) GetGuestHostname(ctx context.Context, vmID string) (string, error) {
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if err := p.checkGuestAgentReachable(ctx, vmID); err != nil {
+		return "", err
+	}
+	return p.virshProvider.getGuestHostname(ctx, vmID)
+}
+
+// SetGuestHostname sets the hostname inside a running guest with hostnamectl, run through
+// the guest agent, and returns the hostname the guest reported before the change
+func (p *Provider) SetGuestHostname(ctx context.Context, vmID, hostname string) (string, error) {
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if err := vmspec.ValidateHostname(hostname); err != nil {
+		return "", err
+	}
+	if err := p.checkGuestAgentReachable(ctx, vmID); err != nil {
+		return "", err
+	}
+
+	previous, err := p.virshProvider.getGuestHostname(ctx, vmID)
+	if err != nil {
+		return "", err
+	}
+	if previous == hostname {
+		return previous, nil
+	}
+
+	log.Printf("INFO Setting hostname of guest %s from %q to %q", vmID, previous, hostname)
+	result, err := p.runGuestCommand(ctx, vmID, hostnamectlPath, []string{"set-hostname", hostname}, setHostnameTimeout)
+	if err != nil {
+		return "", err
+	}
+	if result.ExitCode != 0 {
+		return "", contracts.NewInvalidStateError(fmt.Sprintf("hostnamectl in guest %s exited with code %d: %s",
+			vmID, result.ExitCode, strings.TrimSpace(string(result.Stderr))), nil)
+	}
+	return previous, nil
+}
+
+// checkGuestAgentReachable verifies that the domain exists and runs, so that its guest agent can answer
+func (p *Provider) checkGuestAgentReachable(ctx context.Context, vmID string) error {
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	if state != "running" {
+		return contracts.NewUnavailableError(fmt.Sprintf("domain %s is %s; its guest agent is unavailable", vmID, state), nil)
+	}
+	return nil
+}
+
+// getGuestHostname asks the guest agent for the hostname. The virsh failure is not kept as the
+// cause: libvirt reports a disconnected agent as an internal error, which is really Unavailable.
+func (v *VirshProvider) getGuestHostname(ctx context.Context, domainName string) (string, error) {
+	result, err := v.runVirshCommand(ctx, "domhostname", domainName, "--source", "agent")
+	if err != nil {
+		return "", contracts.NewUnavailableError(fmt.Sprintf("guest agent of %s is unavailable: %v", domainName, err), nil)
+	}
+	return strings.TrimSpace(result.Stdout), nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// interfaceSourceAgent reads addresses from the QEMU guest agent
+	interfaceSourceAgent = "agent"
+
+	// interfaceSourceLease reads addresses from the DHCP leases of libvirt networks
+	interfaceSourceLease = "lease"
+)
+
+// getGuestInterfaces reports the addresses of each guest interface.
+// The guest agent is queried first; when it is not yet up the DHCP leases are used
+// instead and the addresses are marked provisional, since the guest may not have
+// configured them yet.
+func (v *VirshProvider) getGuestInterfaces(ctx context.Context, domainName string) []contracts.GuestInterface {
+	for _, source := range []string{interfaceSourceAgent, interfaceSourceLease} {
+		result, err := v.runVirshCommand(ctx, "domifaddr", domainName, "--source", source)
+		if err != nil {
+			log.Printf("DEBUG Failed to get interface addresses from source '%s' for %s: %v", source, domainName, err)
+			continue
+		}
+
+		interfaces := parseDomIfAddr(result.Stdout)
+		if len(interfaces) == 0 {
+			continue
+		}
+		for i := range interfaces {
+			interfaces[i].Source = source
+			interfaces[i].Provisional = source != interfaceSourceAgent
+		}
+		return interfaces
+	}
+	return nil
+}
+
+// parseDomIfAddr parses "virsh domifaddr" output. Interfaces with several addresses
+// are printed on continuation lines whose name and MAC columns are "-".
+// Loopback interfaces and addresses are skipped.
+func parseDomIfAddr(output string) []contracts.GuestInterface {
+	var interfaces []contracts.GuestInterface
+	for i, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if i == 0 || strings.HasPrefix(strings.TrimSpace(line), "-----") {
+			continue // Skip header lines
+		}
+		parts := strings.Fields(line)
+		if len(parts) < 4 {
+			continue
+		}
+		name, mac, address := parts[0], parts[1], parts[3]
+
+		if name == "-" {
+			if len(interfaces) == 0 {
+				continue
+			}
+		} else {
+			interfaces = append(interfaces, contracts.GuestInterface{Name: name, MAC: strings.ToLower(mac)})
+		}
+		current := &interfaces[len(interfaces)-1]
+		if current.Name == "lo" || address == "N/A" || address == "-" {
+			continue
+		}
+		ip, _, _ := strings.Cut(address, "/")
+		if ip == "::1" || strings.HasPrefix(ip, "127.") {
+			continue
+		}
+		current.Addresses = append(current.Addresses, address)
+	}
+
+	// Drop the loopback interface and interfaces without an address
+	result := interfaces[:0]
+	for _, iface := range interfaces {
+		if iface.Name != "lo" && len(iface.Addresses) > 0 {
+			result = append(result, iface)
+		}
+	}
+	return result
+}
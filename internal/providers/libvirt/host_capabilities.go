@@ -0,0 +1,151 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"slices"
+)
+
+// domainCapabilities is the subset of virsh domcapabilities the provider checks
+type domainCapabilities struct {
+	VCPU struct {
+		Max int32 `xml:"max,attr"`
+	} `xml:"vcpu"`
+	CPU struct {
+		Modes []struct {
+			Name      string `xml:"name,attr"`
+			Supported string `xml:"supported,attr"`
+			Models    []struct {
+				Usable string `xml:"usable,attr"`
+				Name   string `xml:",chardata"`
+			} `xml:"model"`
+		} `xml:"mode"`
+	} `xml:"cpu"`
+	Devices struct {
+		Graphics struct {
+			Supported string `xml:"supported,attr"`
+			Enums     []struct {
+				Name   string   `xml:"name,attr"`
+				Values []string `xml:"value"`
+			} `xml:"enum"`
+		} `xml:"graphics"`
+	} `xml:"devices"`
+}
+
+// hostCapabilities is the subset of virsh capabilities the provider checks
+type hostCapabilities struct {
+	Host struct {
+		Cells []struct {
+			ID     int          `xml:"id,attr"`
+			Memory domainMemory `xml:"memory"`
+			CPUs   struct {
+				Num  int32 `xml:"num,attr"`
+				CPUs []struct {
+					ID int `xml:"id,attr"`
+				} `xml:"cpu"`
+			} `xml:"cpus"`
+		} `xml:"topology>cells>cell"`
+	} `xml:"host"`
+}
+
+// getDomainCapabilities reads what the host's QEMU and libvirt support for new domains
+func (v *VirshProvider) getDomainCapabilities(ctx context.Context) (*domainCapabilities, error) {
+	result, err := v.runVirshCommand(ctx, "domcapabilities")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read host domain capabilities: %w", err)
+	}
+	var caps domainCapabilities
+	if err := xml.Unmarshal([]byte(result.Stdout), &caps); err != nil {
+		return nil, fmt.Errorf("failed to parse host domain capabilities: %w", err)
+	}
+	return &caps, nil
+}
+
+// getHostCapabilities reads the CPU and memory topology of the host
+func (v *VirshProvider) getHostCapabilities(ctx context.Context) (*hostCapabilities, error) {
+	result, err := v.runVirshCommand(ctx, "capabilities")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read host capabilities: %w", err)
+	}
+	var caps hostCapabilities
+	if err := xml.Unmarshal([]byte(result.Stdout), &caps); err != nil {
+		return nil, fmt.Errorf("failed to parse host capabilities: %w", err)
+	}
+	return &caps, nil
+}
+
+// supportsGraphics reports whether the host can expose a display of the given type
+func (c *domainCapabilities) supportsGraphics(display string) bool {
+	if c.Devices.Graphics.Supported != "yes" {
+		return false
+	}
+	for _, enum := range c.Devices.Graphics.Enums {
+		if enum.Name == "type" && slices.Contains(enum.Values, display) {
+			return true
+		}
+	}
+	return false
+}
+
+// cpuModelUsable reports whether the host knows a custom CPU model and can run it
+func (c *domainCapabilities) cpuModelUsable(name string) (known, usable bool) {
+	for _, mode := range c.CPU.Modes {
+		if mode.Name != cpuModeCustom {
+			continue
+		}
+		for _, model := range mode.Models {
+			if model.Name == name {
+				return true, mode.Supported == "yes" && model.Usable != "no"
+			}
+		}
+	}
+	return false, false
+}
+
+// totals returns the logical CPUs and memory in MiB of all NUMA cells of the host
+func (c *hostCapabilities) totals() (cpus int32, memoryMiB int64) {
+	for _, cell := range c.Host.Cells {
+		cpus += cell.CPUs.Num
+		memoryMiB += cell.Memory.MiB()
+	}
+	return cpus, memoryMiB
+}
+
+// hasCPU reports whether the host has a logical CPU with the given ID
+func (c *hostCapabilities) hasCPU(id int) bool {
+	for _, cell := range c.Host.Cells {
+		for _, cpu := range cell.CPUs.CPUs {
+			if cpu.ID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasNUMANode reports whether the host has a NUMA cell with the given ID
+func (c *hostCapabilities) hasNUMANode(id int) bool {
+	for _, cell := range c.Host.Cells {
+		if cell.ID == id {
+			return true
+		}
+	}
+	return false
+}
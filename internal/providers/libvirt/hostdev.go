@@ -0,0 +1,306 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// hostDeviceTypePCI is the PCI host device type
+	hostDeviceTypePCI = "pci"
+
+	// pciDevicesDir lists the PCI devices of the host by address
+	pciDevicesDir = "/sys/bus/pci/devices"
+
+	// pciClassBridge is the class prefix of PCI bridges, which may stay with the host
+	// even when they share an IOMMU group with an assigned device
+	pciClassBridge = "0x0604"
+)
+
+// pciAddressPattern matches a PCI address, with or without the domain (0000:65:00.0 or 65:00.0)
+var pciAddressPattern = regexp.MustCompile(`^(?:([0-9a-f]{4}):)?([0-9a-f]{2}):([0-9a-f]{2})\.([0-7])$`)
+
+// vfioDrivers are the host drivers of devices already detached for assignment
+var vfioDrivers = []string{"", "vfio-pci", "pci-stub"}
+
+// pciAddress is a parsed PCI address (hexadecimal fields without 0x prefix)
+type pciAddress struct {
+	Domain, Bus, Slot, Function string
+}
+
+// parsePCIAddress parses a PCI address in sysfs notation
+func parsePCIAddress(address string) (pciAddress, error) {
+	m := pciAddressPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(address)))
+	if m == nil {
+		return pciAddress{}, fmt.Errorf("invalid PCI address %q (expected domain:bus:slot.function, e.g. 0000:65:00.0)", address)
+	}
+	domain := m[1]
+	if domain == "" {
+		domain = "0000"
+	}
+	return pciAddress{Domain: domain, Bus: m[2], Slot: m[3], Function: m[4]}, nil
+}
+
+// String returns the address in sysfs notation (0000:65:00.0)
+func (a pciAddress) String() string {
+	return fmt.Sprintf("%s:%s:%s.%s", a.Domain, a.Bus, a.Slot, a.Function)
+}
+
+// nodeDevName returns the libvirt node device name of the address (pci_0000_65_00_0)
+func (a pciAddress) nodeDevName() string {
+	return fmt.Sprintf("pci_%s_%s_%s_%s", a.Domain, a.Bus, a.Slot, a.Function)
+}
+
+// validateHostDevices checks the type and address of requested host devices
+func validateHostDevices(devices []contracts.HostDevice) error {
+	seen := make(map[string]bool, len(devices))
+	for _, device := range devices {
+		var key string
+		switch strings.ToLower(device.Type) {
+		case "", hostDeviceTypePCI:
+			if device.VendorID != "" || device.ProductID != "" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("PCI device %s: vendor and product IDs are only supported for usb devices", device.Address), nil)
+			}
+			address, err := parsePCIAddress(device.Address)
+			if err != nil {
+				return contracts.NewInvalidSpecError(err.Error(), nil)
+			}
+			key = address.String()
+		case hostDeviceTypeUSB:
+			usb, err := parseUSBDevice(device)
+			if err != nil {
+				return contracts.NewInvalidSpecError(err.Error(), nil)
+			}
+			key = usb.String()
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported host device type %q (supported: pci, usb)", device.Type), nil)
+		}
+		if seen[key] {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("host device %s is requested twice", key), nil)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// isPCIHostDevice reports whether a requested host device is a PCI device
+func isPCIHostDevice(device contracts.HostDevice) bool {
+	t := strings.ToLower(device.Type)
+	return t == "" || t == hostDeviceTypePCI
+}
+
+// pciHostDevices returns the parsed addresses of the PCI host devices of a request
+func pciHostDevices(devices []contracts.HostDevice) []pciAddress {
+	var addresses []pciAddress
+	for _, device := range devices {
+		if !isPCIHostDevice(device) {
+			continue
+		}
+		if address, err := parsePCIAddress(device.Address); err == nil {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// checkHostDevicesAvailable verifies that every requested device exists on the host. PCI
+// devices must also not be assigned to another domain, and the rest of their IOMMU group
+// must be assignable with them.
+func (p *Provider) checkHostDevicesAvailable(ctx context.Context, name string, devices []contracts.HostDevice) error {
+	if err := p.checkUSBDevicesAvailable(ctx, usbHostDevices(devices)); err != nil {
+		return err
+	}
+
+	addresses := pciHostDevices(devices)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	// VFIO assignment needs hardware virtualization
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-c", "/dev/kvm"); err != nil {
+		return contracts.NewNotSupportedError("PCI passthrough requires KVM, but /dev/kvm is not available on the libvirt host")
+	}
+
+	requested := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		requested[address.String()] = true
+	}
+
+	owners, err := p.assignedHostDevices(ctx)
+	if err != nil {
+		return contracts.NewRetryableError("failed to list assigned host devices", err)
+	}
+
+	for _, address := range addresses {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-d", pciDevicesDir+"/"+address.String()); err != nil {
+			return contracts.NewNotFoundError(fmt.Sprintf("PCI device %s not found on the libvirt host", address), err)
+		}
+		if owner, ok := owners[address.String()]; ok && owner != name {
+			return contracts.NewConflictError(fmt.Sprintf("PCI device %s is already assigned to domain %s", address, owner), nil)
+		}
+		if err := p.checkIOMMUGroup(ctx, address, requested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIOMMUGroup rejects a device whose IOMMU group contains devices that are neither
+// requested, bridges, nor already detached from their host driver; VFIO can only assign
+// complete groups
+func (p *Provider) checkIOMMUGroup(ctx context.Context, address pciAddress, requested map[string]bool) error {
+	// The address was validated against pciAddressPattern, so it is safe to embed
+	script := fmt.Sprintf(`for d in %s/%s/iommu_group/devices/*; do drv=$(readlink "$d/driver"); echo "${d##*/} $(cat "$d/class") ${drv##*/}"; done`,
+		pciDevicesDir, address)
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "sh", "-c", p.virshProvider.quoteRemoteArg(script))
+	if err != nil || strings.TrimSpace(result.Stdout) == "" {
+		return contracts.NewNotSupportedError(fmt.Sprintf(
+			"PCI device %s has no IOMMU group; enable the IOMMU on the host (intel_iommu=on or amd_iommu=on)", address))
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || requested[fields[0]] {
+			continue
+		}
+		driver := ""
+		if len(fields) > 2 {
+			driver = fields[2]
+		}
+		if strings.HasPrefix(fields[1], pciClassBridge) || slices.Contains(vfioDrivers, driver) {
+			continue
+		}
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"PCI device %s shares its IOMMU group with %s (driver %s), which must be passed through as well", address, fields[0], driver), nil)
+	}
+	return nil
+}
+
+// assignedHostDevices maps the PCI addresses assigned to defined domains to the domain names
+func (p *Provider) assignedHostDevices(ctx context.Context) (map[string]string, error) {
+	result, err := p.virshProvider.runVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]string)
+	for _, name := range strings.Split(result.Stdout, "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		domain, err := p.virshProvider.getDomainXML(ctx, name)
+		if err != nil {
+			log.Printf("WARN Failed to inspect host devices of domain %s: %v", name, err)
+			continue
+		}
+		for _, address := range domainPCIDevices(domain) {
+			owners[address.String()] = name
+		}
+	}
+	return owners, nil
+}
+
+// hostDevicesXML renders managed <hostdev> entries; libvirt detaches the devices from their
+// host drivers when the domain starts and reattaches them when it stops
+func hostDevicesXML(devices []contracts.HostDevice) string {
+	var b strings.Builder
+	for _, address := range pciHostDevices(devices) {
+		fmt.Fprintf(&b, `
+    <hostdev mode='subsystem' type='pci' managed='yes'>
+      <source>
+        <address domain='0x%s' bus='0x%s' slot='0x%s' function='0x%s'/>
+      </source>
+    </hostdev>`, address.Domain, address.Bus, address.Slot, address.Function)
+	}
+	for _, usb := range usbHostDevices(devices) {
+		b.WriteString("\n    " + strings.ReplaceAll(usb.hostdevXML(), "\n", "\n    "))
+	}
+	return b.String()
+}
+
+// reattachHostDevices returns the PCI devices of a deleted domain to their host drivers.
+// libvirt does this when a managed device's domain stops, but not when the device was
+// detached manually or the domain crashed.
+func (p *Provider) reattachHostDevices(ctx context.Context, domain *domainXML) {
+	for _, hostdev := range domain.Devices.HostDevs {
+		address, ok := hostdev.pciAddress()
+		if !ok {
+			continue
+		}
+		if _, err := p.virshProvider.runVirshCommand(ctx, "nodedev-reattach", address.nodeDevName()); err != nil {
+			log.Printf("WARN Failed to reattach PCI device %s to the host: %v", address, err)
+			continue
+		}
+		log.Printf("INFO Reattached PCI device %s of %s to the host", address, domain.Name)
+	}
+}
+
+// pciAddress returns the host address of a PCI host device
+func (h domainHostdev) pciAddress() (pciAddress, bool) {
+	if h.Type != hostDeviceTypePCI {
+		return pciAddress{}, false
+	}
+	hex := func(value string, width int) string {
+		n, _ := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(value), "0x"), 16, 16)
+		return fmt.Sprintf("%0*x", width, n)
+	}
+	address := h.Source.Address
+	return pciAddress{
+		Domain:   hex(address.Domain, 4),
+		Bus:      hex(address.Bus, 2),
+		Slot:     hex(address.Slot, 2),
+		Function: hex(address.Function, 1),
+	}, true
+}
+
+// domainPCIDevices returns the host addresses of the PCI devices assigned to a domain
+func domainPCIDevices(domain *domainXML) []pciAddress {
+	var addresses []pciAddress
+	for _, hostdev := range domain.Devices.HostDevs {
+		if address, ok := hostdev.pciAddress(); ok {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// samePCIDevices reports whether two lists hold the same addresses, in any order
+func samePCIDevices(a, b []pciAddress) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[pciAddress]bool, len(a))
+	for _, address := range a {
+		set[address] = true
+	}
+	for _, address := range b {
+		if !set[address] {
+			return false
+		}
+	}
+	return true
+}
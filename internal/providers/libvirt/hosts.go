@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	v1beta1 "github.com/projectbeskar/virtrigaud/api/infra.virtrigaud.io/v1beta1"
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// hostNames returns the names of the configured hosts in a stable order
+func (p *Provider) hostNames() []string {
+	names := make([]string, 0, len(p.options.Hosts))
+	for name := range p.options.Hosts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hostProvider returns the provider of a named host of Options.Hosts, connecting on first
+// use. Host providers share the options, task tracker and operation limiter of p.
+func (p *Provider) hostProvider(ctx context.Context, host string) (*Provider, error) {
+	uri, ok := p.options.Hosts[host]
+	if !ok {
+		return nil, contracts.NewInvalidSpecError(fmt.Sprintf("unknown host %q (configured: %s)",
+			host, strings.Join(p.hostNames(), ", ")), nil)
+	}
+
+	p.hostsMu.Lock()
+	defer p.hostsMu.Unlock()
+	if hp, ok := p.hostConns[host]; ok {
+		return hp, nil
+	}
+
+	storagePool := ""
+	var credentialFiles CredentialFiles
+	if p.virshProvider != nil && p.virshProvider.config != nil {
+		storagePool = p.virshProvider.config.Spec.StoragePool
+		credentialFiles = p.virshProvider.config.CredentialFiles
+	}
+	hp := &Provider{
+		config:      &v1beta1.Provider{Spec: v1beta1.ProviderSpec{Endpoint: uri}},
+		credentials: p.credentials,
+		tasks:       p.tasks,
+		options:     p.options,
+		limiter:     p.limiter,
+		virshProvider: NewVirshProvider(&ProviderConfig{
+			Spec:            ProviderSpec{Endpoint: uri, StoragePool: storagePool},
+			Namespace:       "default",
+			CredentialFiles: credentialFiles,
+		}),
+	}
+	hp.options.Hosts = nil
+	hp.host = host
+	hp.started.Store(p.started.Load())
+	if err := hp.Connect(ctx); err != nil {
+		return nil, contracts.NewRetryableError(fmt.Sprintf("host %s is not reachable", host), err)
+	}
+	log.Printf("INFO Connected to host %s at %s", host, uri)
+
+	if p.hostConns == nil {
+		p.hostConns = make(map[string]*Provider)
+	}
+	p.hostConns[host] = hp
+	return hp, nil
+}
+
+// providerForVM returns the provider of the host a VM is on. Without named hosts that is p;
+// otherwise the host remembered from an earlier call is checked first, then the default
+// connection and then each named host. A VM found nowhere is left to p.
+func (p *Provider) providerForVM(ctx context.Context, vmID string) *Provider {
+	if len(p.options.Hosts) == 0 || vmID == "" {
+		return p
+	}
+
+	if host, ok := p.vmHosts.Load(vmID); ok {
+		if hp, err := p.hostProvider(ctx, host.(string)); err == nil && hp.virshProvider.hasDomain(ctx, vmID) {
+			return hp
+		}
+		p.vmHosts.Delete(vmID)
+	}
+	if p.virshProvider != nil && p.virshProvider.hasDomain(ctx, vmID) {
+		return p
+	}
+	for _, host := range p.hostNames() {
+		hp, err := p.hostProvider(ctx, host)
+		if err != nil {
+			log.Printf("WARN Looking up VM %s: %v", vmID, err)
+			continue
+		}
+		if hp.virshProvider.hasDomain(ctx, vmID) {
+			p.vmHosts.Store(vmID, host)
+			return hp
+		}
+	}
+	return p
+}
+
+// hasDomain reports whether the connection has a domain with the given name
+func (v *VirshProvider) hasDomain(ctx context.Context, name string) bool {
+	_, err := v.runVirshCommand(ctx, "domuuid", name)
+	return err == nil
+}
+
+// hostName returns the hostname of the hypervisor behind the connection
+func (v *VirshProvider) hostName(ctx context.Context) string {
+	result, err := v.runVirshCommand(ctx, "hostname")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(result.Stdout)
+}
+
+// hostLabel names the host VMs of this provider are created on: the name of a named host,
+// or the hostname of the default connection
+func (p *Provider) hostLabel(ctx context.Context) string {
+	if p.host != "" {
+		return p.host
+	}
+	return p.virshProvider.hostName(ctx)
+}
+
+// placementHost returns the host a create request asks for, if any
+func placementHost(req contracts.CreateRequest) string {
+	if req.Placement == nil {
+		return ""
+	}
+	return strings.TrimSpace(req.Placement.Host)
+}
+
+// resolvePlacementHost checks the placement host of a create request. A named host of
+// Options.Hosts is returned for createOnHost; the hostname of the default connection (or no
+// host at all) yields "", and any other host is rejected.
+func (p *Provider) resolvePlacementHost(ctx context.Context, req contracts.CreateRequest) (string, error) {
+	host := placementHost(req)
+	if host == "" || host == p.host {
+		return "", nil
+	}
+	if _, ok := p.options.Hosts[host]; ok {
+		return host, nil
+	}
+	if defaultHost := p.virshProvider.hostName(ctx); strings.EqualFold(host, defaultHost) {
+		return "", nil
+	}
+	return "", contracts.NewInvalidSpecError(fmt.Sprintf("host %q is not managed by this provider", host), nil)
+}
+
+// createOnHost creates a VM on the named host of its placement, after checking that the host
+// is reachable and has the memory and CPUs the VM needs
+func (p *Provider) createOnHost(ctx context.Context, host string, req contracts.CreateRequest) (contracts.CreateResponse, error) {
+	hp, err := p.hostProvider(ctx, host)
+	if err != nil {
+		return contracts.CreateResponse{}, err
+	}
+
+	capacity, err := hp.GetCapacity(ctx)
+	if err != nil {
+		return contracts.CreateResponse{}, contracts.NewRetryableError(fmt.Sprintf("failed to get capacity of host %s", host), err)
+	}
+	if memory := int64(req.Class.MemoryMiB) << 20; memory > 0 && capacity.FreeMemoryBytes > 0 && memory > capacity.FreeMemoryBytes {
+		return contracts.CreateResponse{}, contracts.NewQuotaExceededError(fmt.Sprintf(
+			"host %s has %d MiB of free memory, %d MiB requested", host, capacity.FreeMemoryBytes>>20, req.Class.MemoryMiB), nil)
+	}
+	if cpus := int64(req.Class.CPU); cpus > 0 && capacity.CPUs > 0 && cpus > capacity.CPUs {
+		return contracts.CreateResponse{}, contracts.NewQuotaExceededError(fmt.Sprintf(
+			"host %s has %d CPUs, %d vCPUs requested", host, capacity.CPUs, cpus), nil)
+	}
+
+	log.Printf("INFO Creating VM %s on host %s", req.Name, host)
+	resp, err := hp.Create(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	p.vmHosts.Store(resp.ID, host)
+	return resp, nil
+}
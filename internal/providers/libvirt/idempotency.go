@@ -0,0 +1,160 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// findExistingDomain returns the domain a create request refers to, looked up by name and
+// then by the client-supplied UUID, or nil when neither exists
+func (p *Provider) findExistingDomain(ctx context.Context, req contracts.CreateRequest) (*domainXML, error) {
+	domains, err := p.virshProvider.listDomains(ctx)
+	if err != nil {
+		return nil, contracts.NewRetryableError("failed to list existing domains", err)
+	}
+
+	name := ""
+	for _, domain := range domains {
+		if domain.Name == req.Name {
+			name = domain.Name
+			break
+		}
+	}
+	if name == "" && req.UUID != "" {
+		result, err := p.virshProvider.runVirshCommand(ctx, "domname", req.UUID)
+		if err == nil {
+			name = strings.TrimSpace(result.Stdout)
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, name)
+	if err != nil {
+		return nil, contracts.NewRetryableError(fmt.Sprintf("failed to read definition of existing domain %s", name), err)
+	}
+	return domain, nil
+}
+
+// createSpecDiff compares an existing domain with a create request and describes every
+// material difference; an empty result means Create can return the domain unchanged
+func createSpecDiff(domain *domainXML, req contracts.CreateRequest) []string {
+	return append(immutableSpecDiff(domain, req), mutableSpecDiff(domain, req)...)
+}
+
+// immutableSpecDiff describes differences that cannot be changed without recreating the VM
+func immutableSpecDiff(domain *domainXML, req contracts.CreateRequest) []string {
+	var diffs []string
+
+	if domain.Name != req.Name {
+		diffs = append(diffs, fmt.Sprintf("UUID %s belongs to domain %s", req.UUID, domain.Name))
+	}
+	if req.UUID != "" && !strings.EqualFold(domain.UUID, req.UUID) {
+		diffs = append(diffs, fmt.Sprintf("UUID is %s, requested %s", domain.UUID, req.UUID))
+	}
+	if firmware, err := vmspec.FirmwareType(req.Class); err == nil && (firmware == firmwareBIOS) != (domain.firmware() == firmwareBIOS) {
+		diffs = append(diffs, fmt.Sprintf("firmware differs from requested %s", firmware))
+	}
+	if have, want := hasEmulatedTPM(domain), vmspec.TPMEnabled(req.Class); have != want {
+		diffs = append(diffs, fmt.Sprintf("TPM present is %t, requested %t", have, want))
+	}
+	if have, want := domainPCIDevices(domain), pciHostDevices(req.HostDevices); !samePCIDevices(have, want) {
+		diffs = append(diffs, fmt.Sprintf("PCI host devices are %v, requested %v", have, want))
+	}
+	return diffs
+}
+
+// mutableSpecDiff describes differences that Reconfigure and device reconciliation can converge
+func mutableSpecDiff(domain *domainXML, req contracts.CreateRequest) []string {
+	diffs := append(resourceSpecDiff(domain, req), networkSpecDiff(domain, req.Networks)...)
+	return append(diffs, usbSpecDiff(domain, req.HostDevices)...)
+}
+
+// resourceSpecDiff describes how the vCPUs and memory of a domain differ from the request
+func resourceSpecDiff(domain *domainXML, req contracts.CreateRequest) []string {
+	var diffs []string
+
+	if want := vmspec.VCPUCount(req.Class); domain.VCPU != want {
+		diffs = append(diffs, fmt.Sprintf("vCPUs are %d, requested %d", domain.VCPU, want))
+	}
+	if have, want := domain.Memory.MiB(), vmspec.MemoryMiB(req.Class); have != want {
+		diffs = append(diffs, fmt.Sprintf("memory is %d MiB, requested %d MiB", have, want))
+	}
+	return diffs
+}
+
+// networkSpecDiff describes how the interfaces of a domain differ from the requested attachments
+func networkSpecDiff(domain *domainXML, networks []contracts.NetworkAttachment) []string {
+	if len(networks) == 0 {
+		return nil
+	}
+
+	var diffs []string
+	if have, want := len(domain.Devices.Interfaces), len(networks); have != want {
+		diffs = append(diffs, fmt.Sprintf("%d network interfaces, requested %d", have, want))
+	}
+	attached := make(map[string]bool, len(domain.Devices.Interfaces))
+	for _, iface := range domain.Devices.Interfaces {
+		attached[strings.ToLower(iface.MAC.Address)] = true
+	}
+	for _, network := range networks {
+		mac, err := net.ParseMAC(network.MacAddress)
+		if err == nil && !attached[mac.String()] {
+			diffs = append(diffs, fmt.Sprintf("no interface with MAC %s", mac))
+		}
+	}
+	return diffs
+}
+
+// usbSpecDiff describes how the USB host devices of a domain differ from the request
+func usbSpecDiff(domain *domainXML, devices []contracts.HostDevice) []string {
+	attached := make(map[usbDevice]bool)
+	for _, hostdev := range domain.Devices.HostDevs {
+		if usb, ok := hostdev.usbDevice(); ok {
+			attached[usb] = true
+		}
+	}
+
+	var diffs []string
+	requested := make(map[usbDevice]bool)
+	for _, usb := range usbHostDevices(devices) {
+		requested[usb] = true
+		if !attached[usb] {
+			diffs = append(diffs, fmt.Sprintf("USB device %s not attached", usb))
+		}
+	}
+	for usb := range attached {
+		if !requested[usb] {
+			diffs = append(diffs, fmt.Sprintf("USB device %s attached but not requested", usb))
+		}
+	}
+	return diffs
+}
+
+// existingDomainConflict returns the error for a create request whose VM exists with a different spec
+func existingDomainConflict(domain *domainXML, diffs []string) error {
+	return contracts.NewConflictError(fmt.Sprintf(
+		"VM %s already exists with a different spec: %s", domain.Name, strings.Join(diffs, "; ")), nil)
+}
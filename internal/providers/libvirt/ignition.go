@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// userDataTypeIgnition marks user data as an Ignition config instead of cloud-init
+	userDataTypeIgnition = "ignition"
+
+	// ignitionFwCfgKey is the fw_cfg entry Fedora CoreOS and Flatcar read their config from
+	ignitionFwCfgKey = "opt/org.fedoraproject.coreos.config"
+
+	// ignitionVolumeSuffix names the Ignition config volume of a domain (<name>-ignition.ign)
+	ignitionVolumeSuffix = "-ignition.ign"
+
+	// ignitionStagingDir holds Ignition configs on the host until they are imported
+	ignitionStagingDir = "/tmp/virtrigaud-ignition"
+)
+
+// isIgnitionUserData reports whether the user data carries an Ignition config
+func isIgnitionUserData(userData *contracts.UserData) bool {
+	return userData != nil && strings.EqualFold(userData.Type, userDataTypeIgnition)
+}
+
+// validateIgnitionConfig checks that data is a JSON Ignition config with a version
+func validateIgnitionConfig(data string) error {
+	var config struct {
+		Ignition *struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		return contracts.NewInvalidSpecError("ignition config is not valid JSON", err)
+	}
+	if config.Ignition == nil || config.Ignition.Version == "" {
+		return contracts.NewInvalidSpecError("ignition config is missing ignition.version", nil)
+	}
+	return nil
+}
+
+// prepareIgnition stores an Ignition config in the image pool and returns its path
+func (p *Provider) prepareIgnition(ctx context.Context, domainName, data string) (string, error) {
+	log.Printf("INFO Preparing Ignition config for domain: %s", domainName)
+
+	cloudInitProvider := NewCloudInitProvider(p.virshProvider)
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "mkdir", "-p", ignitionStagingDir); err != nil {
+		return "", fmt.Errorf("failed to create remote ignition directory: %w", err)
+	}
+
+	stagingPath := filepath.Join(ignitionStagingDir, domainName+".ign")
+	defer func() {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", stagingPath); err != nil {
+			log.Printf("WARN Failed to remove ignition staging file %s: %v", stagingPath, err)
+		}
+	}()
+
+	if err := cloudInitProvider.writeRemoteFile(ctx, stagingPath, data); err != nil {
+		return "", err
+	}
+
+	path, err := cloudInitProvider.importFileToPool(ctx, stagingPath, domainName+ignitionVolumeSuffix)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("INFO Stored Ignition config for domain %s at %s", domainName, path)
+	return path, nil
+}
+
+// deleteIgnitionVolume removes the Ignition config volume of a domain, if any
+func (p *Provider) deleteIgnitionVolume(ctx context.Context, domainName string) error {
+	volumeName := domainName + ignitionVolumeSuffix
+	if _, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", volumeName, "--pool", p.virshProvider.storagePool()); err != nil {
+		return fmt.Errorf("failed to delete ignition volume %s: %w", volumeName, err)
+	}
+	return nil
+}
+
+// ignitionSysinfoXML exposes the Ignition config to the guest through fw_cfg
+func ignitionSysinfoXML(path string) string {
+	return fmt.Sprintf(`  <sysinfo type='fwcfg'>
+    <entry name='%s' file='%s'/>
+  </sysinfo>
+`, ignitionFwCfgKey, path)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// sourceImageFormats are the image formats qemu-img can convert volumes from
+var sourceImageFormats = []string{diskFormatQcow2, diskFormatRaw, "vmdk", "vdi", "vhdx", "vpc"}
+
+// convertProgressPattern matches the progress qemu-img convert -p reports, e.g. "(42.00/100%)"
+var convertProgressPattern = regexp.MustCompile(`\((\d+(?:\.\d+)?)/100%\)`)
+
+// convertProgressStep is how many percent of progress are reported in one log line
+const convertProgressStep = 10
+
+// validateSourceFormat rejects image formats qemu-img is not expected to convert
+func validateSourceFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	for _, supported := range sourceImageFormats {
+		if strings.EqualFold(format, supported) {
+			return nil
+		}
+	}
+	return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported source image format %q (supported: %s)",
+		format, strings.Join(sourceImageFormats, ", ")), nil)
+}
+
+// needsConversion reports whether the image of a disk has to be converted rather than
+// copied as qcow2. Local images without a declared format are inspected.
+func (p *Provider) needsConversion(ctx context.Context, plan *diskPlan) bool {
+	isURL := strings.HasPrefix(plan.Source, "http://") || strings.HasPrefix(plan.Source, "https://")
+	if !isURL && !strings.HasPrefix(plan.Source, "/") {
+		return false
+	}
+	if plan.SourceFormat == "" && !isURL {
+		if info, err := p.imageInfo(ctx, plan.Source); err == nil {
+			plan.SourceFormat = info.Format
+		}
+	}
+	if policy := plan.Spec.AllocationPolicy; policy == allocationThick || policy == allocationFalloc {
+		return true
+	}
+	return (plan.SourceFormat != "" && plan.SourceFormat != diskFormatQcow2) || plan.Format != diskFormatQcow2
+}
+
+// createConvertedVolume creates the volume of a disk by converting its source image from
+// the source format to the format of the disk with qemu-img, and returns its path. The
+// conversion is tracked as operation convertTaskID(plan), which WatchOperation follows
+// while Create runs.
+func (p *Provider) createConvertedVolume(ctx context.Context, storageProvider *StorageProvider, plan diskPlan) (string, error) {
+	if err := storageProvider.ensurePoolActive(ctx, plan.Pool.Name); err != nil {
+		return "", fmt.Errorf("failed to ensure pool is active: %w", err)
+	}
+	poolInfo, err := storageProvider.GetPoolInfo(ctx, plan.Pool.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pool info: %w", err)
+	}
+
+	source := plan.Source
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		source = filepath.Join("/tmp", plan.VolumeName+"-temp.img")
+		log.Printf("INFO Downloading image %s to %s for conversion", plan.Source, source)
+		defer func() {
+			_, _ = p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", source)
+		}()
+		if result, err := p.virshProvider.runVirshCommand(ctx, "!", "wget", "-O", source, plan.Source); err != nil {
+			return "", fmt.Errorf("failed to download image: %w, output: %s", err, result.Stderr)
+		}
+	}
+
+	// The virtual size is what qemu-img reports progress against
+	sourceFormat := plan.SourceFormat
+	info, err := p.imageInfo(ctx, source)
+	if err != nil && sourceFormat == "" {
+		return "", err
+	}
+	if sourceFormat == "" {
+		sourceFormat = info.Format
+	}
+	if err := validateSourceFormat(sourceFormat); err != nil {
+		return "", err
+	}
+
+	targetPath := plannedDiskPath(poolInfo.Path, plan)
+	conversion := imageConversion{
+		TaskID:        convertTaskID(plan),
+		SourceFormat:  sourceFormat,
+		TargetFormat:  plan.Format,
+		Preallocation: qemuPreallocation(plan.Spec.AllocationPolicy),
+		Source:        source,
+		Target:        targetPath,
+		SizeBytes:     info.VirtualSize,
+	}
+	if err := p.convertImage(ctx, conversion); err != nil {
+		return "", err
+	}
+
+	if plan.SizeGiB > 0 {
+		sizeSpec := fmt.Sprintf("%dG", plan.SizeGiB)
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "qemu-img", "resize", "-f", plan.Format, targetPath, sizeSpec); err != nil {
+			log.Printf("WARN Failed to resize image (may already be correct size): %v", err)
+		}
+	}
+
+	log.Printf("INFO Setting proper ownership and permissions for %s", targetPath)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "chown", "libvirt-qemu:kvm", targetPath); err != nil {
+		log.Printf("WARN Failed to set ownership: %v", err)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "chmod", "777", targetPath); err != nil {
+		log.Printf("WARN Failed to set permissions: %v", err)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "sudo", "restorecon", targetPath); err != nil {
+		log.Printf("WARN Failed to restore SELinux context (may not be using SELinux): %v", err)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "pool-refresh", plan.Pool.Name); err != nil {
+		log.Printf("WARN Failed to refresh storage pool: %v", err)
+	}
+	return targetPath, nil
+}
+
+// imageConversion describes one qemu-img convert run
+type imageConversion struct {
+	// TaskID is the operation the conversion reports its progress on
+	TaskID       string
+	SourceFormat string
+	TargetFormat string
+	// Preallocation is the qemu-img preallocation mode (off, falloc, full), empty for the default
+	Preallocation string
+	Source        string
+	Target        string
+	// SizeBytes is the virtual size of the source, 0 if unknown
+	SizeBytes int64
+}
+
+// convertTaskID names the operation tracking the conversion of a disk image. It is derived
+// from the volume name, so callers can watch it before Create returns.
+func convertTaskID(plan diskPlan) string {
+	return "task-convert-" + plan.VolumeName
+}
+
+// convertImage runs qemu-img convert, reporting its progress on the conversion task as the
+// copy proceeds. The copy can take a long time, so it runs on its own connection rather
+// than holding a pool slot.
+func (p *Provider) convertImage(ctx context.Context, c imageConversion) (err error) {
+	log.Printf("INFO Converting image %s (%s) to %s (%s)", c.Source, c.SourceFormat, c.Target, c.TargetFormat)
+
+	if p.tasks != nil {
+		p.tasks.start(c.TaskID, fmt.Sprintf("Converting %s to %s", filepath.Base(c.Source), c.TargetFormat))
+		defer func() {
+			message := fmt.Sprintf("Converted %s to %s", filepath.Base(c.Source), c.TargetFormat)
+			if err != nil {
+				message = "Image conversion failed"
+			}
+			p.tasks.finish(c.TaskID, err, message)
+		}()
+	}
+
+	pool := getConnPool(p.virshProvider.uri)
+	conn := pool.streamConn()
+	defer pool.close(conn)
+
+	args := []string{"!", "qemu-img", "convert", "-p", "-f", c.SourceFormat, "-O", c.TargetFormat}
+	if c.Preallocation != "" {
+		args = append(args, "-o", "preallocation="+c.Preallocation)
+	}
+	cmd, _, err := p.virshProvider.buildCommand(ctx, pool, conn, append(args, c.Source, c.Target)...)
+	if err != nil {
+		return err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start image conversion: %w", err)
+	}
+
+	logged, tracked := 0, -1
+	scanner := bufio.NewScanner(stdout)
+	scanner.Split(scanProgressLines)
+	for scanner.Scan() {
+		match := convertProgressPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		percent, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			continue
+		}
+		if p.tasks != nil && c.SizeBytes > 0 && int(percent) > tracked {
+			tracked = int(percent)
+			p.tasks.updateBytes(c.TaskID, int64(percent/100*float64(c.SizeBytes)), c.SizeBytes,
+				fmt.Sprintf("Converting %s (%d%%)", filepath.Base(c.Source), tracked))
+		}
+		if step := int(percent) / convertProgressStep * convertProgressStep; step > logged {
+			logged = step
+			log.Printf("INFO Converting image to %s: %d%%", c.Target, step)
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("failed to convert image %s: %w, output: %s", c.Source, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// scanProgressLines splits progress output on carriage returns as well as newlines
+func scanProgressLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// sourceImageSize returns the virtual size of a local source image, which bounds the
+// space its converted copy takes; other sources and unreadable images yield 0
+func (p *Provider) sourceImageSize(ctx context.Context, plan diskPlan) int64 {
+	if !strings.HasPrefix(plan.Source, "/") {
+		return 0
+	}
+	info, err := p.imageInfo(ctx, plan.Source)
+	if err != nil {
+		return 0
+	}
+	return info.VirtualSize
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertImageReportsProgressWithoutHoldingAPoolSlot(t *testing.T) {
+	// The fake qemu-img reports half of the copy, then waits for $CONVERT_GATE
+	bin := t.TempDir()
+	gate := filepath.Join(bin, "gate")
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "qemu-img"), []byte(`#!/bin/sh
+printf '    (50.00/100%%)\r'
+while [ ! -e "$CONVERT_GATE" ]; do sleep 0.01; done
+printf '    (100.00/100%%)\r'
+`), 0o755))
+	t.Setenv("CONVERT_GATE", gate)
+	t.Setenv("TMPDIR", t.TempDir())
+	p, _ := newScriptedVirshProvider(t, "#!/bin/sh\nexit 0\n")
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	p.tasks = newTaskTracker()
+	t.Cleanup(func() {
+		poolsMu.Lock()
+		delete(pools, p.virshProvider.uri)
+		poolsMu.Unlock()
+	})
+
+	conversion := imageConversion{
+		TaskID:       convertTaskID(diskPlan{VolumeName: "vm1-root"}),
+		SourceFormat: "vmdk",
+		TargetFormat: "qcow2",
+		Source:       "/images/vm1.vmdk",
+		Target:       "/pool/vm1-root.qcow2",
+		SizeBytes:    1000,
+	}
+	done := make(chan error, 1)
+	go func() { done <- p.convertImage(context.Background(), conversion) }()
+
+	require.Eventually(t, func() bool {
+		task, ok := p.tasks.get("task-convert-vm1-root")
+		return ok && task.ProcessedBytes == 500
+	}, 5*time.Second, 10*time.Millisecond)
+	task, _ := p.tasks.get("task-convert-vm1-root")
+	assert.Equal(t, int64(1000), task.TotalBytes)
+	assert.Equal(t, int32(50), task.Progress)
+
+	pool := getConnPool(p.virshProvider.uri)
+	pool.mu.Lock()
+	inUse := pool.inUse
+	pool.mu.Unlock()
+	assert.Zero(t, inUse, "the conversion must not hold a pool slot")
+
+	require.NoError(t, os.WriteFile(gate, nil, 0o644))
+	require.NoError(t, <-done)
+	task, _ = p.tasks.get("task-convert-vm1-root")
+	assert.True(t, task.Done)
+	assert.Equal(t, int32(100), task.Progress)
+}
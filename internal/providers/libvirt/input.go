@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Input device types and buses a VM can be given
+const (
+	inputTypeTablet   = vmspec.InputTypeTablet
+	inputTypeKeyboard = vmspec.InputTypeKeyboard
+	inputTypeMouse    = vmspec.InputTypeMouse
+
+	inputBusUSB    = vmspec.InputBusUSB
+	inputBusVirtio = vmspec.InputBusVirtio
+
+	// inputBusPS2 carries the mouse and keyboard libvirt adds to every x86 domain
+	inputBusPS2 = "ps2"
+)
+
+// domainInput describes an input device of a domain
+type domainInput struct {
+	Type string `xml:"type,attr"`
+	Bus  string `xml:"bus,attr"`
+}
+
+// inputXML renders the <input> elements of a request
+func inputXML(inputs []contracts.InputDevice, graphics *contracts.GraphicsConfig) string {
+	var b strings.Builder
+	for _, input := range vmspec.InputDevices(inputs, graphics) {
+		bus := input.Bus
+		if bus == "" {
+			bus = inputBusUSB
+		}
+		fmt.Fprintf(&b, "\n    <input type='%s' bus='%s'/>", input.Type, bus)
+	}
+	return b.String()
+}
+
+// contractInputs describes the input devices of a domain as a create request setting; the
+// implicit PS/2 mouse and keyboard are left out
+func contractInputs(domain *domainXML) []contracts.InputDevice {
+	var inputs []contracts.InputDevice
+	for _, input := range domain.Devices.Inputs {
+		if input.Bus == inputBusPS2 {
+			continue
+		}
+		inputs = append(inputs, contracts.InputDevice{Type: input.Type, Bus: input.Bus})
+	}
+	if inputs == nil && len(domain.Devices.Graphics) > 0 {
+		// The domain has a display but no tablet; an empty list keeps it that way
+		return []contracts.InputDevice{}
+	}
+	return inputs
+}
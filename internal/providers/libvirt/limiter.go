@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+
+	"golang.org/x/sync/semaphore"
+
+	"github.com/projectbeskar/virtrigaud/internal/obs/metrics"
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Weights of the operations sharing the concurrency limit; disk copies and
+// migrations load the host more than defining a domain
+const (
+	createOpWeight      = 1
+	linkedCloneOpWeight = 1
+	fullCloneOpWeight   = 2
+	migrateOpWeight     = 2
+)
+
+// opLimiter bounds the number of expensive operations running against the hypervisor.
+// Operations beyond the limit wait in a bounded queue; once it is full they are rejected
+// with a rate limit error so callers back off. A nil limiter does not limit anything.
+type opLimiter struct {
+	sem      *semaphore.Weighted
+	capacity int64
+	maxQueue int64
+	inflight atomic.Int64
+	queued   atomic.Int64
+	metrics  *metrics.ConcurrencyMetrics
+}
+
+// newOpLimiter returns a limiter allowing maxConcurrent weight units at once with up to
+// maxQueued waiting operations, or nil when maxConcurrent is not positive
+func newOpLimiter(maxConcurrent, maxQueued int) *opLimiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+	if maxQueued < 0 {
+		maxQueued = 0
+	}
+	return &opLimiter{
+		sem:      semaphore.NewWeighted(int64(maxConcurrent)),
+		capacity: int64(maxConcurrent),
+		maxQueue: int64(maxQueued),
+		metrics:  metrics.NewConcurrencyMetrics("libvirt"),
+	}
+}
+
+// acquire takes weight units for an operation, waiting in the queue if needed.
+// The returned release function must be called once the operation has finished.
+func (l *opLimiter) acquire(ctx context.Context, op string, weight int64) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if weight > l.capacity {
+		weight = l.capacity
+	}
+
+	if !l.sem.TryAcquire(weight) {
+		if queued := l.queued.Add(1); queued > l.maxQueue {
+			l.queued.Add(-1)
+			l.metrics.RecordRejected(op)
+			return nil, contracts.NewRateLimitError(fmt.Sprintf(
+				"too many concurrent operations: %s rejected with %d operations already queued", op, l.maxQueue), nil)
+		}
+		l.metrics.SetQueued(float64(l.queued.Load()))
+		log.Printf("DEBUG Operation %s waiting for a concurrency slot", op)
+
+		err := l.sem.Acquire(ctx, weight)
+		l.metrics.SetQueued(float64(l.queued.Add(-1)))
+		if err != nil {
+			return nil, contracts.NewTimeoutError(fmt.Sprintf("%s gave up waiting for a concurrency slot", op), err)
+		}
+	}
+
+	l.metrics.SetInflight(float64(l.inflight.Add(1)))
+	var released atomic.Bool
+	return func() {
+		if released.CompareAndSwap(false, true) {
+			l.sem.Release(weight)
+			l.metrics.SetInflight(float64(l.inflight.Add(-1)))
+		}
+	}, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// maxListPageSize caps the page size of a VM listing, whatever the client asks for
+	maxListPageSize = 500
+
+	// pageTokenPrefix versions the page token encoding
+	pageTokenPrefix = "v1:"
+)
+
+// ListOptions narrows and pages a VM listing
+type ListOptions struct {
+	// Selector filters VMs by their virtrigaud metadata; nil matches every VM
+	Selector labels.Selector
+	// PageSize bounds the number of VMs returned; 0 returns every VM
+	PageSize int
+	// PageToken continues the listing returned with a previous page
+	PageToken string
+}
+
+// parseLabelSelector parses the label selector of a listing request
+func parseLabelSelector(selector string) (labels.Selector, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, contracts.NewInvalidSpecError(fmt.Sprintf("invalid label selector %q", selector), err)
+	}
+	return parsed, nil
+}
+
+// encodePageToken returns the opaque token of the page following domain name
+func encodePageToken(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix + name))
+}
+
+// decodePageToken returns the name of the last domain of the previous page
+func decodePageToken(token string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), pageTokenPrefix) {
+		return "", contracts.NewInvalidSpecError("invalid page token", err)
+	}
+	return strings.TrimPrefix(string(raw), pageTokenPrefix), nil
+}
+
+// selectDomains returns the domains of a listing page, ordered by name, and the next page token.
+// The token records the last name returned rather than an offset, so it stays valid while
+// domains are defined or removed between pages: every domain is listed at most once.
+func (p *Provider) selectDomains(ctx context.Context, opts ListOptions) ([]VirshDomain, string, error) {
+	after := ""
+	if opts.PageToken != "" {
+		name, err := decodePageToken(opts.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		after = name
+	}
+
+	pageSize := min(opts.PageSize, maxListPageSize)
+
+	result, err := p.virshProvider.runVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list domains: %w", err)
+	}
+	var names []string
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var domains []VirshDomain
+	start := sort.SearchStrings(names, after)
+	for i := start; i < len(names); i++ {
+		name := names[i]
+		if name == after {
+			continue
+		}
+		if pageSize > 0 && len(domains) == pageSize {
+			return domains, encodePageToken(domains[len(domains)-1].Name), nil
+		}
+
+		if opts.Selector != nil && !opts.Selector.Empty() {
+			metadata, err := p.virshProvider.getVMMetadata(ctx, name)
+			if err != nil {
+				log.Printf("WARN Failed to get metadata for %s: %v", name, err)
+				continue
+			}
+			if !opts.Selector.Matches(labels.Set(metadata)) {
+				continue
+			}
+		}
+
+		state, err := p.virshProvider.getDomainState(ctx, name)
+		if err != nil {
+			state = "unknown"
+		}
+		domains = append(domains, VirshDomain{ID: strconv.Itoa(i), Name: name, State: state})
+	}
+	return domains, "", nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// listVirsh lists five domains out of order; the web domains run in prod, db-1 in dev
+// and cache-1 has no metadata
+const listVirsh = `#!/bin/sh
+case "$1" in
+list)
+	printf 'web-2\ndb-1\nweb-1\ncache-1\nweb-3\n'
+	;;
+metadata)
+	case "$2" in
+	web-*)
+		echo '<metadata><entry key="app">web</entry><entry key="env">prod</entry></metadata>'
+		;;
+	db-1)
+		echo '<metadata><entry key="app">db</entry><entry key="env">dev</entry></metadata>'
+		;;
+	*)
+		echo "error: metadata not found: Requested metadata element is not present" >&2
+		exit 1
+		;;
+	esac
+	;;
+domstate)
+	echo running
+	;;
+esac
+exit 0
+`
+
+func TestParseLabelSelector(t *testing.T) {
+	for _, selector := range []string{"env in (prod", "=prod", "env notin prod", "!", "env in (a b)"} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := parseLabelSelector(selector)
+			var providerErr *contracts.ProviderError
+			require.True(t, errors.As(err, &providerErr), "got %v", err)
+			assert.Equal(t, contracts.ErrorTypeInvalidSpec, providerErr.Type)
+		})
+	}
+}
+
+func TestLabelSelectorSemantics(t *testing.T) {
+	prod := labels.Set{"app": "web", "env": "prod"}
+	dev := labels.Set{"app": "db", "env": "dev"}
+	none := labels.Set{}
+
+	tests := []struct {
+		selector string
+		matches  []labels.Set
+		rejects  []labels.Set
+	}{
+		{selector: "env=prod", matches: []labels.Set{prod}, rejects: []labels.Set{dev, none}},
+		{selector: "env!=prod", matches: []labels.Set{dev, none}, rejects: []labels.Set{prod}},
+		{selector: "env in (prod,staging)", matches: []labels.Set{prod}, rejects: []labels.Set{dev, none}},
+		{selector: "env notin (prod)", matches: []labels.Set{dev, none}, rejects: []labels.Set{prod}},
+		{selector: "env", matches: []labels.Set{prod, dev}, rejects: []labels.Set{none}},
+		{selector: "!env", matches: []labels.Set{none}, rejects: []labels.Set{prod, dev}},
+		{selector: "app=web,env=prod", matches: []labels.Set{prod}, rejects: []labels.Set{dev, none}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			selector, err := parseLabelSelector(tt.selector)
+			require.NoError(t, err)
+			for _, set := range tt.matches {
+				assert.True(t, selector.Matches(set), "%q should match %v", tt.selector, set)
+			}
+			for _, set := range tt.rejects {
+				assert.False(t, selector.Matches(set), "%q should not match %v", tt.selector, set)
+			}
+		})
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	for _, name := range []string{"vm1", "web server", "ünïcode", ""} {
+		got, err := decodePageToken(encodePageToken(name))
+		require.NoError(t, err)
+		assert.Equal(t, name, got)
+	}
+}
+
+func TestDecodePageTokenRejectsMalformedTokens(t *testing.T) {
+	tampered := []byte(encodePageToken("web-1"))
+	tampered[0] ^= 0x20
+
+	for name, token := range map[string]string{
+		"not base64":     "%%%",
+		"missing prefix": base64.RawURLEncoding.EncodeToString([]byte("web-1")),
+		"other version":  base64.RawURLEncoding.EncodeToString([]byte("v2:web-1")),
+		"padded base64":  base64.URLEncoding.EncodeToString([]byte("v1:web-12x")),
+		"tampered":       string(tampered),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodePageToken(token)
+			var providerErr *contracts.ProviderError
+			require.True(t, errors.As(err, &providerErr), "got %v", err)
+			assert.Equal(t, contracts.ErrorTypeInvalidSpec, providerErr.Type)
+		})
+	}
+}
+
+// pageNames lists every domain selected by opts, page by page
+func pageNames(t *testing.T, p *Provider, opts ListOptions) [][]string {
+	t.Helper()
+	var pages [][]string
+	for {
+		domains, next, err := p.selectDomains(context.Background(), opts)
+		require.NoError(t, err)
+		var names []string
+		for _, domain := range domains {
+			names = append(names, domain.Name)
+		}
+		pages = append(pages, names)
+		if next == "" {
+			return pages
+		}
+		opts.PageToken = next
+		require.Less(t, len(pages), 10, "listing does not terminate")
+	}
+}
+
+func TestSelectDomainsPagesInNameOrder(t *testing.T) {
+	p, _ := newScriptedVirshProvider(t, listVirsh)
+
+	pages := pageNames(t, p, ListOptions{PageSize: 2})
+	assert.Equal(t, [][]string{{"cache-1", "db-1"}, {"web-1", "web-2"}, {"web-3"}}, pages)
+
+	all := pageNames(t, p, ListOptions{})
+	assert.Equal(t, [][]string{{"cache-1", "db-1", "web-1", "web-2", "web-3"}}, all)
+}
+
+func TestSelectDomainsFiltersBeforePaging(t *testing.T) {
+	p, _ := newScriptedVirshProvider(t, listVirsh)
+
+	selector, err := parseLabelSelector("env=prod")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"web-1", "web-2"}, {"web-3"}}, pageNames(t, p, ListOptions{Selector: selector, PageSize: 2}))
+
+	selector, err = parseLabelSelector("!env")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"cache-1"}}, pageNames(t, p, ListOptions{Selector: selector}))
+}
+
+func TestSelectDomainsContinuesAfterRemovedDomain(t *testing.T) {
+	p, _ := newScriptedVirshProvider(t, listVirsh)
+
+	// The token names the last domain returned; a removed domain still orders the next page
+	domains, _, err := p.selectDomains(context.Background(), ListOptions{PageSize: 2, PageToken: encodePageToken("db-0")})
+	require.NoError(t, err)
+	require.Len(t, domains, 2)
+	assert.Equal(t, "db-1", domains[0].Name)
+	assert.Equal(t, "web-1", domains[1].Name)
+
+	_, _, err = p.selectDomains(context.Background(), ListOptions{PageToken: "garbage!"})
+	var providerErr *contracts.ProviderError
+	require.True(t, errors.As(err, &providerErr))
+	assert.Equal(t, contracts.ErrorTypeInvalidSpec, providerErr.Type)
+}
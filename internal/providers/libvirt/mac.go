@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// DefaultMACOUI is the prefix of generated MAC addresses, QEMU's locally administered range
+	DefaultMACOUI = "52:54:00"
+
+	// maxMACAttempts bounds how often a generated address is re-derived after a collision
+	maxMACAttempts = 64
+)
+
+// ParseMACOUI validates an OUI prefix of three octets such as 52:54:00
+func ParseMACOUI(oui string) ([3]byte, error) {
+	var prefix [3]byte
+	mac, err := net.ParseMAC(oui + ":00:00:00")
+	if err != nil || len(mac) != 6 {
+		return prefix, fmt.Errorf("invalid MAC OUI %q: expected three octets such as %s", oui, DefaultMACOUI)
+	}
+	if mac[0]&1 != 0 {
+		return prefix, fmt.Errorf("invalid MAC OUI %q: multicast prefixes cannot be assigned to interfaces", oui)
+	}
+	copy(prefix[:], mac[:3])
+	return prefix, nil
+}
+
+// macOUI returns the configured OUI; the server validates it at startup
+func (p *Provider) macOUI() [3]byte {
+	if p.options.MACOUI != "" {
+		if prefix, err := ParseMACOUI(p.options.MACOUI); err == nil {
+			return prefix
+		}
+	}
+	prefix, _ := ParseMACOUI(DefaultMACOUI)
+	return prefix
+}
+
+// deterministicMAC derives the address of a NIC from the VM UUID and NIC index.
+// Each attempt yields another address, used when the previous one is taken.
+func deterministicMAC(oui [3]byte, uuid string, index, attempt int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%d", strings.ToLower(uuid), index, attempt)))
+	return net.HardwareAddr{oui[0], oui[1], oui[2], sum[0], sum[1], sum[2]}.String()
+}
+
+// assignMACAddresses returns the attachments of a new VM with a MAC on each interface:
+// explicit addresses are kept and missing ones are derived from the VM UUID and NIC index,
+// so a VM re-created with the same UUID gets the same addresses. Neither kind may be in
+// use by another domain on the host.
+func (p *Provider) assignMACAddresses(ctx context.Context, uuid string, networks []contracts.NetworkAttachment) ([]contracts.NetworkAttachment, error) {
+	// Mirror generateNetworkInterfacesXML, which attaches the default network to VMs without one
+	if len(networks) == 0 && p.options.DefaultNetwork != "" {
+		networks = []contracts.NetworkAttachment{{Name: "default"}}
+	}
+	if len(networks) == 0 {
+		return networks, nil
+	}
+
+	taken, err := p.virshProvider.hostMACAddresses(ctx)
+	if err != nil {
+		return nil, contracts.NewRetryableError("failed to list MAC addresses in use on the host", err)
+	}
+	return p.deriveMACAddresses(uuid, networks, taken)
+}
+
+// deriveMACAddresses gives each attachment without a MAC the address derived from the VM
+// UUID and NIC index, skipping addresses in taken, which maps MACs to the domain using them
+func (p *Provider) deriveMACAddresses(uuid string, networks []contracts.NetworkAttachment, taken map[string]string) ([]contracts.NetworkAttachment, error) {
+	result := make([]contracts.NetworkAttachment, len(networks))
+	copy(result, networks)
+
+	// Claim explicit addresses first so that a generated one cannot take an address requested later
+	requested := make(map[string]bool, len(result))
+	for i, network := range result {
+		if network.MacAddress == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(network.MacAddress)
+		if err != nil || len(mac) != 6 {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("network %q: invalid MAC address %q", network.Name, network.MacAddress), err)
+		}
+		if mac[0]&1 != 0 {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("network %q: %s is a multicast address", network.Name, mac), nil)
+		}
+		if requested[mac.String()] {
+			return nil, contracts.NewInvalidSpecError(fmt.Sprintf("MAC address %s is requested for more than one interface", mac), nil)
+		}
+		if owner, ok := taken[mac.String()]; ok {
+			return nil, contracts.NewConflictError(fmt.Sprintf("MAC address %s of network %q is in use by domain %s", mac, network.Name, owner), nil)
+		}
+		requested[mac.String()] = true
+		result[i].MacAddress = mac.String()
+	}
+
+	oui := p.macOUI()
+	for i := range result {
+		if result[i].MacAddress != "" {
+			continue
+		}
+		for attempt := 0; result[i].MacAddress == ""; attempt++ {
+			if attempt == maxMACAttempts {
+				return nil, contracts.NewConflictError(fmt.Sprintf("no free MAC address found for interface %d after %d attempts", i, maxMACAttempts), nil)
+			}
+			mac := deterministicMAC(oui, uuid, i, attempt)
+			if _, ok := taken[mac]; ok || requested[mac] {
+				continue
+			}
+			requested[mac] = true
+			result[i].MacAddress = mac
+		}
+	}
+	return result, nil
+}
+
+// hostMACAddresses maps the MAC address of every interface of every domain to the domain name
+func (v *VirshProvider) hostMACAddresses(ctx context.Context) (map[string]string, error) {
+	list, err := v.runVirshCommand(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, err
+	}
+
+	macs := make(map[string]string)
+	for _, line := range strings.Split(list.Stdout, "\n") {
+		name := strings.TrimSpace(line)
+		if name == "" {
+			continue
+		}
+		result, err := v.runVirshCommand(ctx, "domiflist", name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list interfaces of %s: %w", name, err)
+		}
+		// Columns: Interface Type Source Model MAC
+		for _, row := range strings.Split(result.Stdout, "\n") {
+			fields := strings.Fields(row)
+			if len(fields) == 0 {
+				continue
+			}
+			if mac, err := net.ParseMAC(fields[len(fields)-1]); err == nil {
+				macs[mac.String()] = name
+			}
+		}
+	}
+	return macs, nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// macVirsh reports one domain, vm-a, with an interface using 52:54:00:aa:bb:cc
+const macVirsh = `#!/bin/sh
+case "$1" in
+list)
+	echo "vm-a"
+	;;
+domiflist)
+	printf ' Interface   Type      Source    Model    MAC\n-----------------------------------------------\n'
+	printf ' vnet0       network   default   virtio   52:54:00:aa:bb:cc\n'
+	;;
+esac
+exit 0
+`
+
+func TestParseMACOUI(t *testing.T) {
+	tests := []struct {
+		name    string
+		oui     string
+		want    [3]byte
+		wantErr bool
+	}{
+		{name: "qemu default", oui: "52:54:00", want: [3]byte{0x52, 0x54, 0x00}},
+		{name: "upper case", oui: "02:AB:CD", want: [3]byte{0x02, 0xab, 0xcd}},
+		{name: "dash separated", oui: "02-ab-cd", wantErr: true},
+		{name: "multicast", oui: "01:00:5e", wantErr: true},
+		{name: "too short", oui: "52:54", wantErr: true},
+		{name: "too long", oui: "52:54:00:01", wantErr: true},
+		{name: "not hex", oui: "zz:54:00", wantErr: true},
+		{name: "empty", oui: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMACOUI(tt.oui)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeterministicMAC(t *testing.T) {
+	oui := [3]byte{0x02, 0xab, 0xcd}
+	uuid := "4C4C4544-0042-3010-8052-B4C04F384D32"
+
+	mac := deterministicMAC(oui, uuid, 0, 0)
+	hw, err := net.ParseMAC(mac)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0xab, 0xcd}, []byte(hw[:3]))
+
+	assert.Equal(t, mac, deterministicMAC(oui, uuid, 0, 0), "stable for the same inputs")
+	assert.Equal(t, mac, deterministicMAC(oui, "4c4c4544-0042-3010-8052-b4c04f384d32", 0, 0), "UUID case does not matter")
+	assert.NotEqual(t, mac, deterministicMAC(oui, uuid, 1, 0), "differs per NIC")
+	assert.NotEqual(t, mac, deterministicMAC(oui, uuid, 0, 1), "differs per attempt")
+	assert.NotEqual(t, mac, deterministicMAC(oui, "another-uuid", 0, 0), "differs per VM")
+}
+
+func TestAssignMACAddresses(t *testing.T) {
+	const uuid = "0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+
+	tests := []struct {
+		name     string
+		networks []contracts.NetworkAttachment
+		wantErr  contracts.ErrorType
+		check    func(t *testing.T, got []contracts.NetworkAttachment)
+	}{
+		{
+			name:     "generated with prefix",
+			networks: []contracts.NetworkAttachment{{Name: "a"}, {Name: "b"}},
+			check: func(t *testing.T, got []contracts.NetworkAttachment) {
+				require.Len(t, got, 2)
+				assert.Equal(t, deterministicMAC([3]byte{0x52, 0x54, 0x00}, uuid, 0, 0), got[0].MacAddress)
+				assert.NotEqual(t, got[0].MacAddress, got[1].MacAddress)
+			},
+		},
+		{
+			name:     "explicit address normalised",
+			networks: []contracts.NetworkAttachment{{Name: "a", MacAddress: "52-54-00-12-34-56"}},
+			check: func(t *testing.T, got []contracts.NetworkAttachment) {
+				assert.Equal(t, "52:54:00:12:34:56", got[0].MacAddress)
+			},
+		},
+		{name: "invalid", networks: []contracts.NetworkAttachment{{Name: "a", MacAddress: "not-a-mac"}}, wantErr: contracts.ErrorTypeInvalidSpec},
+		{name: "too long", networks: []contracts.NetworkAttachment{{Name: "a", MacAddress: "52:54:00:12:34:56:78:9a"}}, wantErr: contracts.ErrorTypeInvalidSpec},
+		{name: "multicast", networks: []contracts.NetworkAttachment{{Name: "a", MacAddress: "01:00:5e:00:00:01"}}, wantErr: contracts.ErrorTypeInvalidSpec},
+		{name: "duplicate in request", wantErr: contracts.ErrorTypeInvalidSpec, networks: []contracts.NetworkAttachment{
+			{Name: "a", MacAddress: "52:54:00:12:34:56"}, {Name: "b", MacAddress: "52:54:00:12:34:56"}}},
+		{name: "in use on host", networks: []contracts.NetworkAttachment{{Name: "a", MacAddress: "52:54:00:AA:BB:CC"}}, wantErr: contracts.ErrorTypeConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, _ := newScriptedVirshProvider(t, macVirsh)
+			got, err := p.assignMACAddresses(context.Background(), uuid, tt.networks)
+			if tt.wantErr != "" {
+				var providerErr *contracts.ProviderError
+				require.True(t, errors.As(err, &providerErr), "got %v", err)
+				assert.Equal(t, tt.wantErr, providerErr.Type)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestAssignMACAddressesSkipsTakenAddresses(t *testing.T) {
+	p, _ := newScriptedVirshProvider(t, macVirsh)
+	oui := [3]byte{0x52, 0x54, 0x00}
+	const uuid = "0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+
+	// An explicit request for the first derived address pushes the generated NIC to the next attempt
+	first := deterministicMAC(oui, uuid, 1, 0)
+	got, err := p.assignMACAddresses(context.Background(), uuid, []contracts.NetworkAttachment{
+		{Name: "a", MacAddress: first},
+		{Name: "b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first, got[0].MacAddress)
+	assert.Equal(t, deterministicMAC(oui, uuid, 1, 1), got[1].MacAddress)
+}
+
+func TestReconcileNetworkInterfacesDerivesMissingAddresses(t *testing.T) {
+	const uuid = "0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+	oui := [3]byte{0x52, 0x54, 0x00}
+	t.Setenv("FIRST_MAC", deterministicMAC(oui, uuid, 0, 0))
+	p, logPath := newScriptedVirshProvider(t, `#!/bin/sh
+echo "$*" >> "$FAKE_VIRSH_LOG"
+case "$1" in
+list) echo web ;;
+domiflist) printf ' vnet0   network   default   virtio   %s\n' "$FIRST_MAC" ;;
+dominfo) printf 'State:          running\nPersistent:     yes\n' ;;
+dumpxml) cat <<XML
+<domain><name>web</name><uuid>`+uuid+`</uuid><devices>
+<interface type='network'><mac address='$FIRST_MAC'/><source network='default'/></interface>
+</devices></domain>
+XML
+	;;
+esac
+exit 0
+`)
+
+	// The first NIC is the one creation derived, so only the second is attached
+	err := p.ReconcileNetworkInterfaces(context.Background(), "web", []contracts.NetworkAttachment{
+		{Name: "a", NetworkName: "default"},
+		{Name: "b", NetworkName: "default"},
+	})
+	require.NoError(t, err)
+
+	commands := loggedCommands(t, logPath, "attach-device", "detach-device")
+	require.Len(t, commands, 1)
+	assert.True(t, strings.HasPrefix(commands[0], "attach-device web"), commands[0])
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// managedSaveDir is where the QEMU driver of a system libvirtd keeps managed save images
+const managedSaveDir = "/var/lib/libvirt/qemu/save"
+
+// SaveVM writes the memory state of a running or paused domain to its managed save image
+// and stops it. The next power-on restores the domain from the image instead of booting it.
+// It returns the size of the image in bytes.
+func (p *Provider) SaveVM(ctx context.Context, vmID string) (int64, error) {
+	state, err := p.existingDomainState(ctx, vmID)
+	if err != nil {
+		return 0, err
+	}
+	if state != "running" && state != "paused" {
+		return 0, contracts.NewInvalidStateError(fmt.Sprintf("domain %s is %s; only a running or paused domain can be saved", vmID, state), nil)
+	}
+	if transient, err := p.virshProvider.isTransientDomain(ctx, vmID); err == nil && transient {
+		return 0, contracts.NewInvalidStateError(fmt.Sprintf("domain %s is ephemeral; it has no definition to restore a saved state into", vmID), nil)
+	}
+
+	log.Printf("INFO Saving domain %s to its managed save image", vmID)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "managedsave", vmID); err != nil {
+		return 0, fmt.Errorf("failed to save domain %s: %w", vmID, err)
+	}
+	return p.virshProvider.managedSaveSize(ctx, vmID), nil
+}
+
+// DiscardManagedSave removes the managed save image of a domain so that the next power-on
+// boots it afresh. It reports whether there was an image to remove.
+func (p *Provider) DiscardManagedSave(ctx context.Context, vmID string) (bool, error) {
+	if _, err := p.existingDomainState(ctx, vmID); err != nil {
+		return false, err
+	}
+
+	saved, err := p.virshProvider.hasManagedSave(ctx, vmID)
+	if err != nil {
+		return false, contracts.NewRetryableError("failed to get domain info", err)
+	}
+	if !saved {
+		return false, nil
+	}
+
+	log.Printf("INFO Discarding managed save image of domain %s", vmID)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "managedsave-remove", vmID); err != nil {
+		return false, fmt.Errorf("failed to discard managed save of domain %s: %w", vmID, err)
+	}
+	return true, nil
+}
+
+// hasManagedSave reports whether a domain has a managed save image
+func (v *VirshProvider) hasManagedSave(ctx context.Context, domainName string) (bool, error) {
+	result, err := v.runVirshCommand(ctx, "dominfo", domainName)
+	if err != nil {
+		return false, err
+	}
+	return parseColonFields(result.Stdout)["Managed save"] == "yes", nil
+}
+
+// managedSaveSize returns the size of the managed save image of a domain, or 0 if it
+// cannot be read
+func (v *VirshProvider) managedSaveSize(ctx context.Context, domainName string) int64 {
+	path := fmt.Sprintf("%s/%s.save", managedSaveDir, domainName)
+	result, err := v.runVirshCommand(ctx, "!", "stat", "-c", "%s", path)
+	if err != nil {
+		log.Printf("DEBUG Failed to stat managed save image %s: %v", path, err)
+		return 0
+	}
+	size, _ := strconv.ParseInt(strings.TrimSpace(result.Stdout), 10, 64)
+	return size
+}
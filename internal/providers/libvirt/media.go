@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// InsertMedia loads an ISO image into a CD-ROM drive of a domain, replacing any inserted media.
+// The change is applied to a running domain and persisted in its definition.
+func (p *Provider) InsertMedia(ctx context.Context, vmID, device, source string) error {
+	cdrom, err := p.findCDROM(ctx, vmID, device)
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(source) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("media source %q must be an absolute path on the host", source), nil)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-f", source); err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("media source %s not found on host", source), err)
+	}
+
+	log.Printf("INFO Inserting %s into %s of domain %s", source, device, vmID)
+	return p.changeMedia(ctx, vmID, cdrom, source)
+}
+
+// EjectMedia empties a CD-ROM drive of a domain and returns the source that was inserted
+func (p *Provider) EjectMedia(ctx context.Context, vmID, device string) (string, error) {
+	cdrom, err := p.findCDROM(ctx, vmID, device)
+	if err != nil {
+		return "", err
+	}
+	ejected := cdrom.sourcePath()
+	if ejected == "" {
+		return "", nil
+	}
+
+	log.Printf("INFO Ejecting %s from %s of domain %s", ejected, device, vmID)
+	return ejected, p.changeMedia(ctx, vmID, cdrom, "")
+}
+
+// changeMedia updates the source of a CD-ROM drive; an empty source ejects the media
+func (p *Provider) changeMedia(ctx context.Context, vmID string, cdrom *domainDisk, source string) error {
+	scope, err := p.virshProvider.domainChangeScope(ctx, vmID)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	sourceXML := ""
+	if source != "" {
+		sourceXML = fmt.Sprintf("\n  <source file='%s'/>", xmlEscape(source))
+	}
+	deviceXML := fmt.Sprintf("<disk type='file' device='cdrom'>\n  <driver name='qemu' type='raw'/>%s\n  <target dev='%s' bus='%s'/>\n  <readonly/>\n</disk>",
+		sourceXML, xmlEscape(cdrom.Target.Dev), xmlEscape(cdrom.Target.Bus))
+
+	if err := p.applyDeviceXML(ctx, "update-device", vmID, deviceXML, scope); err != nil {
+		return fmt.Errorf("failed to change media of %s: %w", cdrom.Target.Dev, err)
+	}
+	return nil
+}
+
+// findCDROM returns the CD-ROM drive of a domain attached at the given target device
+func (p *Provider) findCDROM(ctx context.Context, vmID, device string) (*domainDisk, error) {
+	if p.virshProvider == nil {
+		return nil, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if device == "" {
+		return nil, contracts.NewInvalidSpecError("CD-ROM device name is required", nil)
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return nil, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	disk, ok := domain.findDisk(device)
+	if !ok {
+		return nil, contracts.NewNotFoundError(fmt.Sprintf("domain %s has no device %s", vmID, device), nil)
+	}
+	if disk.Device != "cdrom" {
+		return nil, contracts.NewInvalidSpecError(fmt.Sprintf("device %s of domain %s is a %s, not a removable CD-ROM", device, vmID, disk.Device), nil)
+	}
+	return disk, nil
+}
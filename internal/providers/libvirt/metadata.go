@@ -0,0 +1,362 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// metadataNamespaceURI identifies virtrigaud's element inside the domain <metadata>
+	metadataNamespaceURI = "https://virtrigaud.io/xmlns/libvirt/metadata/1.0"
+
+	// metadataNamespacePrefix is the XML prefix libvirt stores the element under
+	metadataNamespacePrefix = "virtrigaud"
+
+	// ownerNamespaceURI identifies the element marking which provider instance manages a domain.
+	// It is kept apart from the user metadata so that Describe and SetVMMetadata never expose it.
+	ownerNamespaceURI = "https://virtrigaud.io/xmlns/libvirt/owner/1.0"
+
+	// ownerNamespacePrefix is the XML prefix of the ownership marker
+	ownerNamespacePrefix = "virtrigaud-owner"
+
+	// volumesNamespaceURI identifies the element recording which disk volumes a domain owns,
+	// so that Delete leaves volumes attached by reference in place
+	volumesNamespaceURI = "https://virtrigaud.io/xmlns/libvirt/volumes/1.0"
+
+	// volumesNamespacePrefix is the XML prefix of the volume ownership record
+	volumesNamespacePrefix = "virtrigaud-volumes"
+
+	// affinityNamespaceURI identifies the element holding the scheduler's affinity groups,
+	// kept apart from the user metadata whose keys the groups would otherwise collide with
+	affinityNamespaceURI = "https://virtrigaud.io/xmlns/libvirt/affinity/1.0"
+
+	// affinityNamespacePrefix is the XML prefix of the affinity groups
+	affinityNamespacePrefix = "virtrigaud-affinity"
+
+	// linkedClonesNamespaceURI identifies the element recording the linked clones whose
+	// overlays are backed by the disks of a domain
+	linkedClonesNamespaceURI = "https://virtrigaud.io/xmlns/libvirt/linked-clones/1.0"
+
+	// linkedClonesNamespacePrefix is the XML prefix of the linked clone record
+	linkedClonesNamespacePrefix = "virtrigaud-clones"
+
+	// DefaultInstanceID is the instance ID of a provider that was not given one
+	DefaultInstanceID = "default"
+)
+
+// metadataDocument is the virtrigaud element stored in the domain <metadata>
+type metadataDocument struct {
+	XMLName xml.Name        `xml:"metadata"`
+	Entries []metadataEntry `xml:"entry"`
+}
+
+// metadataEntry is a single key/value pair
+type metadataEntry struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ownerDocument is the ownership marker stored in the domain <metadata>
+type ownerDocument struct {
+	XMLName  xml.Name `xml:"owner"`
+	Instance string   `xml:"instance,attr"`
+}
+
+// volumesDocument records the disk volumes of a domain and whether the provider created them
+type volumesDocument struct {
+	XMLName xml.Name       `xml:"volumes"`
+	Volumes []volumeRecord `xml:"volume"`
+}
+
+// volumeRecord is the ownership of a single disk volume
+type volumeRecord struct {
+	Path  string `xml:"path,attr"`
+	Owned bool   `xml:"owned,attr"`
+}
+
+// affinityDocument records the affinity and anti-affinity groups of a domain
+type affinityDocument struct {
+	XMLName      xml.Name `xml:"groups"`
+	Affinity     []string `xml:"affinity"`
+	AntiAffinity []string `xml:"anti-affinity"`
+}
+
+// linkedClonesDocument records the linked clones backed by the disks of a domain
+type linkedClonesDocument struct {
+	XMLName xml.Name            `xml:"clones"`
+	Clones  []linkedCloneRecord `xml:"clone"`
+}
+
+// linkedCloneRecord names a linked clone by its UUID, which survives a rename
+type linkedCloneRecord struct {
+	UUID string `xml:"uuid,attr"`
+}
+
+// renderAffinityGroups renders the group elements of an affinity document, prefixed by prefix
+func renderAffinityGroups(affinity, antiAffinity []string, prefix string) string {
+	var buf bytes.Buffer
+	for _, list := range []struct {
+		element string
+		groups  []string
+	}{
+		{"affinity", affinity},
+		{"anti-affinity", antiAffinity},
+	} {
+		for _, group := range list.groups {
+			buf.WriteString("<" + prefix + list.element + ">")
+			_ = xml.EscapeText(&buf, []byte(group))
+			buf.WriteString("</" + prefix + list.element + ">")
+		}
+	}
+	return buf.String()
+}
+
+// renderMetadataEntries renders the metadata entries in a stable key order
+func renderMetadataEntries(metadata map[string]string, prefix string) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		buf.WriteString("<" + prefix + "entry key=\"")
+		_ = xml.EscapeText(&buf, []byte(key))
+		buf.WriteString("\">")
+		_ = xml.EscapeText(&buf, []byte(metadata[key]))
+		buf.WriteString("</" + prefix + "entry>")
+	}
+	return buf.String()
+}
+
+// domainMetadataXML renders the <metadata> section embedded in a new domain definition,
+// including the ownership marker of the creating provider instance, of the disk volumes
+// and the affinity groups of the request
+func domainMetadataXML(req contracts.CreateRequest, owner string, volumes []volumeRecord) string {
+	metadata := req.VMMetadata
+	var b strings.Builder
+	b.WriteString("  <metadata>\n")
+	if len(metadata) > 0 {
+		prefix := metadataNamespacePrefix + ":"
+		fmt.Fprintf(&b, "    <%smetadata xmlns:%s='%s'>%s</%smetadata>\n",
+			prefix, metadataNamespacePrefix, metadataNamespaceURI,
+			renderMetadataEntries(metadata, prefix), prefix)
+	}
+	fmt.Fprintf(&b, "    <%s:owner xmlns:%s='%s' instance='%s'/>\n",
+		ownerNamespacePrefix, ownerNamespacePrefix, ownerNamespaceURI, xmlEscape(owner))
+	if len(volumes) > 0 {
+		prefix := volumesNamespacePrefix + ":"
+		fmt.Fprintf(&b, "    <%svolumes xmlns:%s='%s'>", prefix, volumesNamespacePrefix, volumesNamespaceURI)
+		for _, volume := range volumes {
+			fmt.Fprintf(&b, "<%svolume path='%s' owned='%t'/>", prefix, xmlEscape(volume.Path), volume.Owned)
+		}
+		fmt.Fprintf(&b, "</%svolumes>\n", prefix)
+	}
+	if len(req.AffinityGroups) > 0 || len(req.AntiAffinityGroups) > 0 {
+		prefix := affinityNamespacePrefix + ":"
+		fmt.Fprintf(&b, "    <%sgroups xmlns:%s='%s'>%s</%sgroups>\n",
+			prefix, affinityNamespacePrefix, affinityNamespaceURI,
+			renderAffinityGroups(req.AffinityGroups, req.AntiAffinityGroups, prefix), prefix)
+	}
+	b.WriteString("  </metadata>\n")
+	return b.String()
+}
+
+// instanceID returns the ID this provider stamps into the ownership marker
+func (p *Provider) instanceID() string {
+	if p.options.InstanceID == "" {
+		return DefaultInstanceID
+	}
+	return p.options.InstanceID
+}
+
+// getDomainOwner reads the ownership marker of a domain; an unmanaged domain yields ""
+func (v *VirshProvider) getDomainOwner(ctx context.Context, domainName string) (string, error) {
+	result, err := v.runVirshCommand(ctx, "metadata", domainName, "--uri", ownerNamespaceURI)
+	if err != nil {
+		if result != nil && strings.Contains(strings.ToLower(result.Stderr), "metadata not found") {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var doc ownerDocument
+	if err := xml.Unmarshal([]byte(result.Stdout), &doc); err != nil {
+		return "", fmt.Errorf("failed to parse domain owner: %w", err)
+	}
+	return doc.Instance, nil
+}
+
+// setDomainOwner stamps the ownership marker of a domain in the definitions of scope
+func (v *VirshProvider) setDomainOwner(ctx context.Context, domainName, owner string, scope changeScope) error {
+	doc := fmt.Sprintf("<owner instance='%s'/>", xmlEscape(owner))
+	args := append([]string{"metadata", domainName, "--uri", ownerNamespaceURI}, scope.flags()...)
+	args = append(args, "--key", ownerNamespacePrefix, "--set", v.quoteRemoteArg(doc))
+
+	if _, err := v.runVirshCommand(ctx, args...); err != nil {
+		return fmt.Errorf("failed to set domain owner: %w", err)
+	}
+	return nil
+}
+
+// getVolumeRecords reads the volume ownership record of a domain; domains created
+// before volumes were recorded yield nil
+func (v *VirshProvider) getVolumeRecords(ctx context.Context, domainName string) ([]volumeRecord, error) {
+	result, err := v.runVirshCommand(ctx, "metadata", domainName, "--uri", volumesNamespaceURI)
+	if err != nil {
+		if result != nil && strings.Contains(strings.ToLower(result.Stderr), "metadata not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var doc volumesDocument
+	if err := xml.Unmarshal([]byte(result.Stdout), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse domain volumes: %w", err)
+	}
+	return doc.Volumes, nil
+}
+
+// getAffinityGroups reads the affinity and anti-affinity groups of a domain; a domain without any yields nil
+func (v *VirshProvider) getAffinityGroups(ctx context.Context, domainName string) (affinity, antiAffinity []string, err error) {
+	result, err := v.runVirshCommand(ctx, "metadata", domainName, "--uri", affinityNamespaceURI)
+	if err != nil {
+		if result != nil && strings.Contains(strings.ToLower(result.Stderr), "metadata not found") {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var doc affinityDocument
+	if err := xml.Unmarshal([]byte(result.Stdout), &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse domain affinity groups: %w", err)
+	}
+	return doc.Affinity, doc.AntiAffinity, nil
+}
+
+// setAffinityGroups replaces the affinity and anti-affinity groups of a domain in the
+// definitions of scope. Empty lists remove the element.
+func (v *VirshProvider) setAffinityGroups(ctx context.Context, domainName string, affinity, antiAffinity []string, scope changeScope) error {
+	args := append([]string{"metadata", domainName, "--uri", affinityNamespaceURI}, scope.flags()...)
+
+	if len(affinity) == 0 && len(antiAffinity) == 0 {
+		args = append(args, "--remove")
+	} else {
+		doc := "<groups>" + renderAffinityGroups(affinity, antiAffinity, "") + "</groups>"
+		args = append(args, "--key", affinityNamespacePrefix, "--set", v.quoteRemoteArg(doc))
+	}
+
+	if _, err := v.runVirshCommand(ctx, args...); err != nil {
+		return fmt.Errorf("failed to set domain affinity groups: %w", err)
+	}
+	return nil
+}
+
+// getLinkedClones reads the UUIDs of the linked clones recorded on a domain
+func (v *VirshProvider) getLinkedClones(ctx context.Context, domainName string) ([]string, error) {
+	result, err := v.runVirshCommand(ctx, "metadata", domainName, "--uri", linkedClonesNamespaceURI)
+	if err != nil {
+		if result != nil && strings.Contains(strings.ToLower(result.Stderr), "metadata not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if strings.TrimSpace(result.Stdout) == "" {
+		return nil, nil
+	}
+
+	var doc linkedClonesDocument
+	if err := xml.Unmarshal([]byte(result.Stdout), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse domain linked clones: %w", err)
+	}
+	uuids := make([]string, 0, len(doc.Clones))
+	for _, clone := range doc.Clones {
+		uuids = append(uuids, clone.UUID)
+	}
+	return uuids, nil
+}
+
+// setLinkedClones replaces the linked clones recorded in the definition of a domain; an
+// empty list removes the record
+func (v *VirshProvider) setLinkedClones(ctx context.Context, domainName string, uuids []string) error {
+	args := []string{"metadata", domainName, "--uri", linkedClonesNamespaceURI, "--config"}
+	if len(uuids) == 0 {
+		args = append(args, "--remove")
+	} else {
+		var doc strings.Builder
+		doc.WriteString("<clones>")
+		for _, uuid := range uuids {
+			fmt.Fprintf(&doc, "<clone uuid='%s'/>", xmlEscape(uuid))
+		}
+		doc.WriteString("</clones>")
+		args = append(args, "--key", linkedClonesNamespacePrefix, "--set", v.quoteRemoteArg(doc.String()))
+	}
+
+	if _, err := v.runVirshCommand(ctx, args...); err != nil {
+		return fmt.Errorf("failed to set domain linked clones: %w", err)
+	}
+	return nil
+}
+
+// getVMMetadata reads the virtrigaud metadata of a domain; a domain without any yields an empty map
+func (v *VirshProvider) getVMMetadata(ctx context.Context, domainName string) (map[string]string, error) {
+	result, err := v.runVirshCommand(ctx, "metadata", domainName, "--uri", metadataNamespaceURI)
+	if err != nil {
+		if result != nil && strings.Contains(strings.ToLower(result.Stderr), "metadata not found") {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	var doc metadataDocument
+	if err := xml.Unmarshal([]byte(result.Stdout), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse domain metadata: %w", err)
+	}
+
+	metadata := make(map[string]string, len(doc.Entries))
+	for _, entry := range doc.Entries {
+		metadata[entry.Key] = entry.Value
+	}
+	return metadata, nil
+}
+
+// setVMMetadata replaces the virtrigaud metadata of a domain in the definitions of scope.
+// An empty map removes the element.
+func (v *VirshProvider) setVMMetadata(ctx context.Context, domainName string, metadata map[string]string, scope changeScope) error {
+	args := append([]string{"metadata", domainName, "--uri", metadataNamespaceURI}, scope.flags()...)
+
+	if len(metadata) == 0 {
+		args = append(args, "--remove")
+	} else {
+		doc := "<metadata>" + renderMetadataEntries(metadata, "") + "</metadata>"
+		args = append(args, "--key", metadataNamespacePrefix, "--set", v.quoteRemoteArg(doc))
+	}
+
+	if _, err := v.runVirshCommand(ctx, args...); err != nil {
+		return fmt.Errorf("failed to set domain metadata: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// migrationTimeout bounds how long a single migration may run
+	migrationTimeout = 2 * time.Hour
+
+	// migrationPollInterval is how often job progress is sampled
+	migrationPollInterval = 2 * time.Second
+)
+
+// MigrateOptions tunes a domain migration
+type MigrateOptions struct {
+	// Live keeps the domain running during migration
+	Live bool
+	// BandwidthMiBps caps migration bandwidth (0 = unlimited)
+	BandwidthMiBps int64
+	// MaxDowntimeMs sets the maximum tolerable downtime for live migration (0 = default)
+	MaxDowntimeMs int64
+}
+
+// Migrate starts migrating a domain to another host and returns a task reference.
+// The destination may be a hostname or a full libvirt URI.
+func (p *Provider) Migrate(ctx context.Context, vmID, destination string, opts MigrateOptions) (string, error) {
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if vmID == "" || destination == "" {
+		return "", contracts.NewInvalidSpecError("vm id and destination are required", nil)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		if domainGone(err) {
+			return "", contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+		}
+		return "", contracts.NewRetryableError("failed to get domain state", err)
+	}
+	if !opts.Live && state == "running" {
+		return "", contracts.NewInvalidSpecError("offline migration requires the domain to be shut off", nil)
+	}
+	if opts.Live && state != "running" {
+		return "", contracts.NewInvalidSpecError("live migration requires a running domain", nil)
+	}
+	if err := p.checkNoLinkedClones(ctx, vmID, "migrated"); err != nil {
+		return "", err
+	}
+
+	destURI, err := p.virshProvider.migrationURI(destination)
+	if err != nil {
+		return "", contracts.NewInvalidSpecError("invalid migration destination", err)
+	}
+
+	mode := "offline"
+	args := []string{"migrate", "--persistent", "--undefinesource", "--verbose"}
+	if opts.Live {
+		mode = "live"
+		args = append(args, "--live")
+	} else {
+		args = append(args, "--offline")
+	}
+	if opts.BandwidthMiBps > 0 {
+		args = append(args, "--bandwidth", strconv.FormatInt(opts.BandwidthMiBps, 10))
+	}
+	args = append(args, vmID, destURI)
+
+	// The migration keeps its concurrency slot until the background job finishes
+	release, err := p.limiter.acquire(ctx, "Migrate", migrateOpWeight)
+	if err != nil {
+		return "", err
+	}
+
+	taskID := fmt.Sprintf("task-migrate-%s", generateTaskID())
+	p.tasks.start(taskID, fmt.Sprintf("Migrating %s to %s", vmID, destination))
+
+	log.Printf("INFO Starting %s migration of %s to %s (task %s)", mode, vmID, destURI, taskID)
+
+	// The RPC context ends when the call returns, so run the migration detached; the VM stays
+	// locked against other operations until it finishes
+	unlock := holdForTask(ctx)
+	migrateCtx, cancel := context.WithTimeout(context.Background(), migrationTimeout)
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := p.virshProvider.runVirshJob(migrateCtx, args...)
+		done <- err
+	}()
+
+	go func() {
+		defer unlock()
+		defer release()
+		defer cancel()
+		p.watchMigration(migrateCtx, taskID, vmID, destination, opts, done)
+	}()
+
+	return taskID, nil
+}
+
+// watchMigration samples job progress until the migration command returns
+func (p *Provider) watchMigration(ctx context.Context, taskID, vmID, destination string, opts MigrateOptions, done <-chan error) {
+	ticker := time.NewTicker(migrationPollInterval)
+	defer ticker.Stop()
+
+	downtimeApplied := opts.MaxDowntimeMs <= 0 || !opts.Live
+
+	for {
+		select {
+		case err := <-done:
+			// Killing virsh does not stop the job in libvirtd, so abort it explicitly
+			if ctx.Err() != nil {
+				p.abortDomainJob(vmID)
+				err = fmt.Errorf("migration did not finish within %s: %w", migrationTimeout, ctx.Err())
+			}
+			if err != nil {
+				log.Printf("ERROR Migration of %s to %s failed: %v", vmID, destination, err)
+				p.tasks.finish(taskID, fmt.Errorf("migration failed: %w", err), "Migration failed")
+				return
+			}
+			log.Printf("INFO Migration of %s to %s completed", vmID, destination)
+			p.tasks.finish(taskID, nil, fmt.Sprintf("Migrated %s to %s", vmID, destination))
+			return
+		case <-ticker.C:
+			// Max downtime can only be tuned once the migration job exists
+			if !downtimeApplied {
+				if _, err := p.virshProvider.runVirshCommand(ctx, "migrate-setmaxdowntime", vmID,
+					strconv.FormatInt(opts.MaxDowntimeMs, 10)); err == nil {
+					downtimeApplied = true
+				}
+			}
+
+			if processed, total, active := p.virshProvider.migrationProgress(ctx, vmID); active {
+				message := "Migration in progress"
+				if total > 0 {
+					message = fmt.Sprintf("Migration in progress (%d%%)", bytesProgress(processed, total))
+				}
+				p.tasks.updateBytes(taskID, processed, total, message)
+			}
+		}
+	}
+}
+
+// abortDomainJob aborts the active job (e.g. a migration) of a domain
+func (p *Provider) abortDomainJob(vmID string) {
+	ctx, cancel := abortContext()
+	defer cancel()
+
+	log.Printf("WARN Aborting active job of domain %s", vmID)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "domjobabort", vmID); err != nil {
+		log.Printf("WARN Failed to abort job of domain %s: %v", vmID, err)
+	}
+}
+
+// migrationURI turns a destination node or URI into a libvirt connection URI,
+// reusing the transport and user of the current connection for bare hostnames
+func (v *VirshProvider) migrationURI(destination string) (string, error) {
+	if strings.Contains(destination, "://") {
+		if _, err := url.Parse(destination); err != nil {
+			return "", err
+		}
+		return destination, nil
+	}
+
+	current, err := url.Parse(v.uri)
+	if err != nil || current.Scheme == "" {
+		return fmt.Sprintf("qemu+ssh://%s/system", destination), nil
+	}
+
+	scheme := current.Scheme
+	if scheme == "qemu" {
+		// Local connections still need a remote transport to reach another host
+		scheme = "qemu+ssh"
+	}
+
+	host := destination
+	if current.User != nil && current.User.Username() != "" {
+		host = fmt.Sprintf("%s@%s", current.User.Username(), destination)
+	}
+
+	path := current.Path
+	if path == "" {
+		path = "/system"
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, host, path), nil
+}
+
+// migrationProgress reads the active job of a domain (virDomainGetJobStats) and returns
+// the data processed so far; active is false while no job runs
+func (v *VirshProvider) migrationProgress(ctx context.Context, vmID string) (processed, total int64, active bool) {
+	result, err := v.runVirshCommand(ctx, "domjobinfo", vmID)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	var remaining int64
+	haveProcessed := false
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		switch key {
+		case "Job type":
+			if value == "None" {
+				return 0, 0, false
+			}
+		case "Data processed":
+			processed, _ = parseStorageSize(value)
+			haveProcessed = true
+		case "Data total":
+			total, _ = parseStorageSize(value)
+		case "Data remaining":
+			remaining, _ = parseStorageSize(value)
+		}
+	}
+
+	if !haveProcessed && total > 0 {
+		processed = total - remaining
+	}
+	return processed, total, true
+}
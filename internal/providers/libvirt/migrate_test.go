@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// gatedMigrateVirsh reports a shut off domain whose migration runs until MIGRATE_GATE exists
+const gatedMigrateVirsh = `#!/bin/sh
+case "$1" in
+domstate)
+	echo "shut off"
+	;;
+migrate)
+	while [ ! -e "$MIGRATE_GATE" ]; do sleep 0.05; done
+	;;
+esac
+exit 0
+`
+
+func TestMigrateHoldsTheRPCLockUntilTheMigrationEnds(t *testing.T) {
+	gate := filepath.Join(t.TempDir(), "gate")
+	t.Setenv("MIGRATE_GATE", gate)
+	p, _ := newScriptedVirshProvider(t, gatedMigrateVirsh)
+	p.tasks = newTaskTracker()
+
+	var taken, released atomic.Bool
+	ctx := WithTaskHold(context.Background(), func() func() {
+		taken.Store(true)
+		return func() { released.Store(true) }
+	})
+
+	taskID, err := p.Migrate(ctx, "vm1", "host-b", MigrateOptions{})
+	require.NoError(t, err)
+	assert.True(t, taken.Load(), "the migration did not take over the RPC lock")
+
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, released.Load(), "the RPC lock was released while the migration runs")
+
+	require.NoError(t, os.WriteFile(gate, nil, 0o600))
+	require.Eventually(t, released.Load, 5*time.Second, 10*time.Millisecond)
+	task, ok := p.tasks.get(taskID)
+	require.True(t, ok)
+	assert.True(t, task.Done)
+	assert.Empty(t, task.Error)
+}
+
+func TestHoldForTaskWithoutHold(t *testing.T) {
+	release := holdForTask(context.Background())
+	require.NotNil(t, release)
+	release()
+}
+
+func TestDomainLookupFailuresAreOnlyNotFoundWhenTheDomainIsGone(t *testing.T) {
+	p, _ := newScriptedVirshProvider(t, `#!/bin/sh
+case "$*" in
+*gone*) echo "error: failed to get domain 'gone'" >&2 ;;
+*) echo "error: unexpected failure talking to the daemon" >&2 ;;
+esac
+exit 1
+`)
+	p.options.AllowGuestExec = true
+	p.options.AllowDebugRPCs = true
+	ctx := context.Background()
+
+	calls := map[string]func(vmID string) error{
+		"Migrate": func(vmID string) error {
+			_, err := p.Migrate(ctx, vmID, "host2", MigrateOptions{})
+			return err
+		},
+		"ExecInGuest": func(vmID string) error {
+			_, err := p.ExecInGuest(ctx, vmID, "/bin/true", nil, time.Second)
+			return err
+		},
+		"GetRawXML": func(vmID string) error {
+			_, err := p.GetRawXML(ctx, vmID, false)
+			return err
+		},
+		"ConsolidateSnapshots": func(vmID string) error {
+			_, err := p.ConsolidateSnapshots(ctx, vmID, "", nil)
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			var providerErr *contracts.ProviderError
+			err := call("gone")
+			require.True(t, errors.As(err, &providerErr), "got %v", err)
+			assert.Equal(t, contracts.ErrorTypeNotFound, providerErr.Type)
+
+			err = call("web")
+			require.True(t, errors.As(err, &providerErr), "got %v", err)
+			assert.Equal(t, contracts.ErrorTypeRetryable, providerErr.Type)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// networkSourceNetwork attaches an interface to a libvirt-managed network
+	networkSourceNetwork = "network"
+
+	// networkSourceBridge attaches an interface to a host bridge
+	networkSourceBridge = "bridge"
+
+	// networkSourceDirect attaches an interface to a host NIC through macvtap
+	networkSourceDirect = "direct"
+
+	// networkSourceHostdev passes an SR-IOV virtual function through to the guest
+	networkSourceHostdev = "hostdev"
+
+	// networkSourceUser is QEMU user-mode networking, used when nothing else is set
+	networkSourceUser = "user"
+
+	// hostNetDir lists the network interfaces of the host by name
+	hostNetDir = "/sys/class/net"
+)
+
+// directModes are the macvtap modes accepted for direct attachments; libvirt defaults to vepa
+var directModes = []string{"vepa", "bridge", "private"}
+
+// networkSourceType returns how an attachment connects to the host. An explicit SourceType
+// wins; otherwise a bridge or network name is inferred, falling back to user-mode networking.
+func networkSourceType(attachment contracts.NetworkAttachment) string {
+	switch {
+	case attachment.SourceType != "":
+		return strings.ToLower(attachment.SourceType)
+	case attachment.Bridge != "":
+		return networkSourceBridge
+	case attachment.NetworkName != "":
+		return networkSourceNetwork
+	default:
+		return networkSourceUser
+	}
+}
+
+// validateNetworkSources checks the source type of each attachment and the fields it needs
+func (p *Provider) validateNetworkSources(networks []contracts.NetworkAttachment) error {
+	vfs := make(map[string]bool)
+	for _, attachment := range p.withNetworkDefaults(networks) {
+		sourceType := networkSourceType(attachment)
+		if attachment.DirectMode != "" && sourceType != networkSourceDirect {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: a macvtap mode is only valid for direct attachments", attachment.Name), nil)
+		}
+
+		switch sourceType {
+		case networkSourceNetwork:
+			if attachment.NetworkName == "" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: network attachments need a network name", attachment.Name), nil)
+			}
+		case networkSourceBridge:
+			if attachment.Bridge == "" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: bridge attachments need a bridge", attachment.Name), nil)
+			}
+		case networkSourceDirect:
+			if attachment.SourceDevice == "" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: direct attachments need a host interface", attachment.Name), nil)
+			}
+			if mode := strings.ToLower(attachment.DirectMode); mode != "" && !slices.Contains(directModes, mode) {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: unsupported macvtap mode %q (supported: %s)",
+					attachment.Name, attachment.DirectMode, strings.Join(directModes, ", ")), nil)
+			}
+		case networkSourceHostdev:
+			address, err := parsePCIAddress(attachment.SourceDevice)
+			if err != nil {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: %v", attachment.Name, err), nil)
+			}
+			if vfs[address.String()] {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("virtual function %s is requested twice", address), nil)
+			}
+			vfs[address.String()] = true
+			if attachment.Bandwidth != nil {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: bandwidth shaping is not supported for SR-IOV virtual functions", attachment.Name), nil)
+			}
+		case networkSourceUser:
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: unsupported source type %q (supported: network, bridge, direct, hostdev)",
+				attachment.Name, attachment.SourceType), nil)
+		}
+	}
+	return nil
+}
+
+// interfaceSourceXML returns the attributes of the <interface> element of an attachment and
+// its <source> element indented by indent; user-mode interfaces have no source
+func interfaceSourceXML(attachment contracts.NetworkAttachment, indent string) (attrs, source string) {
+	sourceType := networkSourceType(attachment)
+	switch sourceType {
+	case networkSourceBridge:
+		source = fmt.Sprintf("\n%s<source bridge='%s'/>", indent, xmlEscape(attachment.Bridge))
+	case networkSourceNetwork:
+		source = fmt.Sprintf("\n%s<source network='%s'/>", indent, xmlEscape(attachment.NetworkName))
+	case networkSourceDirect:
+		mode := strings.ToLower(attachment.DirectMode)
+		if mode == "" {
+			mode = directModes[0]
+		}
+		source = fmt.Sprintf("\n%s<source dev='%s' mode='%s'/>", indent, xmlEscape(attachment.SourceDevice), mode)
+	case networkSourceHostdev:
+		// managed lets libvirt detach the VF from its host driver and return it on shutdown
+		address, _ := parsePCIAddress(attachment.SourceDevice)
+		source = fmt.Sprintf("\n%s<source>\n%s  <address type='pci' domain='0x%s' bus='0x%s' slot='0x%s' function='0x%s'/>\n%s</source>",
+			indent, indent, address.Domain, address.Bus, address.Slot, address.Function, indent)
+		return fmt.Sprintf(" type='%s' managed='yes'", sourceType), source
+	}
+	return fmt.Sprintf(" type='%s'", sourceType), source
+}
+
+// interfaceModelXML returns the <model> element of an attachment; a passed-through VF has none
+func interfaceModelXML(attachment contracts.NetworkAttachment, indent string) string {
+	if networkSourceType(attachment) == networkSourceHostdev {
+		return ""
+	}
+	model := "virtio"
+	if attachment.Model != "" {
+		model = attachment.Model
+	}
+	return fmt.Sprintf("\n%s<model type='%s'/>", indent, xmlEscape(model))
+}
+
+// checkDirectDeviceAvailable verifies that the host NIC of a direct attachment exists
+func (p *Provider) checkDirectDeviceAvailable(ctx context.Context, device string) error {
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-e", hostNetDir+"/"+device); err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("network interface %s not found on host", device), err)
+	}
+	return nil
+}
+
+// checkVirtualFunctionAvailable verifies that the PCI address of a hostdev attachment is an
+// SR-IOV virtual function on the host
+func (p *Provider) checkVirtualFunctionAvailable(ctx context.Context, device string) error {
+	address, err := parsePCIAddress(device)
+	if err != nil {
+		return contracts.NewInvalidSpecError(err.Error(), nil)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-c", "/dev/kvm"); err != nil {
+		return contracts.NewNotSupportedError("SR-IOV assignment requires KVM, but /dev/kvm is not available on the libvirt host")
+	}
+	devicePath := pciDevicesDir + "/" + address.String()
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-e", devicePath); err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("PCI device %s not found on host", address), err)
+	}
+	// Only virtual functions link to their physical function
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-e", devicePath+"/physfn"); err != nil {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("PCI device %s is not an SR-IOV virtual function", address), err)
+	}
+	return nil
+}
+
+// probeSRIOV records whether the host can assign SR-IOV virtual functions: it needs an
+// IOMMU and at least one NIC able to create virtual functions
+func (p *Provider) probeSRIOV(ctx context.Context) {
+	script := "ls /sys/kernel/iommu_groups | grep -q . && cat " + hostNetDir + "/*/device/sriov_totalvfs 2>/dev/null"
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "sh", "-c", p.virshProvider.quoteRemoteArg(script))
+	supported := false
+	if err == nil {
+		for _, line := range strings.Fields(result.Stdout) {
+			if n, err := strconv.Atoi(line); err == nil && n > 0 {
+				supported = true
+				break
+			}
+		}
+	}
+	p.sriovSupported.Store(supported)
+	if supported {
+		log.Printf("INFO Host supports SR-IOV virtual function assignment")
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// ReconcileNetworkInterfaces makes the domain's interfaces match the desired attachments.
+// Interfaces are matched by MAC address: desired MACs that are missing are hot-plugged,
+// attached MACs that are no longer desired are unplugged and changed bandwidth limits
+// are updated in place, so repeated calls are no-ops. Attachments without a MAC use the
+// address derived from the domain UUID and their index, as at creation.
+func (p *Provider) ReconcileNetworkInterfaces(ctx context.Context, vmID string, desired []contracts.NetworkAttachment) error {
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	if err := vmspec.ValidateNetworkBandwidth(desired); err != nil {
+		return err
+	}
+	if err := p.validateNetworkSources(desired); err != nil {
+		return err
+	}
+	desired = p.withNetworkDefaults(desired)
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+
+	// Attachments without a MAC get the address creation derived for them, so an unchanged
+	// spec matches the interfaces the VM already has
+	desired, err = p.desiredMACAddresses(ctx, domain, desired)
+	if err != nil {
+		return err
+	}
+
+	desiredByMAC := make(map[string]contracts.NetworkAttachment, len(desired))
+	for _, attachment := range desired {
+		mac, err := net.ParseMAC(attachment.MacAddress)
+		if err != nil {
+			return contracts.NewInvalidSpecError(
+				fmt.Sprintf("network attachment %q has an invalid MAC address", attachment.Name), err)
+		}
+		attachment.MacAddress = mac.String()
+		desiredByMAC[attachment.MacAddress] = attachment
+	}
+
+	scope, err := p.virshProvider.domainChangeScope(ctx, vmID)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	current := make(map[string]domainInterface, len(domain.Devices.Interfaces))
+	for _, iface := range domain.Devices.Interfaces {
+		current[strings.ToLower(iface.MAC.Address)] = iface
+	}
+
+	// Validate every new attachment before touching the domain
+	for mac, attachment := range desiredByMAC {
+		if _, ok := current[mac]; ok {
+			continue
+		}
+		if err := p.checkNetworkAvailable(ctx, attachment); err != nil {
+			return err
+		}
+	}
+
+	for mac, iface := range current {
+		if _, ok := desiredByMAC[mac]; ok {
+			continue
+		}
+		log.Printf("INFO Detaching interface %s from domain %s", mac, vmID)
+		detachXML := fmt.Sprintf("<interface type='%s'>\n  <mac address='%s'/>\n</interface>", xmlEscape(iface.Type), mac)
+		if err := p.applyDeviceXML(ctx, "detach-device", vmID, detachXML, scope); err != nil {
+			return fmt.Errorf("failed to detach interface %s: %w", mac, err)
+		}
+	}
+
+	for mac, attachment := range desiredByMAC {
+		if iface, ok := current[mac]; ok {
+			if bandwidthEqual(iface.Bandwidth, attachment.Bandwidth) {
+				continue
+			}
+			log.Printf("INFO Updating bandwidth of interface %s on domain %s", mac, vmID)
+			if err := p.applyDeviceXML(ctx, "update-device", vmID, renderInterfaceXML(attachment), scope); err != nil {
+				return fmt.Errorf("failed to update interface %s: %w", mac, err)
+			}
+			continue
+		}
+		log.Printf("INFO Attaching interface %s to domain %s", mac, vmID)
+		if err := p.applyDeviceXML(ctx, "attach-device", vmID, renderInterfaceXML(attachment), scope); err != nil {
+			return fmt.Errorf("failed to attach interface %s: %w", mac, err)
+		}
+	}
+
+	return nil
+}
+
+// desiredMACAddresses derives the MAC of each attachment that does not set one the way
+// assignMACAddresses did when the domain was created
+func (p *Provider) desiredMACAddresses(ctx context.Context, domain *domainXML, desired []contracts.NetworkAttachment) ([]contracts.NetworkAttachment, error) {
+	missing := false
+	for _, attachment := range desired {
+		if attachment.MacAddress == "" {
+			missing = true
+			break
+		}
+	}
+	if !missing {
+		return desired, nil
+	}
+
+	taken, err := p.virshProvider.hostMACAddresses(ctx)
+	if err != nil {
+		return nil, contracts.NewRetryableError("failed to list MAC addresses in use on the host", err)
+	}
+	// The VM's own interfaces are the ones the derived addresses should match
+	for mac, owner := range taken {
+		if owner == domain.Name {
+			delete(taken, mac)
+		}
+	}
+	return p.deriveMACAddresses(domain.UUID, desired, taken)
+}
+
+// withNetworkDefaults fills in the configured default network and interface model
+// for attachments that do not set them
+func (p *Provider) withNetworkDefaults(networks []contracts.NetworkAttachment) []contracts.NetworkAttachment {
+	if p.options.DefaultNetwork == "" && p.options.DefaultNICModel == "" {
+		return networks
+	}
+
+	result := make([]contracts.NetworkAttachment, len(networks))
+	for i, attachment := range networks {
+		// Direct and hostdev attachments do not use a libvirt network
+		if attachment.NetworkName == "" && (attachment.SourceType == "" && attachment.Bridge == "" ||
+			strings.EqualFold(attachment.SourceType, networkSourceNetwork)) {
+			attachment.NetworkName = p.options.DefaultNetwork
+		}
+		if attachment.Model == "" {
+			attachment.Model = p.options.DefaultNICModel
+		}
+		result[i] = attachment
+	}
+	return result
+}
+
+// renderInterfaceXML renders a hot-pluggable <interface> element; libvirt assigns the PCI address
+func renderInterfaceXML(attachment contracts.NetworkAttachment) string {
+	attrs, source := interfaceSourceXML(attachment, "  ")
+	return fmt.Sprintf("<interface%s>\n  <mac address='%s'/>%s%s%s\n</interface>",
+		attrs, xmlEscape(attachment.MacAddress), source, interfaceModelXML(attachment, "  "), bandwidthXML(attachment.Bandwidth, "  "))
+}
+
+// applyDeviceXML runs attach-device, detach-device or update-device with a device definition.
+// Changes apply to the definitions of scope.
+func (p *Provider) applyDeviceXML(ctx context.Context, command, vmID, deviceXML string, scope changeScope) error {
+	devicePath := fmt.Sprintf("/tmp/%s-device-%s.xml", vmID, generateTaskID())
+	if err := NewCloudInitProvider(p.virshProvider).writeRemoteFile(ctx, devicePath, deviceXML); err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", devicePath)
+	}()
+
+	args := append([]string{command, vmID, devicePath}, scope.flags()...)
+	_, err := p.virshProvider.runVirshCommand(ctx, args...)
+	return err
+}
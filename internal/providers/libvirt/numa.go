@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// hugepagesSysfsDir is where the kernel exposes the hugepage pools per page size
+const hugepagesSysfsDir = "/sys/kernel/mm/hugepages"
+
+// checkHugepagesAvailable verifies that the host has enough free hugepages of the requested size
+func (p *Provider) checkHugepagesAvailable(ctx context.Context, class contracts.VMClass) error {
+	size := class.HugepageSizeKiB
+	if size == 0 {
+		return nil
+	}
+
+	dir := fmt.Sprintf("%s/hugepages-%dkB", hugepagesSysfsDir, size)
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "cat", dir+"/nr_hugepages", dir+"/free_hugepages")
+	if err != nil {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"hugepage size %d KiB is not supported by the host (%s not found)", size, dir), err)
+	}
+
+	fields := strings.Fields(result.Stdout)
+	if len(fields) != 2 {
+		return contracts.NewRetryableError(fmt.Sprintf("unexpected hugepage counters in %s: %q", dir, result.Stdout), nil)
+	}
+	reserved, _ := strconv.ParseInt(fields[0], 10, 64)
+	free, _ := strconv.ParseInt(fields[1], 10, 64)
+	if reserved == 0 {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"no %d KiB hugepages are reserved on the host; set %s/nr_hugepages or the hugepages= kernel parameter", size, dir), nil)
+	}
+
+	required := vmspec.MemoryMiB(class) * 1024 / size
+	if free < required {
+		return contracts.NewQuotaExceededError(fmt.Sprintf(
+			"VM needs %d free %d KiB hugepages but the host has %d free of %d reserved", required, size, free, reserved), nil)
+	}
+	return nil
+}
+
+// numaXML renders the <numa> element nested in <cpu>
+func numaXML(cells []contracts.NUMACell) string {
+	if len(cells) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(`
+    <numa>`)
+	for i, cell := range cells {
+		fmt.Fprintf(&b, `
+      <cell id='%d' cpus='%s' memory='%d' unit='MiB'/>`, i, xmlEscape(strings.ReplaceAll(cell.CPUs, " ", "")), cell.MemoryMiB)
+	}
+	b.WriteString(`
+    </numa>`)
+	return b.String()
+}
+
+// memoryBackingXML renders the <memoryBacking> element for hugepage-backed memory
+func memoryBackingXML(class contracts.VMClass) string {
+	if class.HugepageSizeKiB == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`  <memoryBacking>
+    <hugepages>
+      <page size='%d' unit='KiB'/>
+    </hugepages>
+  </memoryBacking>
+`, class.HugepageSizeKiB)
+}
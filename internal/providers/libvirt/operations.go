@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// operationPollInterval is how often WatchOperation samples a tracked operation
+const operationPollInterval = time.Second
+
+// States reported by WatchOperation
+const (
+	OperationRunning   = "running"
+	OperationCompleted = "completed"
+	OperationFailed    = "failed"
+	OperationAborted   = "aborted"
+)
+
+// OperationProgress is a sample of a long-running operation
+type OperationProgress struct {
+	OperationID     string
+	State           string
+	ProgressPercent int32
+	ProcessedBytes  int64
+	TotalBytes      int64
+	// BytesPerSecond is the throughput since the previous sample; the final sample carries the average
+	BytesPerSecond int64
+	Message        string
+	Error          string
+}
+
+// WatchOperation reports the progress of a tracked clone, migration, snapshot consolidation or
+// image conversion through send, starting with its current state and then whenever it changes,
+// and returns once the final sample (completed, failed or aborted) was sent. Ending ctx stops
+// watching but leaves the operation running.
+func (p *Provider) WatchOperation(ctx context.Context, operationID string, send func(OperationProgress) error) error {
+	if p.tasks == nil {
+		return contracts.NewRetryableError("task tracker not initialized", nil)
+	}
+	task, ok := p.tasks.get(operationID)
+	if !ok {
+		return contracts.NewNotFoundError(fmt.Sprintf("operation %s not found", operationID), nil)
+	}
+
+	ticker := time.NewTicker(operationPollInterval)
+	defer ticker.Stop()
+
+	previous := taskState{UpdatedAt: task.StartedAt}
+	sent := false
+	for {
+		if !sent || !task.UpdatedAt.Equal(previous.UpdatedAt) {
+			if err := send(operationSample(operationID, task, previous)); err != nil {
+				return err
+			}
+			if task.Done {
+				return nil
+			}
+			previous, sent = task, true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if task, ok = p.tasks.get(operationID); !ok {
+			// Only completed tasks expire, so the last sample already reported the outcome
+			return nil
+		}
+	}
+}
+
+// operationSample converts a task to a progress sample, measuring throughput against the previous sample
+func operationSample(operationID string, task, previous taskState) OperationProgress {
+	sample := OperationProgress{
+		OperationID:     operationID,
+		State:           OperationRunning,
+		ProgressPercent: task.Progress,
+		ProcessedBytes:  task.ProcessedBytes,
+		TotalBytes:      task.TotalBytes,
+		Message:         task.Message,
+		Error:           task.Error,
+	}
+	switch {
+	case task.Aborted:
+		sample.State = OperationAborted
+	case task.Done && task.Error != "":
+		sample.State = OperationFailed
+	case task.Done:
+		sample.State = OperationCompleted
+	}
+
+	// The final sample averages over the whole operation
+	if task.Done {
+		previous = taskState{UpdatedAt: task.StartedAt}
+	}
+	if elapsed := task.UpdatedAt.Sub(previous.UpdatedAt).Seconds(); elapsed > 0 && task.ProcessedBytes > previous.ProcessedBytes {
+		sample.BytesPerSecond = int64(float64(task.ProcessedBytes-previous.ProcessedBytes) / elapsed)
+	}
+	return sample
+}
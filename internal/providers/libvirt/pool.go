@@ -0,0 +1,324 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultPoolSize is the number of pooled connections per libvirt URI unless the
+	// operation limit needs more (see reservePoolSlots)
+	defaultPoolSize = 4
+
+	// poolControlHeadroom is the number of slots kept beyond the operation limit for the
+	// commands that poll, abort and health-check running operations
+	poolControlHeadroom = 4
+
+	// defaultPoolIdleTTL is how long an unused connection is kept open
+	defaultPoolIdleTTL = 5 * time.Minute
+)
+
+// pooledConn is a connection slot. Only password-authenticated SSH endpoints, which run
+// virsh through sshpass, reuse a transport: each slot owns an SSH ControlMaster socket, so
+// consecutive commands share one authenticated session. Local and key-based URIs are run
+// by virsh itself, which connects anew for every command; for them a slot only bounds
+// how many commands run at once.
+type pooledConn struct {
+	id          int
+	controlPath string
+	target      string
+	lastUsed    time.Time
+	established bool
+
+	// generation is the pool generation the connection was checked out in
+	generation int
+}
+
+// connPool bounds and reuses connections to a single libvirt URI
+type connPool struct {
+	size  int
+	ttl   time.Duration
+	slots chan *pooledConn
+
+	mu    sync.Mutex
+	conns []*pooledConn
+	inUse int
+
+	// generation is bumped when libvirt restarts; connections from an older
+	// generation are redialed before reuse
+	generation int
+
+	// watch is the connection of the libvirt event session, kept out of the slots
+	watch *pooledConn
+
+	// dir holds the control sockets; streams counts the connections handed out by streamConn
+	dir     string
+	streams int
+}
+
+var (
+	poolsMu sync.Mutex
+	pools   = map[string]*connPool{}
+
+	// poolMinSize is the size reserved for the operation limit by reservePoolSlots
+	poolMinSize int
+)
+
+// reservePoolSlots sizes pools created from now on to hold opLimit operations plus
+// poolControlHeadroom slots for the commands that poll and abort them
+func reservePoolSlots(opLimit int) {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	poolMinSize = 0
+	if opLimit > 0 {
+		poolMinSize = opLimit + poolControlHeadroom
+	}
+	for uri, pool := range pools {
+		if pool.size < poolMinSize {
+			log.Printf("WARN Libvirt connection pool for %s was created with %d slots, fewer than the %d reserved for %d concurrent operations",
+				uri, pool.size, poolMinSize, opLimit)
+		}
+	}
+}
+
+// getConnPool returns the shared pool for a libvirt URI, creating it on first use
+func getConnPool(uri string) *connPool {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	if pool, ok := pools[uri]; ok {
+		return pool
+	}
+
+	pool := newConnPool(uri, max(poolSizeFromEnv(), poolMinSize), poolIdleTTLFromEnv())
+	pools[uri] = pool
+	log.Printf("INFO Created libvirt connection pool for %s (size=%d, idle_ttl=%s)", uri, pool.size, pool.ttl)
+	return pool
+}
+
+// newConnPool creates a pool with the given number of slots
+func newConnPool(uri string, size int, ttl time.Duration) *connPool {
+	pool := &connPool{
+		size:  size,
+		ttl:   ttl,
+		slots: make(chan *pooledConn, size),
+	}
+
+	// Control sockets live under a short per-URI directory (unix socket paths are length-limited)
+	sum := sha256.Sum256([]byte(uri))
+	dir := filepath.Join(os.TempDir(), fmt.Sprintf("vrtg-%x", sum[:4]))
+	_ = os.MkdirAll(dir, 0o700)
+	pool.dir = dir
+
+	for i := 0; i < size; i++ {
+		conn := &pooledConn{
+			id:          i,
+			controlPath: filepath.Join(dir, strconv.Itoa(i)),
+		}
+		pool.conns = append(pool.conns, conn)
+		pool.slots <- conn
+	}
+	pool.watch = &pooledConn{id: size, controlPath: filepath.Join(dir, "watch")}
+
+	pool.reportMetrics()
+	return pool
+}
+
+// acquire checks out a connection, waiting for a free slot if necessary. Connections idle
+// beyond the TTL, opened before libvirt restarted or whose SSH master has exited are
+// closed before reuse.
+func (p *connPool) acquire(ctx context.Context) (*pooledConn, error) {
+	select {
+	case conn := <-p.slots:
+		if !p.alive(conn) {
+			log.Printf("DEBUG Closing libvirt connection %d whose SSH master has exited", conn.id)
+			p.close(conn)
+		}
+		p.mu.Lock()
+		if conn.established && time.Since(conn.lastUsed) > p.ttl {
+			p.mu.Unlock()
+			log.Printf("DEBUG Closing idle libvirt connection %d (idle %s)", conn.id, time.Since(conn.lastUsed).Round(time.Second))
+			p.close(conn)
+			p.mu.Lock()
+		} else if conn.established && conn.generation != p.generation {
+			p.mu.Unlock()
+			log.Printf("DEBUG Closing libvirt connection %d opened before the last reconnect", conn.id)
+			p.close(conn)
+			p.mu.Lock()
+		}
+		conn.generation = p.generation
+		p.inUse++
+		p.mu.Unlock()
+		p.reportMetrics()
+		return conn, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// alive asks the SSH master of an established connection whether it still runs, which
+// costs no round trip to the host. Connections without a master have nothing to check.
+func (p *connPool) alive(conn *pooledConn) bool {
+	p.mu.Lock()
+	established, target := conn.established, conn.target
+	p.mu.Unlock()
+	if !established || target == "" {
+		return true
+	}
+	if _, err := os.Stat(conn.controlPath); err != nil {
+		return false
+	}
+	return exec.Command("ssh", "-o", "ControlPath="+conn.controlPath, "-O", "check", target).Run() == nil
+}
+
+// release returns a connection to the pool
+func (p *connPool) release(conn *pooledConn) {
+	p.mu.Lock()
+	conn.lastUsed = time.Now()
+	conn.established = true
+	p.inUse--
+	p.mu.Unlock()
+	p.reportMetrics()
+	p.slots <- conn
+}
+
+// close tears down the transport behind a connection so the next use reconnects
+func (p *connPool) close(conn *pooledConn) {
+	if _, err := os.Stat(conn.controlPath); err == nil {
+		// Ask the SSH master to exit; fall back to removing a stale socket
+		if conn.target == "" || exec.Command("ssh", "-o", "ControlPath="+conn.controlPath, "-O", "exit", conn.target).Run() != nil {
+			_ = os.Remove(conn.controlPath)
+		}
+	}
+
+	p.mu.Lock()
+	conn.established = false
+	p.mu.Unlock()
+}
+
+// streamConn returns a dedicated connection for a long-running event stream or job. Like
+// the watch connection it is kept out of the slots, so streams and jobs never starve RPCs;
+// the caller closes it when it is done.
+func (p *connPool) streamConn() *pooledConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.streams++
+	return &pooledConn{
+		id:          p.size + p.streams,
+		controlPath: filepath.Join(p.dir, "stream-"+strconv.Itoa(p.streams)),
+		generation:  p.generation,
+	}
+}
+
+// invalidate marks every connection as stale, so that each is redialed on its next use
+func (p *connPool) invalidate() {
+	p.mu.Lock()
+	p.generation++
+	p.mu.Unlock()
+}
+
+// sshOptions returns the SSH multiplexing options for a connection to target (user@host)
+func (p *connPool) sshOptions(conn *pooledConn, target string) []string {
+	p.mu.Lock()
+	conn.target = target
+	p.mu.Unlock()
+
+	return []string{
+		"-o", "ControlMaster=auto",
+		"-o", "ControlPath=" + conn.controlPath,
+		"-o", fmt.Sprintf("ControlPersist=%d", int(p.ttl.Seconds())),
+	}
+}
+
+// reportMetrics publishes in-use and idle connection counts
+func (p *connPool) reportMetrics() {
+	p.mu.Lock()
+	inUse := p.inUse
+	idle := 0
+	for _, conn := range p.conns {
+		if conn.established && time.Since(conn.lastUsed) <= p.ttl {
+			idle++
+		}
+	}
+	p.mu.Unlock()
+
+	// In-use slots are by definition not idle
+	if idle > p.size-inUse {
+		idle = p.size - inUse
+	}
+	connMetrics.SetPoolConnections(inUse, idle)
+}
+
+// isInvalidConnectionError reports whether a failed command indicates a broken
+// libvirt connection (VIR_ERR_INVALID_CONN, VIR_ERR_SYSTEM_ERROR on the client
+// socket, a daemon that is restarting or a dropped transport)
+func isInvalidConnectionError(stderr string) bool {
+	lower := strings.ToLower(stderr)
+	for _, marker := range []string{
+		"invalid connection",
+		"no connection driver available",
+		"end of file while reading data",
+		"cannot recv data",
+		"connection reset by peer",
+		"broken pipe",
+		"mux_client_request_session",
+		"control socket connect",
+		"client socket is closed",
+		"cannot write data",
+		"failed to connect socket",
+		"failed to connect to the hypervisor",
+	} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// poolSizeFromEnv reads LIBVIRT_POOL_SIZE
+func poolSizeFromEnv() int {
+	if v := os.Getenv("LIBVIRT_POOL_SIZE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+		log.Printf("WARN Invalid LIBVIRT_POOL_SIZE %q, using default %d", v, defaultPoolSize)
+	}
+	return defaultPoolSize
+}
+
+// poolIdleTTLFromEnv reads LIBVIRT_POOL_IDLE_TTL (a Go duration, e.g. "5m")
+func poolIdleTTLFromEnv() time.Duration {
+	if v := os.Getenv("LIBVIRT_POOL_IDLE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+		log.Printf("WARN Invalid LIBVIRT_POOL_IDLE_TTL %q, using default %s", v, defaultPoolIdleTTL)
+	}
+	return defaultPoolIdleTTL
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInvalidConnectionError(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   bool
+	}{
+		{name: "invalid connection", stderr: "error: invalid connection pointer in virConnectGetVersion", want: true},
+		{name: "daemon restarting", stderr: "error: End of file while reading data: Input/output error", want: true},
+		{name: "socket closed", stderr: "error: internal error: client socket is closed", want: true},
+		{name: "cannot connect", stderr: "error: failed to connect to the hypervisor", want: true},
+		{name: "dropped transport", stderr: "Connection reset by peer", want: true},
+		{name: "stale control socket", stderr: "mux_client_request_session: read from master failed: Broken pipe", want: true},
+		{name: "no domain", stderr: "error: failed to get domain 'vm1'", want: false},
+		{name: "invalid argument", stderr: "error: invalid argument: unsupported flags", want: false},
+		{name: "empty", stderr: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isInvalidConnectionError(tt.stderr))
+		})
+	}
+}
+
+func newTestConnPool(t *testing.T, size int, ttl time.Duration) *connPool {
+	t.Helper()
+	t.Setenv("TMPDIR", t.TempDir())
+	return newConnPool("test:///"+t.Name(), size, ttl)
+}
+
+// establish marks a connection as used with a live control socket
+func establish(t *testing.T, pool *connPool, conn *pooledConn) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(conn.controlPath, nil, 0o600))
+	pool.release(conn)
+}
+
+func TestConnPoolAcquireWaitsForFreeSlot(t *testing.T) {
+	pool := newTestConnPool(t, 2, time.Minute)
+	ctx := context.Background()
+
+	first, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	second, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.id, second.id)
+
+	// With every slot checked out, a caller gives up when its context ends
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(waitCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	cancelled, cancelNow := context.WithCancel(ctx)
+	cancelNow()
+	_, err = pool.acquire(cancelled)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// A released slot is handed to the next waiter
+	got := make(chan *pooledConn, 1)
+	go func() {
+		conn, err := pool.acquire(ctx)
+		if err == nil {
+			got <- conn
+		}
+	}()
+	pool.release(first)
+	select {
+	case conn := <-got:
+		assert.Equal(t, first.id, conn.id)
+		assert.True(t, conn.established)
+	case <-time.After(time.Second):
+		t.Fatal("released connection was not handed out")
+	}
+	assert.Equal(t, 2, pool.inUse)
+}
+
+func TestConnPoolReusesEstablishedConnection(t *testing.T) {
+	pool := newTestConnPool(t, 1, time.Minute)
+	ctx := context.Background()
+
+	conn, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	establish(t, pool, conn)
+
+	again, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.Same(t, conn, again)
+	assert.True(t, again.established)
+	assert.FileExists(t, again.controlPath)
+}
+
+func TestConnPoolClosesIdleConnection(t *testing.T) {
+	pool := newTestConnPool(t, 1, time.Millisecond)
+	ctx := context.Background()
+
+	conn, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	establish(t, pool, conn)
+	time.Sleep(5 * time.Millisecond)
+
+	again, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.False(t, again.established)
+	assert.NoFileExists(t, again.controlPath)
+}
+
+func TestConnPoolInvalidateRedialsConnections(t *testing.T) {
+	pool := newTestConnPool(t, 1, time.Minute)
+	ctx := context.Background()
+
+	conn, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	establish(t, pool, conn)
+
+	pool.invalidate()
+
+	again, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.False(t, again.established)
+	assert.NoFileExists(t, again.controlPath)
+	assert.Equal(t, pool.generation, again.generation)
+
+	// Once redialed the connection is current and kept
+	establish(t, pool, again)
+	kept, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.True(t, kept.established)
+	assert.FileExists(t, kept.controlPath)
+}
+
+func TestConnPoolStreamConnIsOutsideSlots(t *testing.T) {
+	pool := newTestConnPool(t, 1, time.Minute)
+	ctx := context.Background()
+
+	conn, err := pool.acquire(ctx)
+	require.NoError(t, err)
+
+	stream := pool.streamConn()
+	assert.NotEqual(t, conn.controlPath, stream.controlPath)
+	assert.Equal(t, 1, pool.inUse)
+	pool.close(stream)
+	pool.release(conn)
+	assert.Equal(t, 0, pool.inUse)
+}
+
+func TestConnPoolClosesConnectionWhoseMasterExited(t *testing.T) {
+	// The fake ssh master answers checks while $SSH_MASTER exists
+	bin := t.TempDir()
+	master := filepath.Join(bin, "master")
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "ssh"), []byte("#!/bin/sh\n[ -e \"$SSH_MASTER\" ]\n"), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("SSH_MASTER", master)
+	require.NoError(t, os.WriteFile(master, nil, 0o600))
+
+	pool := newTestConnPool(t, 1, time.Minute)
+	ctx := context.Background()
+
+	conn, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	pool.sshOptions(conn, "root@host")
+	establish(t, pool, conn)
+
+	kept, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.True(t, kept.established)
+	pool.release(kept)
+
+	require.NoError(t, os.Remove(master))
+	again, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	assert.False(t, again.established)
+	assert.NoFileExists(t, again.controlPath)
+}
+
+func TestReservePoolSlotsCoversTheOperationLimit(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	t.Cleanup(func() {
+		reservePoolSlots(0)
+		poolsMu.Lock()
+		delete(pools, "test:///"+t.Name())
+		delete(pools, "test:///"+t.Name()+"/unlimited")
+		poolsMu.Unlock()
+	})
+
+	reservePoolSlots(8)
+	assert.Equal(t, 8+poolControlHeadroom, getConnPool("test:///"+t.Name()).size)
+
+	reservePoolSlots(0)
+	assert.Equal(t, defaultPoolSize, getConnPool("test:///"+t.Name()+"/unlimited").size)
+}
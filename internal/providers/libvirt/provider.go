@@ -22,6 +22,8 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"sync"
+	"sync/atomic"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,17 +44,104 @@ type Provider struct {
 
 	// cached credentials
 	credentials *Credentials
+
+	// background operations (e.g. migrations) reported through TaskStatus
+	tasks *taskTracker
+
+	// optional features enabled by the provider server
+	options Options
+
+	// bounds concurrent Create, Clone and Migrate operations (nil = unlimited)
+	limiter *opLimiter
+
+	// set once the virsh provider has initialized and reached libvirt
+	connected atomic.Bool
+
+	// set by Start once the options are in place; connection probes wait for it
+	started atomic.Bool
+
+	// disks with a block job (e.g. snapshot consolidation) started by this provider
+	blockJobs blockJobGuard
+
+	// set when the host can assign SR-IOV virtual functions, probed on connect
+	sriovSupported atomic.Bool
+
+	// name of the host in Options.Hosts this provider connects to ("" for the default connection)
+	host string
+
+	// connections to the named hosts of Options.Hosts, opened on first use
+	hostsMu   sync.Mutex
+	hostConns map[string]*Provider
+
+	// named host of VMs found away from the default connection, by VM ID
+	vmHosts sync.Map
+
+	// resources of running ephemeral VMs, by VM ID, removed by their reaper once the domain is gone
+	ephemeral sync.Map
+}
+
+// Options toggles optional provider features
+type Options struct {
+	// EnableIgnition accepts Ignition configs, delivered to guests through fw_cfg
+	EnableIgnition bool
+	// DefaultNetwork is the libvirt network used for attachments without a network or bridge
+	DefaultNetwork string
+	// DefaultNICModel is the interface model used when an attachment does not set one (default virtio)
+	DefaultNICModel string
+	// MaxConcurrentOps bounds concurrent Create, Clone and Migrate operations (0 = unlimited)
+	MaxConcurrentOps int
+	// MaxQueuedOps is how many operations may wait for a slot before new ones are rejected
+	MaxQueuedOps int
+	// InstanceID identifies this provider in the ownership marker of domains (default "default")
+	InstanceID string
+	// ConsoleLogDir is where serial console logs are written (default /var/log/libvirt/qemu)
+	ConsoleLogDir string
+	// AllowGuestExec enables running commands inside guests through the guest agent
+	AllowGuestExec bool
+	// AllowDebugRPCs enables troubleshooting RPCs such as GetRawXML
+	AllowDebugRPCs bool
+	// AutoCreatePools defines and starts storage pools named by a create request that do not exist
+	AutoCreatePools bool
+	// AllowQEMUPassthrough accepts qemu command-line arguments in create requests
+	AllowQEMUPassthrough bool
+	// QEMUPassthroughPrefixes are the prefixes passthrough options must start with
+	// (nil uses DefaultQEMUPassthroughPrefixes)
+	QEMUPassthroughPrefixes []string
+	// MACOUI is the prefix of the MAC addresses generated for interfaces without one
+	// (empty uses DefaultMACOUI)
+	MACOUI string
+	// Hosts maps host names to libvirt URIs of further hosts this provider manages. Create
+	// places a VM on the host named by its placement; operations on a VM are sent to the
+	// host it is on, while listing, capacity and storage report the default connection.
+	Hosts map[string]string
+	// DomainTemplate is a base <domain> definition every generated domain is merged into;
+	// the generated elements take precedence (see applyDomainTemplate)
+	DomainTemplate string
+	// CPUOvercommit and MemoryOvercommit scale the physical CPUs and memory GetCapacity
+	// reports as schedulable (0 uses DefaultOvercommitRatio)
+	CPUOvercommit    float64
+	MemoryOvercommit float64
+}
+
+// SetOptions enables optional provider features
+func (p *Provider) SetOptions(opts Options) {
+	p.options = opts
+	p.limiter = newOpLimiter(opts.MaxConcurrentOps, opts.MaxQueuedOps)
+	reservePoolSlots(opts.MaxConcurrentOps)
 }
 
 // ProviderConfig represents the configuration for the provider
 type ProviderConfig struct {
 	Spec      ProviderSpec
 	Namespace string
+	// CredentialFiles are read on connect and re-read whenever they change
+	CredentialFiles CredentialFiles
 }
 
 // ProviderSpec represents the spec of the provider configuration
 type ProviderSpec struct {
 	Endpoint            string
+	StoragePool         string
 	CredentialSecretRef CredentialSecretRef
 }
 
@@ -78,23 +167,33 @@ type Credentials struct {
 const (
 	// CredentialsPath is where the controller mounts the credentials secret
 	CredentialsPath = "/etc/virtrigaud/credentials"
+
+	// defaultStoragePool is the storage pool used when none is configured
+	defaultStoragePool = "default"
 )
 
 // Config holds the libvirt provider configuration
 type Config struct {
 	Endpoint      string
+	StoragePool   string
 	Username      string
 	Password      string
 	SSHPrivateKey string
+	// CredentialFiles name mounted secret files holding the connection credentials
+	CredentialFiles CredentialFiles
 }
 
 // New creates a new Libvirt provider that reads configuration from environment and mounted secrets
 func New() *Provider {
 	// Load configuration from environment (set by provider controller)
-	config := &Config{
+	return NewFromConfig(&Config{
 		Endpoint: os.Getenv("PROVIDER_ENDPOINT"),
-	}
+	})
+}
 
+// NewFromConfig creates a new Libvirt provider from an already resolved configuration.
+// Credentials are still read from environment variables and mounted secrets.
+func NewFromConfig(config *Config) *Provider {
 	// Credentials are now loaded by virsh provider from environment variables
 
 	p := &Provider{
@@ -104,24 +203,28 @@ func New() *Provider {
 			Username: config.Username,
 			Password: config.Password,
 		},
+		tasks: newTaskTracker(),
 	}
 
 	// Try to establish libvirt connection
 	slog.Info("Libvirt provider configuration loaded",
 		"endpoint", config.Endpoint,
+		"storage_pool", config.StoragePool,
 		"username", config.Username,
 		"password_length", len(config.Password))
 
 	// Create provider configuration
 	providerConfig := &ProviderConfig{
 		Spec: ProviderSpec{
-			Endpoint: config.Endpoint,
+			Endpoint:    config.Endpoint,
+			StoragePool: config.StoragePool,
 			CredentialSecretRef: CredentialSecretRef{
 				Name:      "libvirt-credentials", // Default name
 				Namespace: "default",
 			},
 		},
-		Namespace: "default",
+		Namespace:       "default",
+		CredentialFiles: config.CredentialFiles,
 	}
 
 	// Create virsh provider to replace libvirt-go
@@ -140,12 +243,49 @@ func New() *Provider {
 	if err := virshProvider.Initialize(ctx); err != nil {
 		log.Printf("ERROR Failed to initialize virsh provider: %v", err)
 	} else {
+		p.connected.Store(true)
 		log.Printf("INFO Successfully initialized virsh provider")
 	}
 
 	return p
 }
 
+// Connect establishes the libvirt connection, retrying the virsh provider initialization
+// if it failed when the provider was created, and probes it once established
+func (p *Provider) Connect(ctx context.Context) error {
+	if p.virshProvider == nil {
+		return fmt.Errorf("virsh provider not initialized")
+	}
+	if p.connected.Load() {
+		return p.CheckHealth(ctx)
+	}
+	if err := p.virshProvider.Initialize(ctx); err != nil {
+		return err
+	}
+	p.connected.Store(true)
+	p.onConnected(ctx)
+	return nil
+}
+
+// Start begins the background work of the provider once SetOptions has been called: the
+// SR-IOV probe and the reapers of running ephemeral domains, which depend on the instance ID.
+// A provider that is not connected yet starts them when Connect succeeds.
+func (p *Provider) Start(ctx context.Context) {
+	p.started.Store(true)
+	if p.connected.Load() {
+		p.onConnected(ctx)
+	}
+}
+
+// onConnected probes a newly established connection, unless the provider has not been started
+func (p *Provider) onConnected(ctx context.Context) {
+	if !p.started.Load() {
+		return
+	}
+	p.probeSRIOV(ctx)
+	p.resumeEphemeralReapers(ctx)
+}
+
 // Removed old file-based credential loading - now using environment variables via virsh provider
 
 // Removed old libvirt-go connection logic - now using virsh provider
@@ -178,6 +318,7 @@ func NewProvider(ctx context.Context, k8sClient client.Client, provider *v1beta1
 		k8sClient:     k8sClient,
 		virshProvider: virshProvider,
 		credentials:   &Credentials{},
+		tasks:         newTaskTracker(),
 	}
 
 	// Initialize the virsh provider
@@ -205,4 +346,17 @@ func (p *Provider) Validate(ctx context.Context) error {
 	return nil
 }
 
+// CheckHealth probes the libvirt connection with a lightweight version query.
+// It implements contracts.HealthChecker.
+func (p *Provider) CheckHealth(ctx context.Context) error {
+	if p.virshProvider == nil {
+		return fmt.Errorf("virsh provider not initialized")
+	}
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "version"); err != nil {
+		return fmt.Errorf("libvirt connection unreachable: %w", err)
+	}
+	return nil
+}
+
 // Contract methods are now implemented in provider_virsh.go using virsh commands
@@ -18,6 +18,7 @@ package libvirt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -29,6 +30,7 @@ import (
 	"github.com/projectbeskar/virtrigaud/internal/diskutil"
 	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
 	"github.com/projectbeskar/virtrigaud/internal/storage"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
 )
 
 // Clean provider implementation using only virsh
@@ -41,93 +43,184 @@ func (p *Provider) Create(ctx context.Context, req contracts.CreateRequest) (con
 		return contracts.CreateResponse{}, contracts.NewRetryableError("virsh provider not initialized", nil)
 	}
 
-	// Check if domain already exists
-	domains, err := p.virshProvider.listDomains(ctx)
+	if err := vmspec.ValidateUUID(req); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+
+	// A placement host selects one of the named hosts of a multi-host provider
+	host, err := p.resolvePlacementHost(ctx, req)
 	if err != nil {
-		return contracts.CreateResponse{}, contracts.NewRetryableError("failed to list existing domains", err)
+		return contracts.CreateResponse{}, err
+	}
+	if host != "" {
+		return p.createOnHost(ctx, host, req)
 	}
 
-	for _, domain := range domains {
-		if domain.Name == req.Name {
-			log.Printf("INFO Domain %s already exists with state: %s", req.Name, domain.State)
-			return contracts.CreateResponse{
-				ID: req.Name,
-			}, nil
+	// Devices the request leaves unset get the defaults of its OS hint
+	req, osWarnings := vmspec.Default(req)
+	for _, warning := range osWarnings {
+		log.Printf("WARN VM %s: %s", req.Name, warning)
+	}
+
+	release, err := p.limiter.acquire(ctx, "Create", createOpWeight)
+	if err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	defer release()
+
+	// Create is idempotent: a domain with the requested name or UUID is returned
+	// unchanged when it matches the request and rejected as a conflict otherwise
+	existing, err := p.findExistingDomain(ctx, req)
+	if err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if existing != nil {
+		if diffs := createSpecDiff(existing, req); len(diffs) > 0 {
+			return contracts.CreateResponse{}, existingDomainConflict(existing, diffs)
 		}
+		log.Printf("INFO Domain %s already exists and matches the request", existing.Name)
+		return contracts.CreateResponse{
+			ID:            existing.Name,
+			AlreadyExists: true,
+			Host:          p.hostLabel(ctx),
+		}, nil
+	}
+
+	// Reject malformed specs and cloud-init payloads before any storage is allocated
+	if err := vmspec.Validate(req); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.validateCloudInitPayload(req); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := validateDisks(req.Disks); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := validateHostDevices(req.HostDevices); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.validateNetworkSources(req.Networks); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := validateBootSpec(req); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkQEMUPassthrough(req.QemuArgs); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkTPMAvailable(ctx, req.Class); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkHostDevicesAvailable(ctx, req.Name, req.HostDevices); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkGraphicsAvailable(ctx, req.Graphics); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if _, err := p.resolveFirmware(ctx, req.Class); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkTuningAvailable(ctx, req.Class); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	if err := p.checkHugepagesAvailable(ctx, req.Class); err != nil {
+		return contracts.CreateResponse{}, err
+	}
+	for _, network := range p.withNetworkDefaults(req.Networks) {
+		if err := p.checkNetworkAvailable(ctx, network); err != nil {
+			return contracts.CreateResponse{}, err
+		}
+	}
+
+	// Interfaces without a MAC get one derived from the UUID, stable when the VM is re-created
+	if req.UUID == "" {
+		req.UUID = p.generateUUID()
+	}
+	if req.Networks, err = p.assignMACAddresses(ctx, req.UUID, req.Networks); err != nil {
+		return contracts.CreateResponse{}, err
 	}
 
 	// Create VM with cloud-init support
 	vmID, err := p.createVMWithCloudInit(ctx, req)
 	if err != nil {
-		return contracts.CreateResponse{}, contracts.NewRetryableError("failed to create VM", err)
+		return contracts.CreateResponse{}, createFailure(err)
 	}
 
 	log.Printf("INFO Successfully created VM: %s with ID: %s", req.Name, vmID)
 	return contracts.CreateResponse{
-		ID: vmID,
+		ID:   vmID,
+		Host: p.hostLabel(ctx),
 	}, nil
 }
 
-// createVMWithCloudInit creates a VM with comprehensive cloud-init support and storage management
-func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.CreateRequest) (string, error) {
+// createFailure keeps the type of a typed create error, so that invalid specs, missing pools and
+// conflicts are not retried; untyped errors are treated as transient
+func createFailure(err error) error {
+	var providerErr *contracts.ProviderError
+	if errors.As(err, &providerErr) {
+		return err
+	}
+	return contracts.NewRetryableError("failed to create VM", err)
+}
+
+// createVMWithCloudInit creates a VM with comprehensive cloud-init support and storage management.
+// A failure after the first volume has been created rolls back everything created so far.
+func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.CreateRequest) (vmID string, err error) {
 	log.Printf("INFO Creating VM with enhanced cloud-init configuration and storage: %s", req.Name)
 
 	// Initialize providers
 	cloudInitProvider := NewCloudInitProvider(p.virshProvider)
 	storageProvider := NewStorageProvider(p.virshProvider)
 
-	// Ensure default storage pool exists and is active
-	if err := storageProvider.EnsureDefaultStoragePool(ctx); err != nil {
-		return "", fmt.Errorf("failed to ensure storage pool: %w", err)
+	// Resolve the pool, target and source of every disk and check that they fit
+	disks, err := p.planDisks(ctx, req)
+	if err != nil {
+		return "", err
 	}
-
-	// Create disk image from template or create empty disk
-	diskVolumeName := fmt.Sprintf("%s-disk", req.Name)
-	var diskPath string
-
-	// Get disk size from VMClass (default to 20GB if not specified)
-	diskSizeGB := p.extractDiskSize(req)
-	log.Printf("INFO Using disk size: %dGB", diskSizeGB)
-
-	// Check if VMImage is specified in the request
-	if imageSpec := p.extractImageSpec(req); imageSpec != "" {
-		log.Printf("INFO Creating disk from image: %s", imageSpec)
-
-		var volume *StorageVolume
-		var err error
-
-		// Determine how to handle the image based on its type
-		if strings.HasPrefix(imageSpec, "http://") || strings.HasPrefix(imageSpec, "https://") {
-			// Handle URL - download the image
-			log.Printf("INFO Downloading cloud image from URL: %s", imageSpec)
-			volume, err = storageProvider.DownloadCloudImage(ctx, imageSpec, diskVolumeName, "default", diskSizeGB)
-		} else if strings.HasPrefix(imageSpec, "/") {
-			// Handle absolute path - copy from existing image file
-			log.Printf("INFO Creating disk from local template file: %s", imageSpec)
-			volume, err = storageProvider.CreateVolumeFromImageFile(ctx, imageSpec, diskVolumeName, "default", diskSizeGB)
-		} else {
-			// Handle template name - look up in predefined templates
-			log.Printf("INFO Creating disk from predefined template: %s", imageSpec)
-			volume, err = storageProvider.CreateVolumeFromTemplate(ctx, imageSpec, diskVolumeName, "default", diskSizeGB)
+	warnings, err := p.checkDiskCapacity(ctx, disks)
+	warnings = append(warnings, shareableDiskWarnings(disks)...)
+	for _, warning := range warnings {
+		log.Printf("WARN %s", warning)
+	}
+	if err != nil {
+		return "", err
+	}
+	reservations, err := p.planDHCPReservations(ctx, req.Networks)
+	if err != nil {
+		return "", err
+	}
+	if err := p.ensureDiskPools(ctx, disks); err != nil {
+		return "", err
+	}
+	// Seed ISOs are kept in the configured pool
+	if seedPool := p.virshProvider.storagePool(); disks[0].Pool.Name != seedPool && req.UserData != nil {
+		if err := p.ensureStoragePool(ctx, poolDefinition{Name: seedPool}, false); err != nil {
+			return "", fmt.Errorf("failed to ensure storage pool %s: %w", seedPool, err)
 		}
+	}
 
-		if err != nil {
-			return "", fmt.Errorf("failed to create disk from image: %w", err)
-		}
-		diskPath = volume.Path
-	} else {
-		// Create empty disk volume
-		log.Printf("INFO Creating empty disk volume: %s", diskVolumeName)
-		volume, err := storageProvider.CreateVolume(ctx, "default", diskVolumeName, "qcow2", diskSizeGB)
-		if err != nil {
-			return "", fmt.Errorf("failed to create disk volume: %w", err)
+	rollback := &createRollback{}
+	defer func() {
+		if err != nil && (len(rollback.volumes) > 0 || len(rollback.reservations) > 0 || rollback.domain != "") {
+			log.Printf("WARN Create of VM %s failed, rolling back: %v", req.Name, err)
+			p.rollbackCreate(ctx, rollback)
 		}
-		diskPath = volume.Path
+	}()
+
+	// Create the root disk from the VM image (or empty) and the data disks not referencing a volume
+	log.Printf("INFO Using root disk size: %dGB", disks[0].SizeGiB)
+	if err := p.createDisks(ctx, storageProvider, disks, rollback); err != nil {
+		return "", err
 	}
 
-	// Prepare cloud-init if provided
-	var cloudInitISOPath string
-	if req.UserData != nil && req.UserData.CloudInitData != "" {
+	// Prepare Ignition or cloud-init if provided
+	var cloudInitISOPath, ignitionPath string
+	if isIgnitionUserData(req.UserData) {
+		ignitionPath, err = p.prepareIgnition(ctx, req.Name, req.UserData.CloudInitData)
+		if err != nil {
+			return "", fmt.Errorf("failed to prepare ignition config: %w", err)
+		}
+	} else if req.UserData != nil && req.UserData.CloudInitData != "" {
 		log.Printf("INFO Preparing cloud-init configuration for VM: %s", req.Name)
 
 		// Extract hostname from cloud-init data
@@ -136,19 +229,19 @@ func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.Crea
 			hostname = req.Name // fallback to VM name
 		}
 
-		// Validate cloud-init data
-		if err := cloudInitProvider.ValidateCloudInitData(req.UserData.CloudInitData); err != nil {
-			return "", fmt.Errorf("invalid cloud-init data: %w", err)
-		}
-
 		// Prepare cloud-init configuration
 		cloudInitConfig := CloudInitConfig{
 			UserData:   req.UserData.CloudInitData,
 			InstanceID: req.Name,
 			Hostname:   hostname,
 		}
+		if req.MetaData != nil {
+			cloudInitConfig.MetaData = req.MetaData.MetaDataYAML
+		}
+		if req.NetworkConfig != nil {
+			cloudInitConfig.NetworkConfig = req.NetworkConfig.NetworkConfigYAML
+		}
 
-		var err error
 		cloudInitISOPath, err = cloudInitProvider.PrepareCloudInit(ctx, cloudInitConfig)
 		if err != nil {
 			return "", fmt.Errorf("failed to prepare cloud-init: %w", err)
@@ -170,6 +263,12 @@ func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.Crea
 			InstanceID: req.Name,
 			Hostname:   req.Name,
 		}
+		if req.MetaData != nil {
+			cloudInitConfig.MetaData = req.MetaData.MetaDataYAML
+		}
+		if req.NetworkConfig != nil {
+			cloudInitConfig.NetworkConfig = req.NetworkConfig.NetworkConfigYAML
+		}
 
 		var err error
 		cloudInitISOPath, err = cloudInitProvider.PrepareCloudInit(ctx, cloudInitConfig)
@@ -186,18 +285,47 @@ func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.Crea
 		}
 	}
 
-	// Generate domain XML with proper disk and cloud-init ISO
-	domainXML, err := p.generateDomainXMLWithStorage(req, diskPath, cloudInitISOPath)
+	firmware, err := p.resolveFirmware(ctx, req.Class)
+	if err != nil {
+		return "", err
+	}
+
+	// Generate domain XML with proper disk and cloud-init ISO or Ignition config
+	domainXML, err := p.generateDomainXMLWithStorage(req, domainStorage{
+		Disks:            disks,
+		CloudInitISOPath: cloudInitISOPath,
+		IgnitionPath:     ignitionPath,
+		Firmware:         firmware,
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to generate domain XML: %w", err)
 	}
+	if err := p.checkTemplatedDomain(ctx, domainXML); err != nil {
+		return "", err
+	}
 
 	// Create domain definition file
 	if err := p.createDomainDefinition(ctx, req.Name, domainXML); err != nil {
 		return "", fmt.Errorf("failed to create domain definition: %w", err)
 	}
 
+	// Reserve static IPs on libvirt networks for the interface MACs
+	if err := p.addDHCPReservations(ctx, reservations, rollback); err != nil {
+		return "", err
+	}
+
+	// An ephemeral VM is started as a transient domain and never defined
+	if req.Ephemeral {
+		if err := p.startTransientDomain(ctx, req.Name); err != nil {
+			return "", fmt.Errorf("failed to start transient domain: %w", err)
+		}
+		p.watchEphemeralDomain(ctx, req.Name)
+		log.Printf("INFO Successfully started ephemeral VM with storage and cloud-init: %s", req.Name)
+		return req.Name, nil
+	}
+
 	// Define the domain in libvirt
+	rollback.domain = req.Name
 	if err := p.defineDomain(ctx, req.Name); err != nil {
 		return "", fmt.Errorf("failed to define domain: %w", err)
 	}
@@ -206,6 +334,36 @@ func (p *Provider) createVMWithCloudInit(ctx context.Context, req contracts.Crea
 	return req.Name, nil
 }
 
+// validateCloudInitPayload checks the user-data, meta-data and network-config of a create request.
+// Ignition user data is validated as an Ignition config instead.
+func (p *Provider) validateCloudInitPayload(req contracts.CreateRequest) error {
+	if isIgnitionUserData(req.UserData) {
+		if !p.options.EnableIgnition {
+			return contracts.NewNotSupportedError("ignition user data is not enabled on this provider")
+		}
+		return validateIgnitionConfig(req.UserData.CloudInitData)
+	}
+
+	cloudInitProvider := NewCloudInitProvider(p.virshProvider)
+
+	if req.UserData != nil && req.UserData.CloudInitData != "" {
+		if err := cloudInitProvider.ValidateCloudInitData(req.UserData.CloudInitData); err != nil {
+			return err
+		}
+	}
+	if req.MetaData != nil {
+		if err := cloudInitProvider.ValidateCloudInitDocument("meta-data", req.MetaData.MetaDataYAML); err != nil {
+			return err
+		}
+	}
+	if req.NetworkConfig != nil {
+		if err := cloudInitProvider.ValidateCloudInitDocument("network-config", req.NetworkConfig.NetworkConfigYAML); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Delete removes a VM using virsh and cleans up all associated resources
 func (p *Provider) Delete(ctx context.Context, id string) (taskRef string, err error) {
 	log.Printf("INFO Deleting VM and all associated resources: %s", id)
@@ -235,36 +393,113 @@ func (p *Provider) Delete(ctx context.Context, id string) (taskRef string, err e
 		return "", nil
 	}
 
+	// Deleting a linked clone template would remove the backing files of its clones
+	if err := p.checkNoLinkedClones(ctx, id, "deleted"); err != nil {
+		return "", err
+	}
+
+	// Read the disks, seed ISO and devices before the domain goes away
+	resources := p.collectDomainResources(ctx, id)
+
+	// A transient domain vanishes once destroyed; take it from its reaper so it is cleaned up here
+	if resources.transient {
+		p.ephemeral.Delete(id)
+	}
+
+	// Stop the domain if running
+	if err := p.virshProvider.destroyDomain(ctx, id); err != nil {
+		log.Printf("WARN Failed to destroy domain %s: %v", id, err)
+		// Continue with undefine even if destroy fails
+	}
+
+	// Remove the domain definition, NVRAM and TPM state (this should also remove storage if --remove-all-storage is used)
+	// However, we'll explicitly delete disks to ensure cleanup
+	if !resources.transient {
+		if err := p.undefineDomainWithState(ctx, id); err != nil {
+			return "", contracts.NewRetryableError("failed to undefine domain", err)
+		}
+	}
+	p.removeDomainResources(ctx, id, resources)
+
+	log.Printf("INFO Successfully deleted domain and all resources: %s", id)
+	return "", nil
+}
+
+// domainResources are the host resources removed together with a domain, read from its
+// definition while the domain still exists
+type domainResources struct {
+	definition       *domainXML
+	diskPaths        []string
+	referenced       map[string]bool
+	keepDisks        bool
+	cloudInitISOPath string
+	transient        bool
+}
+
+// collectDomainResources reads the resources of a domain; failures are logged and the
+// affected resources are left in place rather than failing the delete
+func (p *Provider) collectDomainResources(ctx context.Context, id string) *domainResources {
+	resources := &domainResources{}
+
 	// Get disk paths before deleting the domain
 	diskPaths, err := p.getDomainDiskPaths(ctx, id)
 	if err != nil {
 		log.Printf("WARN Failed to get disk paths for %s: %v", id, err)
 		// Continue with deletion even if we can't get disk paths
 	}
+	resources.diskPaths = diskPaths
 
 	// Get cloud-init ISO path before deleting the domain
-	cloudInitISOPath, err := p.getCloudInitISOPath(ctx, id)
+	resources.cloudInitISOPath, err = p.getCloudInitISOPath(ctx, id)
 	if err != nil {
 		log.Printf("WARN Failed to get cloud-init ISO path for %s: %v", id, err)
 		// Continue with deletion
 	}
 
-	// Stop the domain if running
-	if err := p.virshProvider.destroyDomain(ctx, id); err != nil {
-		log.Printf("WARN Failed to destroy domain %s: %v", id, err)
-		// Continue with undefine even if destroy fails
+	// Remember passed-through devices so they can be returned to the host
+	resources.definition, err = p.virshProvider.getDomainXML(ctx, id)
+	if err != nil {
+		log.Printf("WARN Failed to get domain XML for %s: %v", id, err)
 	}
 
-	// Remove the domain definition (this should also remove storage if --remove-all-storage is used)
-	// However, we'll explicitly delete disks to ensure cleanup
-	if err := p.virshProvider.undefineDomain(ctx, id); err != nil {
-		return "", contracts.NewRetryableError("failed to undefine domain", err)
+	// Volumes attached by reference belong to someone else and are never deleted
+	resources.referenced, err = p.referencedVolumes(ctx, id)
+	resources.keepDisks = err != nil
+	if resources.keepDisks {
+		log.Printf("WARN Failed to read volume ownership of %s, keeping its disks: %v", id, err)
+		resources.diskPaths = nil
+	}
+
+	resources.transient, err = p.virshProvider.isTransientDomain(ctx, id)
+	if err != nil {
+		log.Printf("WARN Failed to read persistence of domain %s: %v", id, err)
+	}
+	return resources
+}
+
+// removeDomainResources removes the resources of a domain that no longer exists. A transient
+// domain leaves its NVRAM and TPM state behind, which undefine removes for a persistent one.
+func (p *Provider) removeDomainResources(ctx context.Context, id string, resources *domainResources) {
+	if domainDef := resources.definition; domainDef != nil {
+		p.reattachHostDevices(ctx, domainDef)
+		p.removeConsoleLogs(ctx, domainDef)
+		p.removeDHCPReservations(ctx, domainDef)
+		if !resources.keepDisks {
+			p.deleteBlockVolumes(ctx, domainDef, resources.referenced)
+		}
+		if resources.transient {
+			p.removeTransientState(ctx, domainDef)
+		}
 	}
 
 	// Delete disk images
-	if len(diskPaths) > 0 {
-		log.Printf("INFO Deleting %d disk(s) for VM %s", len(diskPaths), id)
-		for _, diskPath := range diskPaths {
+	if len(resources.diskPaths) > 0 {
+		log.Printf("INFO Deleting %d disk(s) for VM %s", len(resources.diskPaths), id)
+		for _, diskPath := range resources.diskPaths {
+			if resources.referenced[diskPath] {
+				log.Printf("INFO Keeping referenced volume: %s", diskPath)
+				continue
+			}
 			if err := p.deleteDiskFile(ctx, diskPath); err != nil {
 				log.Printf("WARN Failed to delete disk %s: %v", diskPath, err)
 				// Continue with other deletions
@@ -274,18 +509,20 @@ func (p *Provider) Delete(ctx context.Context, id string) (taskRef string, err e
 		}
 	}
 
+	// Delete Ignition config, if the domain was provisioned with one
+	if err := p.deleteIgnitionVolume(ctx, id); err == nil {
+		log.Printf("INFO Successfully deleted ignition config for: %s", id)
+	}
+
 	// Delete cloud-init ISO
-	if cloudInitISOPath != "" {
-		if err := p.deleteCloudInitResources(ctx, id, cloudInitISOPath); err != nil {
+	if resources.cloudInitISOPath != "" {
+		if err := p.deleteCloudInitResources(ctx, id, resources.cloudInitISOPath); err != nil {
 			log.Printf("WARN Failed to delete cloud-init resources: %v", err)
 			// Continue - not a critical error
 		} else {
 			log.Printf("INFO Successfully deleted cloud-init resources for: %s", id)
 		}
 	}
-
-	log.Printf("INFO Successfully deleted domain and all resources: %s", id)
-	return "", nil
 }
 
 // getDomainDiskPaths retrieves all disk paths for a domain
@@ -350,7 +587,7 @@ func (p *Provider) getCloudInitISOPath(ctx context.Context, domainName string) (
 				if end != -1 {
 					filePath := line[start : start+end]
 					// Check if this is a cloud-init ISO
-					if strings.HasSuffix(filePath, "cloud-init.iso") || strings.Contains(filePath, "virtrigaud-cloudinit") {
+					if strings.HasSuffix(filePath, cloudInitVolumeSuffix) || strings.HasSuffix(filePath, "cloud-init.iso") || strings.Contains(filePath, "virtrigaud-cloudinit") {
 						return filePath, nil
 					}
 				}
@@ -378,6 +615,14 @@ func (p *Provider) deleteDiskFile(ctx context.Context, diskPath string) error {
 func (p *Provider) deleteCloudInitResources(ctx context.Context, domainName, isoPath string) error {
 	log.Printf("INFO Deleting cloud-init resources for: %s", domainName)
 
+	// Seed ISOs live in the image pool as volumes; never remove the pool directory
+	if strings.HasSuffix(isoPath, cloudInitVolumeSuffix) {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "vol-delete", isoPath); err != nil {
+			return fmt.Errorf("failed to delete cloud-init volume %s: %w", isoPath, err)
+		}
+		return nil
+	}
+
 	// Delete the cloud-init directory which contains ISO, user-data, and meta-data
 	// Extract directory from ISO path by removing the filename
 	lastSlash := strings.LastIndex(isoPath, "/")
@@ -414,6 +659,16 @@ func (p *Provider) cleanupOrphanedResources(ctx context.Context, domainName stri
 		}
 	}
 
+	// Try to delete the Ignition config volume
+	if err := p.deleteIgnitionVolume(ctx, domainName); err != nil {
+		log.Printf("DEBUG Could not delete ignition volume for %s: %v", domainName, err)
+	}
+
+	// Try to delete the cloud-init seed volume
+	if err := NewCloudInitProvider(p.virshProvider).DeleteCloudInitVolume(ctx, domainName); err != nil {
+		log.Printf("DEBUG Could not delete cloud-init volume for %s: %v", domainName, err)
+	}
+
 	// Try to delete cloud-init directory
 	cloudInitDir := fmt.Sprintf("/tmp/virtrigaud-cloudinit/%s", domainName)
 	_, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-rf", cloudInitDir)
@@ -432,8 +687,26 @@ func (p *Provider) Power(ctx context.Context, id string, op contracts.PowerOp) (
 		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
 	}
 
+	// A transient domain runs from creation until it stops, and syncing its XML would define it
+	transient, _ := p.virshProvider.isTransientDomain(ctx, id)
+
+	// A running linked clone template would write into the backing files of its clones
+	if op == contracts.PowerOpOn || op == contracts.PowerOpReboot {
+		if err := p.checkNoLinkedClones(ctx, id, "started"); err != nil {
+			return "", err
+		}
+	}
+
 	switch op {
 	case contracts.PowerOpOn:
+		if transient {
+			log.Printf("INFO Ephemeral VM %s is already running", id)
+			return "", nil
+		}
+		// virsh start restores a managed save image rather than booting the guest
+		if saved, _ := p.virshProvider.hasManagedSave(ctx, id); saved {
+			log.Printf("INFO Restoring domain %s from its managed save image", id)
+		}
 		err = p.virshProvider.startDomain(ctx, id)
 		// After starting, sync the persistent XML to match the running state
 		// This prevents "pending changes" in Cockpit by ensuring the persistent
@@ -444,9 +717,15 @@ func (p *Provider) Power(ctx context.Context, id string, op contracts.PowerOp) (
 				// Don't fail the power on operation for this
 			}
 		}
-	case contracts.PowerOpOff:
-		err = p.virshProvider.stopDomain(ctx, id)
+	case contracts.PowerOpOff, contracts.PowerOpShutdownGraceful:
+		// Attempt an agent/ACPI shutdown first and only force stop on timeout
+		_, err = p.ShutdownWithTimeout(ctx, id, defaultGracefulShutdownTimeout)
 	case contracts.PowerOpReboot:
+		// Stopping a transient domain would remove it, so the guest is rebooted in place
+		if transient {
+			_, err = p.RebootVM(ctx, id, false)
+			break
+		}
 		// Restart by stopping then starting
 		if stopErr := p.virshProvider.stopDomain(ctx, id); stopErr != nil {
 			log.Printf("WARN Failed to stop domain for reboot: %v", stopErr)
@@ -458,13 +737,6 @@ func (p *Provider) Power(ctx context.Context, id string, op contracts.PowerOp) (
 				log.Printf("WARN Failed to sync persistent XML for %s: %v", id, syncErr)
 			}
 		}
-	case contracts.PowerOpShutdownGraceful:
-		// Graceful shutdown for libvirt - attempt guest shutdown, fallback to force stop
-		err = p.virshProvider.shutdownDomain(ctx, id)
-		if err != nil {
-			log.Printf("WARN Graceful shutdown failed for %s, falling back to force stop: %v", id, err)
-			err = p.virshProvider.stopDomain(ctx, id)
-		}
 	default:
 		return "", contracts.NewInvalidSpecError(fmt.Sprintf("unsupported power operation: %s", op), nil)
 	}
@@ -477,6 +749,57 @@ func (p *Provider) Power(ctx context.Context, id string, op contracts.PowerOp) (
 	return "", nil
 }
 
+// ShutdownWithTimeout asks the guest to shut down (via the QEMU guest agent, then ACPI)
+// and waits up to timeout for the domain to stop before forcing it off.
+// It reports whether the forced path was taken.
+func (p *Provider) ShutdownWithTimeout(ctx context.Context, id string, timeout time.Duration) (forced bool, err error) {
+	if p.virshProvider == nil {
+		return false, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if timeout <= 0 {
+		timeout = defaultGracefulShutdownTimeout
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if state == "shut off" {
+		log.Printf("INFO Domain %s is already shut off", id)
+		return false, nil
+	}
+
+	log.Printf("INFO Attempting graceful shutdown of %s (timeout: %s)", id, timeout)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "shutdown", id, "--mode", "agent,acpi"); err != nil {
+		log.Printf("WARN Graceful shutdown request failed for %s, falling back to force stop: %v", id, err)
+		return true, p.virshProvider.stopDomain(ctx, id)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			log.Printf("WARN Graceful shutdown of %s timed out after %s, forcing stop", id, timeout)
+			return true, p.virshProvider.stopDomain(ctx, id)
+		case <-ticker.C:
+			// A transient domain is gone rather than shut off once it stops
+			state, err := p.virshProvider.getDomainState(waitCtx, id)
+			if err == nil && state == "shut off" || domainGone(err) {
+				log.Printf("INFO Domain %s shut down gracefully", id)
+				return false, nil
+			}
+		}
+	}
+}
+
 // syncPersistentXML updates the persistent domain definition to match the running state
 // This prevents "pending changes" in management tools like Cockpit by ensuring the
 // persistent XML matches what libvirt expanded (e.g., host-model CPU to specific features)
@@ -515,155 +838,28 @@ func (p *Provider) syncPersistentXML(ctx context.Context, domainName string) err
 	return nil
 }
 
-// Reconfigure updates VM configuration using virsh
-func (p *Provider) Reconfigure(ctx context.Context, id string, desired contracts.CreateRequest) (taskRef string, err error) {
-	log.Printf("INFO Reconfiguring VM: %s", id)
-
-	if p.virshProvider == nil {
-		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
-	}
-
-	hasChanges := false
-	requiresRestart := false
-
-	// Get current domain state
-	domainState, err := p.virshProvider.getDomainState(ctx, id)
-	if err != nil {
-		return "", contracts.NewRetryableError("failed to get domain state", err)
-	}
-
-	isRunning := domainState == "running"
-	log.Printf("INFO Domain %s current state: %s", id, domainState)
-
-	// Get current domain info for comparison
-	currentInfo, err := p.virshProvider.getDomainInfo(ctx, id)
-	if err != nil {
-		return "", contracts.NewRetryableError("failed to get current domain info", err)
-	}
-
-	// Handle CPU changes
-	if desired.Class.CPU > 0 {
-		currentCPUs, err := p.extractCPUCount(currentInfo)
-		if err == nil && currentCPUs != desired.Class.CPU {
-			log.Printf("INFO CPU change requested for %s: %d -> %d", id, currentCPUs, desired.Class.CPU)
-
-			if isRunning {
-				// Try online CPU change with --live flag
-				_, err = p.virshProvider.runVirshCommand(ctx, "setvcpus", id,
-					fmt.Sprintf("%d", desired.Class.CPU), "--live")
-				if err != nil {
-					log.Printf("WARN Online CPU change failed, will require restart: %v", err)
-					requiresRestart = true
-				} else {
-					log.Printf("INFO Successfully changed CPUs online for domain: %s", id)
-					hasChanges = true
-				}
-			} else {
-				// Domain is off, change config
-				_, err = p.virshProvider.runVirshCommand(ctx, "setvcpus", id,
-					fmt.Sprintf("%d", desired.Class.CPU), "--config")
-				if err != nil {
-					log.Printf("WARN Failed to set CPUs in config: %v", err)
-					requiresRestart = true
-				} else {
-					hasChanges = true
-				}
-			}
-		}
-	}
-
-	// Handle Memory changes
-	if desired.Class.MemoryMiB > 0 {
-		currentMemoryKB, err := p.extractMemoryKB(currentInfo)
-		desiredMemoryKB := int64(desired.Class.MemoryMiB) * 1024 // Convert MiB to KiB
-
-		if err == nil && currentMemoryKB != desiredMemoryKB {
-			log.Printf("INFO Memory change requested for %s: %d KiB -> %d KiB", id, currentMemoryKB, desiredMemoryKB)
-
-			if isRunning {
-				// Try online memory change with --live flag
-				_, err = p.virshProvider.runVirshCommand(ctx, "setmem", id,
-					fmt.Sprintf("%dK", desiredMemoryKB), "--live")
-				if err != nil {
-					log.Printf("WARN Online memory change failed, will require restart: %v", err)
-					requiresRestart = true
-				} else {
-					log.Printf("INFO Successfully changed memory online for domain: %s", id)
-					hasChanges = true
-				}
-			} else {
-				// Domain is off, change config
-				_, err = p.virshProvider.runVirshCommand(ctx, "setmem", id,
-					fmt.Sprintf("%dK", desiredMemoryKB), "--config")
-				if err != nil {
-					log.Printf("WARN Failed to set memory in config: %v", err)
-					requiresRestart = true
-				} else {
-					// Also update max memory
-					_, _ = p.virshProvider.runVirshCommand(ctx, "setmaxmem", id,
-						fmt.Sprintf("%dK", desiredMemoryKB), "--config")
-					hasChanges = true
-				}
-			}
-		}
-	}
-
-	// Handle Disk changes
-	if len(desired.Disks) > 0 || (desired.Class.DiskDefaults != nil && desired.Class.DiskDefaults.SizeGiB > 0) {
-		storageProvider := NewStorageProvider(p.virshProvider)
-
-		// Get desired disk size
-		var desiredDiskGB int
-		if desired.Class.DiskDefaults != nil && desired.Class.DiskDefaults.SizeGiB > 0 {
-			desiredDiskGB = int(desired.Class.DiskDefaults.SizeGiB)
-		}
-
-		if desiredDiskGB > 0 {
-			// Find the VM's disk volume
-			volumeName := fmt.Sprintf("%s-disk", id)
-
-			// Try to resize the volume
-			log.Printf("INFO Attempting to resize disk for VM %s to %dGB", id, desiredDiskGB)
-			err = storageProvider.ResizeVolume(ctx, "default", volumeName, desiredDiskGB)
-			if err != nil {
-				log.Printf("WARN Disk resize failed: %v", err)
-				// Disk resize failure is not fatal, just log it
-			} else {
-				log.Printf("INFO Successfully resized disk for VM: %s", id)
-				hasChanges = true
-			}
-		}
-	}
-
-	// Log reconfiguration results
-	if !hasChanges && !requiresRestart {
-		log.Printf("INFO No configuration changes needed for domain: %s", id)
-		return "", nil
-	}
-
-	if requiresRestart {
-		log.Printf("WARN Some changes for domain %s require a restart to take effect", id)
-		// Note: The caller (controller) should handle restarting the VM if needed
-	}
-
-	log.Printf("INFO Successfully reconfigured domain: %s", id)
-	return "", nil
-}
+// defaultGracefulShutdownTimeout is used when a power request does not specify a timeout
+const defaultGracefulShutdownTimeout = 60 * time.Second
 
 // getVNCPort extracts the VNC port from domain XML
 func (p *Provider) getVNCPort(ctx context.Context, domainName string) (int, error) {
+	return p.getGraphicsPort(ctx, domainName, "vnc")
+}
+
+// getGraphicsPort extracts the listening port of a graphics device (vnc, spice) from the domain XML
+func (p *Provider) getGraphicsPort(ctx context.Context, domainName, graphicsType string) (int, error) {
 	// Get domain XML
 	result, err := p.virshProvider.runVirshCommand(ctx, "dumpxml", domainName)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get domain XML: %w", err)
 	}
 
-	// Parse XML to find VNC port
+	// Parse XML to find the graphics port
 	// Look for <graphics type='vnc' port='XXXX'/>
 	lines := strings.Split(result.Stdout, "\n")
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
-		if strings.Contains(line, "<graphics") && strings.Contains(line, "type='vnc'") {
+		if strings.Contains(line, "<graphics") && strings.Contains(line, fmt.Sprintf("type='%s'", graphicsType)) {
 			// Extract port attribute
 			if portIdx := strings.Index(line, "port='"); portIdx != -1 {
 				portStart := portIdx + 6 // len("port='")
@@ -679,7 +875,7 @@ func (p *Provider) getVNCPort(ctx context.Context, domainName string) (int, erro
 		}
 	}
 
-	return 0, fmt.Errorf("VNC port not found in domain XML")
+	return 0, fmt.Errorf("%s port not found in domain XML", strings.ToUpper(graphicsType))
 }
 
 // extractCPUCount extracts the CPU count from domain info map
@@ -875,10 +1071,15 @@ func (p *Provider) Describe(ctx context.Context, id string) (contracts.DescribeR
 	// Generate console URL (VNC/SPICE access info)
 	consoleURL := ""
 	if powerState == "On" {
-		// Try to get VNC display information
-		vncPort, err := p.getVNCPort(ctx, id)
-		if err == nil && vncPort > 0 {
-			// Build VNC URL
+		// Try to get VNC display information, then SPICE
+		display := displayVNC
+		port, err := p.getVNCPort(ctx, id)
+		if err != nil || port <= 0 {
+			display = displaySPICE
+			port, err = p.getGraphicsPort(ctx, id, displaySPICE)
+		}
+		if err == nil && port > 0 {
+			// Build VNC/SPICE URL
 			// Extract host from libvirt URI
 			host := "localhost" // Default to localhost
 			if p.virshProvider != nil && p.virshProvider.uri != "" {
@@ -890,18 +1091,89 @@ func (p *Provider) Describe(ctx context.Context, id string) (contracts.DescribeR
 					}
 				}
 			}
-			consoleURL = fmt.Sprintf("vnc://%s:%d", host, vncPort)
-			domainInfo["vnc_port"] = fmt.Sprintf("%d", vncPort)
+			consoleURL = fmt.Sprintf("%s://%s:%d", display, host, port)
+			domainInfo[display+"_port"] = fmt.Sprintf("%d", port)
 		}
 	}
 
+	// Read back user metadata so controllers can detect drift
+	vmMetadata, err := p.virshProvider.getVMMetadata(ctx, id)
+	if err != nil {
+		log.Printf("WARN Failed to read metadata for domain %s: %v", id, err)
+	}
+
+	affinity, antiAffinity, err := p.virshProvider.getAffinityGroups(ctx, id)
+	if err != nil {
+		log.Printf("WARN Failed to read affinity groups for domain %s: %v", id, err)
+	}
+
 	// Convert virsh domain info to contracts format
 	response := contracts.DescribeResponse{
-		Exists:      true,
-		PowerState:  string(powerState),
-		IPs:         ips,
-		ConsoleURL:  consoleURL,
-		ProviderRaw: domainInfo, // Pass the enhanced domain info as provider-specific data
+		Exists:             true,
+		PowerState:         string(powerState),
+		IPs:                ips,
+		ConsoleURL:         consoleURL,
+		ProviderRaw:        domainInfo, // Pass the enhanced domain info as provider-specific data
+		VMMetadata:         vmMetadata,
+		AffinityGroups:     affinity,
+		AntiAffinityGroups: antiAffinity,
+	}
+
+	// Report boot order and firmware so controllers can detect changes made through virsh
+	if domain, err := p.virshProvider.getDomainXML(ctx, id); err == nil {
+		response.BootOrder = domain.bootOrder()
+		response.Disks = p.diskAllocations(ctx, id, domain)
+		response.DiskThrottles = domain.diskThrottles()
+		response.Firmware = firmwareBIOS
+		if firmware := domain.firmware(); firmware != firmwareBIOS {
+			response.Firmware = firmwareUEFI
+			response.SecureBoot = firmware == firmwareUEFISecure
+		}
+	} else {
+		log.Printf("WARN Failed to read boot configuration of domain %s: %v", id, err)
+	}
+
+	// Hash the persistent definition so controllers can detect edits made with virsh
+	if domain, err := p.virshProvider.getPersistentDomainXML(ctx, id); err == nil {
+		response.SpecHash = specHash(domain)
+	} else {
+		log.Printf("WARN Failed to read persistent definition of domain %s: %v", id, err)
+	}
+
+	// Report per-interface addresses; leases stand in until the guest agent is up.
+	// Without a connected agent the hostname is left empty rather than failing Describe.
+	if powerState == "On" {
+		response.GuestInterfaces = p.virshProvider.getGuestInterfaces(ctx, id)
+		if hostname, err := p.virshProvider.getGuestHostname(ctx, id); err == nil {
+			response.GuestHostname = hostname
+		} else {
+			log.Printf("DEBUG Guest hostname of domain %s not available: %v", id, err)
+		}
+	}
+
+	// A watchdog with the pause action leaves the domain paused; libvirt only records the
+	// watchdog as the reason of a pause, so resets and power-offs are not seen. A panicked
+	// guest is left crashed when preserved and shut off with the crashed reason when destroyed.
+	switch domainInfo["State"] {
+	case "paused", "crashed", "shut off":
+		if state, reason, err := p.virshProvider.getDomainStateReason(ctx, id); err == nil {
+			response.WatchdogTriggered = state == "paused" && reason == "watchdog"
+			if domainCrashed(state, reason) {
+				response.Crashed = true
+				response.CrashReason = reason
+			}
+		} else {
+			log.Printf("WARN Failed to read state reason of domain %s: %v", id, err)
+		}
+	}
+
+	// Ephemeral VMs run as transient domains
+	response.Ephemeral = domainInfo["Persistent"] == "no"
+
+	// A saved domain is shut off until power-on restores it
+	if domainInfo["Managed save"] == "yes" {
+		response.HasManagedSave = true
+		response.ManagedSaveSizeBytes = p.virshProvider.managedSaveSize(ctx, id)
 	}
 
 	log.Printf("INFO Domain %s comprehensive state: power=%s, ips=%v, monitoring_data=collected", id, response.PowerState, ips)
@@ -910,6 +1182,15 @@ func (p *Provider) Describe(ctx context.Context, id string) (contracts.DescribeR
 
 // IsTaskComplete checks if a task is complete (virsh operations are usually synchronous)
 func (p *Provider) IsTaskComplete(ctx context.Context, taskRef string) (done bool, err error) {
+	if p.tasks != nil {
+		if task, ok := p.tasks.get(taskRef); ok {
+			if task.Error != "" {
+				return true, fmt.Errorf("%s", task.Error)
+			}
+			return task.Done, nil
+		}
+	}
+
 	// Most virsh operations are synchronous, so tasks are immediately complete
 	return true, nil
 }
@@ -1076,6 +1357,11 @@ final_message: "VM %s is ready!"
 
 // generateNetworkInterfacesXML creates network interface XML from network attachments
 func (p *Provider) generateNetworkInterfacesXML(networks []contracts.NetworkAttachment) string {
+	if len(networks) == 0 && p.options.DefaultNetwork != "" {
+		networks = []contracts.NetworkAttachment{{Name: "default"}}
+	}
+	networks = p.withNetworkDefaults(networks)
+
 	if len(networks) == 0 {
 		// Default to user network if no networks specified
 		return `    <interface type='user'>
@@ -1086,12 +1372,6 @@ func (p *Provider) generateNetworkInterfacesXML(networks []contracts.NetworkAtta
 
 	var interfacesXML string
 	for idx, net := range networks {
-		// Determine network model (default to virtio)
-		model := "virtio"
-		if net.Model != "" {
-			model = net.Model
-		}
-
 		// Determine PCI slot (start at 0x03, increment for each interface)
 		pciSlot := fmt.Sprintf("0x%02x", 3+idx)
 
@@ -1101,30 +1381,14 @@ func (p *Provider) generateNetworkInterfacesXML(networks []contracts.NetworkAtta
 			macXML = fmt.Sprintf("\n      <mac address='%s'/>", net.MacAddress)
 		}
 
-		var interfaceXML string
+		// Traffic shaping, if requested
+		shapingXML := bandwidthXML(net.Bandwidth, "      ")
 
-		// Determine interface type and configuration
-		if net.Bridge != "" {
-			// Bridge network
-			interfaceXML = fmt.Sprintf(`    <interface type='bridge'>%s
-      <source bridge='%s'/>
-      <model type='%s'/>
-      <address type='pci' domain='0x0000' bus='0x00' slot='%s' function='0x0'/>
-    </interface>`, macXML, net.Bridge, model, pciSlot)
-		} else if net.NetworkName != "" {
-			// Libvirt managed network
-			interfaceXML = fmt.Sprintf(`    <interface type='network'>%s
-      <source network='%s'/>
-      <model type='%s'/>
+		// Network, bridge, direct (macvtap), hostdev (SR-IOV VF) or user-mode (NAT) source
+		attrs, sourceXML := interfaceSourceXML(net, "      ")
+		interfaceXML := fmt.Sprintf(`    <interface%s>%s%s%s%s
       <address type='pci' domain='0x0000' bus='0x00' slot='%s' function='0x0'/>
-    </interface>`, macXML, net.NetworkName, model, pciSlot)
-		} else {
-			// Default to user network (NAT)
-			interfaceXML = fmt.Sprintf(`    <interface type='user'>%s
-      <model type='%s'/>
-      <address type='pci' domain='0x0000' bus='0x00' slot='%s' function='0x0'/>
-    </interface>`, macXML, model, pciSlot)
-		}
+    </interface>`, attrs, macXML, sourceXML, interfaceModelXML(net, "      "), shapingXML, pciSlot)
 
 		if idx > 0 {
 			interfacesXML += "\n"
@@ -1135,8 +1399,18 @@ func (p *Provider) generateNetworkInterfacesXML(networks []contracts.NetworkAtta
 	return interfacesXML
 }
 
+// domainStorage holds the host paths of the volumes and firmware a new domain is created with
+type domainStorage struct {
+	Disks            []diskPlan
+	CloudInitISOPath string
+	IgnitionPath     string
+	Firmware         *firmwarePaths
+}
+
 // generateDomainXMLWithStorage creates libvirt domain XML with proper storage configuration
-func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, diskPath, cloudInitISOPath string) (string, error) {
+func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, volumes domainStorage) (string, error) {
+	cloudInitISOPath := volumes.CloudInitISOPath
+
 	// Extract specifications from request
 	cpuCount := int32(1)    // default
 	memoryMB := int64(1024) // default 1GB
@@ -1150,43 +1424,44 @@ func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, dis
 		memoryMB = int64(req.Class.MemoryMiB)
 	}
 
-	// Extract performance and security features
-	var nestedVirtualization bool
+	// Extract security features
 	var vtdEnabled bool
-	var secureBoot bool
-	var tpmEnabled bool
-
-	if req.Class.PerformanceProfile != nil {
-		nestedVirtualization = req.Class.PerformanceProfile.NestedVirtualization
-	}
+	secureBoot := volumes.Firmware != nil && volumes.Firmware.Secure
 
 	if req.Class.SecurityProfile != nil {
 		vtdEnabled = req.Class.SecurityProfile.VTDEnabled
-		secureBoot = req.Class.SecurityProfile.SecureBoot
-		tpmEnabled = req.Class.SecurityProfile.TPMEnabled
 	}
 
 	// Generate UUID for the domain
-	uuid := p.generateUUID()
+	// Use the client-supplied UUID, so retried creates can be matched to the domain
+	uuid := req.UUID
+	if uuid == "" {
+		uuid = p.generateUUID()
+	}
 
-	// Build disk devices XML
-	diskDevicesXML := fmt.Sprintf(`    <disk type='file' device='disk'>
-      <driver name='qemu' type='qcow2'/>
-      <source file='%s'/>
-      <target dev='vda' bus='virtio'/>
-      <address type='pci' domain='0x0000' bus='0x00' slot='0x07' function='0x0'/>
-    </disk>`, diskPath)
+	// Requested boot devices get per-device boot orders
+	bootOrder := bootDeviceOrder(req.Boot)
+
+	// Build disk devices XML; throttles may only name the planned disks
+	targets := make([]string, 0, len(volumes.Disks))
+	for _, disk := range volumes.Disks {
+		targets = append(targets, disk.Target)
+	}
+	if err := checkDiskThrottleTargets(req.DiskThrottles, targets); err != nil {
+		return "", err
+	}
+	diskDevicesXML := renderDisksXML(volumes.Disks, bootOrder[bootDeviceDisk], req.DiskThrottles)
 
 	// Add cloud-init ISO if available
 	if cloudInitISOPath != "" {
-		diskDevicesXML += fmt.Sprintf(`
+		diskDevicesXML += withBootOrder(fmt.Sprintf(`
     <disk type='file' device='cdrom'>
       <driver name='qemu' type='raw'/>
       <source file='%s'/>
       <target dev='hda' bus='ide'/>
       <readonly/>
       <address type='drive' controller='0' bus='0' target='0' unit='0'/>
-    </disk>`, cloudInitISOPath)
+    </disk>`, cloudInitISOPath), "disk", bootOrder[bootDeviceCDROM])
 	}
 
 	// Build features XML based on configuration
@@ -1205,63 +1480,48 @@ func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, dis
     <iommu model='intel'/>` // or 'amd' for AMD systems
 	}
 
-	// Build CPU configuration with nested virtualization support
-	cpuXML := `<cpu mode='host-model' check='partial'>`
-	if nestedVirtualization {
-		cpuXML += `
-    <feature policy='require' name='vmx'/> <!-- Intel VT-x -->
-    <feature policy='require' name='svm'/> <!-- AMD-V -->`
-	}
-	cpuXML += `</cpu>`
+	// Build CPU configuration (mode, topology, nested virtualization)
+	cpuXML := renderCPUXML(req.Class)
 
-	// Build OS configuration with secure boot if needed
-	osXML := `    <type arch='x86_64' machine='pc'>hvm</type>
-    <boot dev='hd'/>
-    <boot dev='cdrom'/>`
-
-	if secureBoot {
-		osXML = `    <type arch='x86_64' machine='q35'>hvm</type>
-    <loader readonly='yes' type='pflash' secure='yes'>/usr/share/OVMF/OVMF_CODE_4M.secboot.fd</loader>
-    <nvram template='/usr/share/OVMF/OVMF_VARS_4M.fd'/>
-    <boot dev='hd'/>
-    <boot dev='cdrom'/>`
-	}
+	// Build OS configuration with UEFI firmware and secure boot if needed
+	osXML := firmwareOSXML(volumes.Firmware, req.Name, osBootXML(req.Boot))
 
 	// Build devices XML with TPM if needed
 	devicesXML := fmt.Sprintf(`    <emulator>/usr/bin/qemu-system-x86_64</emulator>
-%s`, diskDevicesXML)
+%s%s%s%s%s%s%s`, diskDevicesXML, tpmXML(req.Class), rngXML(req.RNG), hostDevicesXML(req.HostDevices),
+		graphicsXML(req.Graphics), inputXML(req.Inputs, req.Graphics), watchdogXML(req.Watchdog)+panicXML(req.OnCrash))
 
-	if tpmEnabled {
-		devicesXML += `
-    <tpm model='tpm-tis'>
-      <backend type='emulator' version='2.0'/>
-    </tpm>`
+	// Generate network interfaces based on request
+	networkInterfacesXML := withBootOrder(p.generateNetworkInterfacesXML(req.Networks), "interface", bootOrder[bootDeviceNetwork])
+
+	// Ignition configs are passed through fw_cfg rather than an attached ISO
+	sysinfoXML := ""
+	if volumes.IgnitionPath != "" {
+		sysinfoXML = ignitionSysinfoXML(volumes.IgnitionPath)
 	}
 
-	// Generate network interfaces based on request
-	networkInterfacesXML := p.generateNetworkInterfacesXML(req.Networks)
+	// Device assignment through VFIO is only possible with hardware acceleration
+	domainType := "qemu"
+	if len(pciHostDevices(req.HostDevices)) > 0 {
+		domainType = "kvm"
+	}
 
-	domainXML := fmt.Sprintf(`<domain type='qemu'>
+	domainXML := fmt.Sprintf(`<domain type='%s'%s>
   <name>%s</name>
   <uuid>%s</uuid>
-  <memory unit='MiB'>%d</memory>
+%s  <memory unit='MiB'>%d</memory>
   <currentMemory unit='MiB'>%d</currentMemory>
   <vcpu placement='static'>%d</vcpu>
-  <os>
+%s%s%s%s%s  <os>
 %s
   </os>
   <features>
 %s
   </features>
   %s
-  <clock offset='utc'>
-    <timer name='rtc' tickpolicy='catchup'/>
-    <timer name='pit' tickpolicy='delay'/>
-    <timer name='hpet' present='no'/>
-  </clock>
-  <on_poweroff>destroy</on_poweroff>
+%s  <on_poweroff>destroy</on_poweroff>
   <on_reboot>restart</on_reboot>
-  <on_crash>destroy</on_crash>
+  <on_crash>%s</on_crash>
   <devices>
 %s
     <controller type='usb' index='0' model='ich9-ehci1'>
@@ -1287,7 +1547,7 @@ func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, dis
       <address type='pci' domain='0x0000' bus='0x00' slot='0x06' function='0x0'/>
     </controller>
 %s
-    <serial type='pty'>
+    <serial type='pty'>%s
       <target type='isa-serial' port='0'>
         <model name='isa-serial'/>
       </target>
@@ -1299,38 +1559,37 @@ func (p *Provider) generateDomainXMLWithStorage(req contracts.CreateRequest, dis
       <target type='virtio' name='org.qemu.guest_agent.0'/>
       <address type='virtio-serial' controller='0' bus='0' port='1'/>
     </channel>
-    <input type='tablet' bus='usb'>
-      <address type='usb' bus='0' port='1'/>
-    </input>
     <input type='mouse' bus='ps2'/>
     <input type='keyboard' bus='ps2'/>
-    <graphics type='vnc' port='-1' autoport='yes' listen='127.0.0.1'>
-      <listen type='address' address='127.0.0.1'/>
-    </graphics>
-    <sound model='ich6'>
-      <address type='pci' domain='0x0000' bus='0x00' slot='0x04' function='0x0'/>
-    </sound>
-    <video>
-      <model type='cirrus' vram='16384' heads='1' primary='yes'/>
-      <address type='pci' domain='0x0000' bus='0x00' slot='0x02' function='0x0'/>
-    </video>
     <memballoon model='virtio'>
       <address type='pci' domain='0x0000' bus='0x00' slot='0x08' function='0x0'/>
     </memballoon>
   </devices>
-</domain>`,
+%s</domain>`,
+		domainType,
+		qemuNamespaceAttr(req.QemuArgs),
 		req.Name,
 		uuid,
+		domainMetadataXML(req, p.instanceID(), diskVolumeRecords(volumes.Disks)),
 		memoryMB,
 		memoryMB,
 		cpuCount,
+		cpuTuneXML(req.Class.CPUTune),
+		blkioTuneXML(req.Class.BlkioTune),
+		numaTuneXML(req.Class.NUMATune),
+		memoryBackingXML(req.Class),
+		sysinfoXML,
 		osXML,
 		featuresXML,
 		cpuXML,
+		clockXML(req.Clock),
+		onCrashAction(req.OnCrash),
 		devicesXML,
-		networkInterfacesXML)
+		networkInterfacesXML,
+		p.serialLogXML(req),
+		qemuCommandlineXML(req.QemuArgs))
 
-	return domainXML, nil
+	return p.applyDomainTemplate(domainXML)
 }
 
 // generateUUID creates a simple UUID for the domain
@@ -1405,6 +1664,9 @@ func (p *Provider) SnapshotCreate(ctx context.Context, req contracts.SnapshotCre
 	if err != nil {
 		return contracts.SnapshotCreateResponse{}, contracts.NewRetryableError("failed to get domain state", err)
 	}
+	if err := p.checkNoLinkedClones(ctx, req.VmId, "snapshotted"); err != nil {
+		return contracts.SnapshotCreateResponse{}, err
+	}
 
 	log.Printf("INFO Domain %s is in state: %s", req.VmId, domainState)
 
@@ -1445,94 +1707,31 @@ func (p *Provider) SnapshotCreate(ctx context.Context, req contracts.SnapshotCre
 
 // SnapshotDelete deletes a VM snapshot using virsh
 func (p *Provider) SnapshotDelete(ctx context.Context, vmId string, snapshotId string) (taskRef string, err error) {
-	log.Printf("INFO Deleting snapshot %s from VM: %s", snapshotId, vmId)
-
-	if p.virshProvider == nil {
-		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
-	}
-
-	// Check if snapshot exists
-	exists, err := p.virshProvider.snapshotExists(ctx, vmId, snapshotId)
-	if err != nil {
-		return "", fmt.Errorf("failed to check snapshot existence: %w", err)
-	}
-
-	if !exists {
-		log.Printf("WARN Snapshot %s does not exist, considering deletion successful", snapshotId)
-		return "", nil
-	}
-
-	// Delete the snapshot
-	args := []string{
-		"snapshot-delete",
-		vmId,
-		snapshotId,
-	}
-
-	result, err := p.virshProvider.runVirshCommand(ctx, args...)
-	if err != nil {
-		return "", fmt.Errorf("failed to delete snapshot: %w", err)
-	}
-
-	log.Printf("INFO Snapshot deleted successfully: %s\nOutput: %s", snapshotId, result.Stdout)
-
-	// Return empty task reference (synchronous operation)
-	return "", nil
+	// Synchronous operation, no task reference
+	return "", p.DeleteSnapshot(ctx, vmId, snapshotId, false)
 }
 
 // SnapshotRevert reverts a VM to a snapshot using virsh
 func (p *Provider) SnapshotRevert(ctx context.Context, vmId string, snapshotId string) (taskRef string, err error) {
-	log.Printf("INFO Reverting VM %s to snapshot: %s", vmId, snapshotId)
-
-	if p.virshProvider == nil {
-		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
-	}
-
-	// Check if snapshot exists
-	exists, err := p.virshProvider.snapshotExists(ctx, vmId, snapshotId)
-	if err != nil {
-		return "", fmt.Errorf("failed to check snapshot existence: %w", err)
-	}
-
-	if !exists {
-		return "", fmt.Errorf("snapshot %s does not exist", snapshotId)
-	}
-
-	// Get current domain state
-	domainState, err := p.virshProvider.getDomainState(ctx, vmId)
-	if err != nil {
-		return "", fmt.Errorf("failed to get domain state: %w", err)
-	}
-
-	log.Printf("INFO Domain %s current state: %s", vmId, domainState)
-
-	// Revert to snapshot
-	args := []string{
-		"snapshot-revert",
-		vmId,
-		snapshotId,
-		"--force", // Force revert even if domain is running
-	}
-
-	// If domain was running, keep it running after revert
-	if domainState == "running" {
-		args = append(args, "--running")
-	}
-
-	result, err := p.virshProvider.runVirshCommand(ctx, args...)
-	if err != nil {
-		return "", fmt.Errorf("failed to revert to snapshot: %w", err)
-	}
-
-	log.Printf("INFO Successfully reverted to snapshot: %s\nOutput: %s", snapshotId, result.Stdout)
-
-	// Return empty task reference (synchronous operation)
-	return "", nil
+	// Synchronous operation, no task reference
+	return "", p.RevertSnapshot(ctx, vmId, snapshotId, SnapshotRevertOptions{})
 }
 
 // TaskStatus returns the status of a task (libvirt operations are mostly synchronous)
 func (p *Provider) TaskStatus(ctx context.Context, taskRef string) (contracts.TaskStatus, error) {
-	// LibVirt operations are synchronous, so if we have a taskRef, it's completed
+	// Background operations (e.g. migrations) are tracked explicitly
+	if p.tasks != nil {
+		if task, ok := p.tasks.get(taskRef); ok {
+			return contracts.TaskStatus{
+				IsCompleted:     task.Done,
+				Error:           task.Error,
+				Message:         task.Message,
+				ProgressPercent: task.Progress,
+			}, nil
+		}
+	}
+
+	// Other LibVirt operations are synchronous, so if we have a taskRef, it's completed
 	return contracts.TaskStatus{
 		IsCompleted: true,
 		Error:       "",
@@ -1603,7 +1802,7 @@ func (p *Provider) GetDiskInfo(ctx context.Context, req contracts.GetDiskInfoReq
 	}
 
 	// Get volume information
-	volume, err := storageProvider.GetVolumeInfo(ctx, "default", diskVolumeName)
+	volume, err := storageProvider.GetVolumeInfo(ctx, p.virshProvider.rootDiskPool(ctx, req.VmId), diskVolumeName)
 	if err != nil {
 		return contracts.GetDiskInfoResponse{}, fmt.Errorf("failed to get volume info: %w", err)
 	}
@@ -1791,7 +1990,7 @@ func (p *Provider) ImportDisk(ctx context.Context, req contracts.ImportDiskReque
 	storageProvider := NewStorageProvider(p.virshProvider)
 
 	// Determine storage pool
-	storagePool := "default"
+	storagePool := p.virshProvider.storagePool()
 	if req.StorageHint != "" {
 		storagePool = req.StorageHint
 	}
@@ -1914,16 +2113,26 @@ func (p *Provider) ImportDisk(ctx context.Context, req contracts.ImportDiskReque
 
 // ListVMs returns all VMs managed by this provider
 func (p *Provider) ListVMs(ctx context.Context) ([]contracts.VMInfo, error) {
-	log.Printf("INFO Listing all virtual machines")
+	vmInfos, _, err := p.ListVMsPage(ctx, ListOptions{})
+	return vmInfos, err
+}
+
+// ListVMsPage returns one page of the VMs matching opts, ordered by name, and the token
+// of the next page ("" on the last page). Only the domains of the page are inspected.
+func (p *Provider) ListVMsPage(ctx context.Context, opts ListOptions) ([]contracts.VMInfo, string, error) {
+	if opts.Selector == nil || opts.Selector.Empty() {
+		log.Printf("INFO Listing virtual machines (page_size=%d)", opts.PageSize)
+	} else {
+		log.Printf("INFO Listing virtual machines matching %s (page_size=%d)", opts.Selector, opts.PageSize)
+	}
 
 	if p.virshProvider == nil {
-		return nil, contracts.NewRetryableError("virsh provider not initialized", nil)
+		return nil, "", contracts.NewRetryableError("virsh provider not initialized", nil)
 	}
 
-	// List all domains
-	domains, err := p.virshProvider.listDomains(ctx)
+	domains, nextPageToken, err := p.selectDomains(ctx, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list domains: %w", err)
+		return nil, "", err
 	}
 
 	log.Printf("INFO Found %d domains", len(domains))
@@ -2052,6 +2261,6 @@ func (p *Provider) ListVMs(ctx context.Context) ([]contracts.VMInfo, error) {
 		vmInfos = append(vmInfos, vmInfo)
 	}
 
-	return vmInfos, nil
+	return vmInfos, nextPageToken, nil
 }
 
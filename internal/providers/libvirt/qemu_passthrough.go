@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// qemuNamespaceURI is the libvirt namespace of the <qemu:commandline> element
+const qemuNamespaceURI = "http://libvirt.org/schemas/domain/qemu/1.0"
+
+// DefaultQEMUPassthroughPrefixes are the qemu options passthrough arguments may use when
+// no allowlist is configured. They only tune devices libvirt has already created; options
+// that add devices or read host files (e.g. -device, -drive, -fw_cfg) must be allowed explicitly.
+var DefaultQEMUPassthroughPrefixes = []string{"-global", "-set", "-overcommit"}
+
+// qemuArgOptions groups passthrough arguments into options: a flag followed by its values
+func qemuArgOptions(args []string) ([][]string, error) {
+	var options [][]string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			options = append(options, []string{arg})
+			continue
+		}
+		if len(options) == 0 {
+			return nil, contracts.NewInvalidSpecError(
+				fmt.Sprintf("qemu argument %q is not preceded by an option", arg), nil)
+		}
+		options[len(options)-1] = append(options[len(options)-1], arg)
+	}
+	return options, nil
+}
+
+// checkQEMUPassthrough rejects qemu command-line arguments unless passthrough is enabled
+// and every option, with its values, starts with an allowlisted prefix
+func (p *Provider) checkQEMUPassthrough(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if !p.options.AllowQEMUPassthrough {
+		return contracts.NewUnauthorizedError("qemu command-line passthrough is disabled; start the provider with --allow-qemu-passthrough", nil)
+	}
+
+	options, err := qemuArgOptions(args)
+	if err != nil {
+		return err
+	}
+	prefixes := p.options.QEMUPassthroughPrefixes
+	if prefixes == nil {
+		prefixes = DefaultQEMUPassthroughPrefixes
+	}
+	for _, option := range options {
+		joined := strings.Join(option, " ")
+		allowed := false
+		for _, prefix := range prefixes {
+			if prefix != "" && strings.HasPrefix(joined, prefix) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return contracts.NewUnauthorizedError(fmt.Sprintf(
+				"qemu argument %q is not allowed (allowed prefixes: %s)", joined, strings.Join(prefixes, ", ")), nil)
+		}
+	}
+	return nil
+}
+
+// qemuNamespaceAttr declares the qemu namespace on the <domain> element when passthrough is used
+func qemuNamespaceAttr(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" xmlns:qemu='%s'", qemuNamespaceURI)
+}
+
+// qemuCommandlineXML renders the <qemu:commandline> element passing args straight to qemu
+func qemuCommandlineXML(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("  <qemu:commandline>\n")
+	for _, arg := range args {
+		fmt.Fprintf(&b, "    <qemu:arg value='%s'/>\n", xmlEscape(arg))
+	}
+	b.WriteString("  </qemu:commandline>\n")
+	return b.String()
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// redactedValue replaces the value of secret attributes in returned domain XML
+const redactedValue = "REDACTED"
+
+// secretAttrPattern matches the attributes of a domain definition that hold a secret,
+// i.e. the VNC and SPICE passwords of <graphics passwd='...'>
+var secretAttrPattern = regexp.MustCompile(`(\spasswd=)(?:'[^']*'|"[^"]*")`)
+
+// GetRawXML returns the domain XML libvirt has for a VM for troubleshooting; inactive returns
+// the persistent definition instead of the live one. It is refused unless the provider was
+// started with --allow-debug-rpcs, and secrets are redacted even though virsh only prints
+// them when asked for security information.
+func (p *Provider) GetRawXML(ctx context.Context, vmID string, inactive bool) (string, error) {
+	if !p.options.AllowDebugRPCs {
+		return "", contracts.NewUnauthorizedError("debug RPCs are disabled; start the provider with --allow-debug-rpcs", nil)
+	}
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	args := []string{"dumpxml", vmID}
+	if inactive {
+		args = append(args, "--inactive")
+	}
+	result, err := p.virshProvider.runVirshCommand(ctx, args...)
+	if err != nil {
+		if domainGone(err) {
+			return "", contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+		}
+		return "", contracts.NewRetryableError("failed to dump domain XML", err)
+	}
+	return redactDomainXML(result.Stdout), nil
+}
+
+// redactDomainXML replaces the values of secret attributes, keeping the attributes so that
+// it stays visible that a password is set
+func redactDomainXML(domainXML string) string {
+	return secretAttrPattern.ReplaceAllString(domainXML, "${1}'"+redactedValue+"'")
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+func TestRedactDomainXML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "single quoted",
+			in:   `<graphics type='vnc' port='5900' passwd='s3cret'/>`,
+			want: `<graphics type='vnc' port='5900' passwd='REDACTED'/>`,
+		},
+		{
+			name: "double quoted",
+			in:   `<graphics type="spice" passwd="s3cret" autoport="yes"/>`,
+			want: `<graphics type="spice" passwd='REDACTED' autoport="yes"/>`,
+		},
+		{
+			name: "quotes and markup inside the value",
+			in:   `<graphics type="vnc" passwd="it's<a>&quot;" listen="0.0.0.0"/>`,
+			want: `<graphics type="vnc" passwd='REDACTED' listen="0.0.0.0"/>`,
+		},
+		{
+			name: "empty password",
+			in:   `<graphics type='vnc' passwd=''/>`,
+			want: `<graphics type='vnc' passwd='REDACTED'/>`,
+		},
+		{
+			name: "attribute on its own line",
+			in:   "<graphics type='vnc'\n          passwd='s3cret'>\n  <listen type='address'/>\n</graphics>",
+			want: "<graphics type='vnc'\n          passwd='REDACTED'>\n  <listen type='address'/>\n</graphics>",
+		},
+		{
+			name: "similar attribute names are kept",
+			in:   `<graphics type='vnc' vncpasswd='a' passwdValidTo='2026-01-01T00:00:00'/>`,
+			want: `<graphics type='vnc' vncpasswd='a' passwdValidTo='2026-01-01T00:00:00'/>`,
+		},
+		{
+			name: "no secret",
+			in:   `<domain type='kvm'><name>passwd</name><description>passwd='x'</description></domain>`,
+			want: `<domain type='kvm'><name>passwd</name><description>passwd='x'</description></domain>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactDomainXML(tt.in))
+		})
+	}
+}
+
+const rawXMLDomain = `<domain type='kvm' id='3'>
+  <name>web-1</name>
+  <metadata>
+    <vr:labels xmlns:vr="https://virtrigaud.io/xmlns/labels/1.0"><entry key="env">prod</entry></vr:labels>
+  </metadata>
+  <devices>
+    <disk type='file' device='disk'>
+      <source file='/var/lib/libvirt/images/web-1.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <graphics type='vnc' port='5900' autoport='yes' listen='0.0.0.0' passwd='first-secret'>
+      <listen type='address' address='0.0.0.0'/>
+    </graphics>
+    <graphics type='spice' autoport='yes' passwd="second-secret" keymap='en-us'>
+      <listen type='network' network='default'/>
+    </graphics>
+  </devices>
+</domain>
+`
+
+func TestRedactDomainXMLKeepsTheRestOfTheDefinition(t *testing.T) {
+	redacted := redactDomainXML(rawXMLDomain)
+
+	assert.NotContains(t, redacted, "first-secret")
+	assert.NotContains(t, redacted, "second-secret")
+	assert.Equal(t, 2, strings.Count(redacted, `passwd='REDACTED'`))
+
+	want := strings.NewReplacer(`'first-secret'`, `'REDACTED'`, `"second-secret"`, `'REDACTED'`).Replace(rawXMLDomain)
+	assert.Equal(t, want, redacted)
+	assert.Equal(t, redacted, redactDomainXML(redacted), "redaction is idempotent")
+}
+
+func TestGetRawXML(t *testing.T) {
+	p, logPath := newScriptedVirshProvider(t, `#!/bin/sh
+echo "$@" >> "$FAKE_VIRSH_LOG"
+cat <<'XML'
+`+rawXMLDomain+`XML
+`)
+
+	_, err := p.GetRawXML(context.Background(), "web-1", false)
+	var providerErr *contracts.ProviderError
+	require.True(t, errors.As(err, &providerErr), "got %v", err)
+	assert.Equal(t, contracts.ErrorTypeUnauthorized, providerErr.Type)
+
+	p.options.AllowDebugRPCs = true
+	xml, err := p.GetRawXML(context.Background(), "web-1", true)
+	require.NoError(t, err)
+	assert.NotContains(t, xml, "secret")
+	assert.Contains(t, xml, `<source file='/var/lib/libvirt/images/web-1.qcow2'/>`)
+
+	log, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(log), "dumpxml web-1 --inactive")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Reboot methods reported by RebootVM
+const (
+	rebootMethodAgent = "agent"
+	rebootMethodACPI  = "acpi"
+	rebootMethodReset = "reset"
+)
+
+// RebootVM reboots a running domain in place, so it keeps its QEMU process, console and
+// passed-through devices. The guest agent is asked first and ACPI is used when no agent
+// answers; force resets the domain like a reset button. It returns the method used.
+func (p *Provider) RebootVM(ctx context.Context, vmID string, force bool) (string, error) {
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return "", contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	if state != "running" {
+		return "", contracts.NewInvalidSpecError(fmt.Sprintf("domain %s is %s; only running domains can be rebooted", vmID, state), nil)
+	}
+
+	if force {
+		log.Printf("INFO Resetting domain %s", vmID)
+		if _, err := p.virshProvider.runVirshCommand(ctx, "reset", vmID); err != nil {
+			return "", contracts.NewRetryableError("failed to reset domain", err)
+		}
+		return rebootMethodReset, nil
+	}
+
+	if NewGuestAgentProvider(p.virshProvider).isGuestAgentAvailable(ctx, vmID) {
+		_, err := p.virshProvider.runVirshCommand(ctx, "reboot", vmID, "--mode", "agent")
+		if err == nil {
+			log.Printf("INFO Rebooted domain %s through the guest agent", vmID)
+			return rebootMethodAgent, nil
+		}
+		log.Printf("WARN Guest agent reboot of %s failed, falling back to ACPI: %v", vmID, err)
+	}
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "reboot", vmID, "--mode", "acpi"); err != nil {
+		return "", contracts.NewRetryableError("failed to reboot domain", err)
+	}
+	log.Printf("INFO Rebooted domain %s through ACPI", vmID)
+	return rebootMethodACPI, nil
+}
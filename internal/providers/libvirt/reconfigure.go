@@ -0,0 +1,359 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Fields reported by ReconfigureVM
+const (
+	reconfigureFieldCPU      = "cpu"
+	reconfigureFieldMemory   = "memory"
+	reconfigureFieldTuning   = "tuning"
+	reconfigureFieldMetadata = "metadata"
+	reconfigureFieldAffinity = "affinity"
+	reconfigureFieldDisk     = "disk"
+	reconfigureFieldThrottle = "disk-throttle"
+)
+
+// PendingChange is a change that was written to the persistent definition of a running
+// domain but only takes effect once the domain is power cycled
+type PendingChange struct {
+	// Field is the part of the spec that changed (cpu, memory)
+	Field string
+	// Current is the value the running domain still has
+	Current string
+	// Desired is the value it gets after a power cycle
+	Desired string
+	// Reason explains why the change could not be applied live
+	Reason string
+}
+
+// ReconfigureResult reports how the changes of a reconfigure were applied
+type ReconfigureResult struct {
+	// Applied lists the fields that took effect: live on a running domain, in the
+	// definition of a stopped one, or through the power cycle of a forced reboot
+	Applied []string
+	// Pending lists the changes that wait for a power cycle
+	Pending []PendingChange
+	// Rebooted reports that the domain was power cycled to apply pending changes
+	Rebooted bool
+}
+
+// Reconfigure updates VM configuration using virsh
+func (p *Provider) Reconfigure(ctx context.Context, id string, desired contracts.CreateRequest) (taskRef string, err error) {
+	_, err = p.ReconfigureVM(ctx, id, desired, false)
+	return "", err
+}
+
+// ReconfigureVM applies the CPU, memory, tuning, metadata, disk I/O limits and disk size of desired to a domain.
+// On a running domain each change is applied live where libvirt can; the others are written
+// to the persistent definition and returned as pending. forceReboot power cycles the domain
+// (graceful shutdown, then start) when anything is pending, so that every change takes effect.
+func (p *Provider) ReconfigureVM(ctx context.Context, id string, desired contracts.CreateRequest, forceReboot bool) (ReconfigureResult, error) {
+	log.Printf("INFO Reconfiguring VM: %s", id)
+
+	var result ReconfigureResult
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	domainState, err := p.virshProvider.getDomainState(ctx, id)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+	isRunning := domainState == "running"
+	log.Printf("INFO Domain %s current state: %s", id, domainState)
+
+	currentInfo, err := p.virshProvider.getDomainInfo(ctx, id)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to get current domain info", err)
+	}
+
+	// A transient domain has no persistent definition to hold changes that need a restart,
+	// and stopping it to apply them would delete it
+	transient := currentInfo["Persistent"] == "no"
+	scope := changeScope{live: isRunning, transient: transient}
+
+	if desired.Class.CPU > 0 {
+		if err := p.reconfigureVCPUs(ctx, id, desired.Class.CPU, isRunning, transient, &result); err != nil {
+			return result, err
+		}
+	}
+
+	if desired.Class.MemoryMiB > 0 {
+		if err := p.reconfigureMemory(ctx, id, currentInfo, int64(desired.Class.MemoryMiB)*1024, isRunning, transient, &result); err != nil {
+			return result, err
+		}
+	}
+
+	// Handle CPU scheduler, pinning, NUMA and block I/O tuning; pins are checked against
+	// the current vCPUs unless the vCPU count changes too
+	if desired.Class.CPUTune != nil || desired.Class.BlkioTune != nil || desired.Class.NUMATune != nil {
+		class := desired.Class
+		if class.CPU == 0 {
+			class.CPU, _ = p.extractCPUCount(currentInfo)
+		}
+		if err := vmspec.ValidateCPUTune(class); err != nil {
+			return result, err
+		}
+		if err := vmspec.ValidateBlkioTune(class.BlkioTune); err != nil {
+			return result, err
+		}
+		if err := vmspec.ValidateNUMATune(class.NUMATune); err != nil {
+			return result, err
+		}
+		if err := p.checkTuningAvailable(ctx, class); err != nil {
+			return result, err
+		}
+		if err := p.applyTuning(ctx, id, class, scope); err != nil {
+			return result, contracts.NewRetryableError("failed to update CPU, NUMA and block I/O tuning", err)
+		}
+		log.Printf("INFO Updated CPU, NUMA and block I/O tuning for domain %s", id)
+		result.Applied = append(result.Applied, reconfigureFieldTuning)
+	}
+
+	// Handle metadata changes; the desired map replaces the stored one
+	if desired.VMMetadata != nil {
+		if err := vmspec.ValidateMetadata(desired.VMMetadata); err != nil {
+			return result, err
+		}
+		if err := p.virshProvider.setVMMetadata(ctx, id, desired.VMMetadata, scope); err != nil {
+			return result, contracts.NewRetryableError("failed to update VM metadata", err)
+		}
+		log.Printf("INFO Updated metadata for domain %s (%d keys)", id, len(desired.VMMetadata))
+		result.Applied = append(result.Applied, reconfigureFieldMetadata)
+	}
+
+	// Affinity groups are replaced together; they are scheduler hints and applied as stored
+	if desired.AffinityGroups != nil || desired.AntiAffinityGroups != nil {
+		if err := vmspec.ValidateAffinityGroups(desired.AffinityGroups, desired.AntiAffinityGroups); err != nil {
+			return result, err
+		}
+		if err := p.virshProvider.setAffinityGroups(ctx, id, desired.AffinityGroups, desired.AntiAffinityGroups, scope); err != nil {
+			return result, contracts.NewRetryableError("failed to update VM affinity groups", err)
+		}
+		log.Printf("INFO Updated affinity groups for domain %s (%d affinity, %d anti-affinity)",
+			id, len(desired.AffinityGroups), len(desired.AntiAffinityGroups))
+		result.Applied = append(result.Applied, reconfigureFieldAffinity)
+	}
+
+	// Disk I/O limits are set with blkdeviotune, which applies them to a running domain at once
+	if desired.DiskThrottles != nil {
+		if err := vmspec.ValidateDiskThrottles(desired.DiskThrottles); err != nil {
+			return result, err
+		}
+		domain, err := p.virshProvider.getDomainXML(ctx, id)
+		if err != nil {
+			return result, contracts.NewRetryableError("failed to read domain definition", err)
+		}
+		if err := checkDiskThrottleTargets(desired.DiskThrottles, domain.diskTargets()); err != nil {
+			return result, err
+		}
+		if err := p.applyDiskThrottles(ctx, id, desired.DiskThrottles, scope); err != nil {
+			return result, contracts.NewRetryableError("failed to update disk I/O limits", err)
+		}
+		result.Applied = append(result.Applied, reconfigureFieldThrottle)
+	}
+
+	// Grow the root disk volume to the class default size
+	if desired.Class.DiskDefaults != nil && desired.Class.DiskDefaults.SizeGiB > 0 {
+		desiredDiskGB := int(desired.Class.DiskDefaults.SizeGiB)
+		volumeName := fmt.Sprintf("%s-disk", id)
+
+		log.Printf("INFO Attempting to resize disk for VM %s to %dGB", id, desiredDiskGB)
+		err := NewStorageProvider(p.virshProvider).ResizeVolume(ctx, p.virshProvider.rootDiskPool(ctx, id), volumeName, desiredDiskGB)
+		if err != nil {
+			// Disk resize failure is not fatal, just log it
+			log.Printf("WARN Disk resize failed: %v", err)
+		} else {
+			log.Printf("INFO Successfully resized disk for VM: %s", id)
+			result.Applied = append(result.Applied, reconfigureFieldDisk)
+		}
+	}
+
+	if len(result.Pending) > 0 {
+		if !forceReboot {
+			log.Printf("WARN %d change(s) for domain %s take effect after a power cycle", len(result.Pending), id)
+			return result, nil
+		}
+		if err := p.powerCycle(ctx, id); err != nil {
+			return result, err
+		}
+		for _, change := range result.Pending {
+			result.Applied = append(result.Applied, change.Field)
+		}
+		result.Pending = nil
+		result.Rebooted = true
+	}
+
+	if len(result.Applied) == 0 {
+		log.Printf("INFO No configuration changes needed for domain: %s", id)
+	} else {
+		log.Printf("INFO Successfully reconfigured domain %s: %v", id, result.Applied)
+	}
+	return result, nil
+}
+
+// reconfigureVCPUs sets the vCPU count of a domain. A running domain gets vCPUs hot-plugged
+// up to its maximum; a count above the maximum, or an unplug the guest cannot confirm, is
+// written to the definition and reported as pending, or rejected for a transient domain.
+func (p *Provider) reconfigureVCPUs(ctx context.Context, id string, target int32, isRunning, transient bool, result *ReconfigureResult) error {
+	counts, err := p.virshProvider.getVCPUCounts(ctx, id)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get vCPU counts", err)
+	}
+
+	// A transient domain has no definition to change, only the vCPUs it runs with
+	if !isRunning && !transient {
+		if target == counts.CurrentConfig {
+			return nil
+		}
+		log.Printf("INFO CPU change requested for %s: %d -> %d", id, counts.CurrentConfig, target)
+		if err := p.setConfigVCPUs(ctx, id, target, counts.MaximumConfig); err != nil {
+			return err
+		}
+		result.Applied = append(result.Applied, reconfigureFieldCPU)
+		return nil
+	}
+
+	if target == counts.CurrentLive && (transient || target == counts.CurrentConfig) {
+		return nil
+	}
+	log.Printf("INFO CPU change requested for %s: %d -> %d", id, counts.CurrentLive, target)
+
+	reason := fmt.Sprintf("exceeds the hot-plug maximum of %d vCPUs", counts.MaximumLive)
+	if target <= counts.MaximumLive {
+		_, err := p.SetVCPUs(ctx, id, target)
+		if err == nil {
+			log.Printf("INFO Successfully changed CPUs online for domain: %s", id)
+			result.Applied = append(result.Applied, reconfigureFieldCPU)
+			return nil
+		}
+		log.Printf("WARN Online CPU change of %s failed, applying it to the definition: %v", id, err)
+		reason = err.Error()
+	}
+	if transient {
+		return restartOnTransientError(id, reconfigureFieldCPU, reason)
+	}
+
+	if err := p.setConfigVCPUs(ctx, id, target, counts.MaximumConfig); err != nil {
+		return err
+	}
+	result.Pending = append(result.Pending, PendingChange{
+		Field:   reconfigureFieldCPU,
+		Current: strconv.Itoa(int(counts.CurrentLive)),
+		Desired: strconv.Itoa(int(target)),
+		Reason:  reason,
+	})
+	return nil
+}
+
+// setConfigVCPUs sets the vCPUs of the persistent definition, raising its maximum if needed
+func (p *Provider) setConfigVCPUs(ctx context.Context, id string, target, maximum int32) error {
+	count := strconv.Itoa(int(target))
+	if target > maximum {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "setvcpus", id, count, "--config", "--maximum"); err != nil {
+			return contracts.NewRetryableError("failed to set the maximum vCPUs in the domain definition", err)
+		}
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "setvcpus", id, count, "--config"); err != nil {
+		return contracts.NewRetryableError("failed to set vCPUs in the domain definition", err)
+	}
+	return nil
+}
+
+// reconfigureMemory sets the memory of a domain. Sizes up to the maximum memory are ballooned,
+// live on a running domain; a larger size raises the maximum in the definition and is reported
+// as pending on a running domain, or rejected for a transient one.
+func (p *Provider) reconfigureMemory(ctx context.Context, id string, currentInfo map[string]string, desiredKB int64, isRunning, transient bool, result *ReconfigureResult) error {
+	maximumKB, err := p.extractMemoryKB(currentInfo)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get maximum memory", err)
+	}
+	currentKB := parseKiB(currentInfo["Used memory"]) / 1024
+	if currentKB <= 0 {
+		return contracts.NewRetryableError("failed to get current memory", nil)
+	}
+	if currentKB == desiredKB {
+		return nil
+	}
+	log.Printf("INFO Memory change requested for %s: %d KiB -> %d KiB (maximum %d KiB)", id, currentKB, desiredKB, maximumKB)
+
+	size := fmt.Sprintf("%dK", desiredKB)
+	if desiredKB <= maximumKB {
+		args := append([]string{"setmem", id, size}, changeScope{live: isRunning, transient: transient}.flags()...)
+		if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+			return contracts.NewRetryableError("failed to set memory", err)
+		}
+		log.Printf("INFO Successfully changed memory of domain %s (live: %t)", id, isRunning)
+		result.Applied = append(result.Applied, reconfigureFieldMemory)
+		return nil
+	}
+
+	reason := fmt.Sprintf("exceeds the maximum memory of %d MiB, which only changes when the VM is restarted", maximumKB/1024)
+	if transient {
+		return restartOnTransientError(id, reconfigureFieldMemory, reason)
+	}
+
+	// The maximum memory of a domain can only change in its definition
+	if _, err := p.virshProvider.runVirshCommand(ctx, "setmaxmem", id, size, "--config"); err != nil {
+		return contracts.NewRetryableError("failed to set the maximum memory in the domain definition", err)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "setmem", id, size, "--config"); err != nil {
+		return contracts.NewRetryableError("failed to set memory in the domain definition", err)
+	}
+
+	if !isRunning {
+		result.Applied = append(result.Applied, reconfigureFieldMemory)
+		return nil
+	}
+	result.Pending = append(result.Pending, PendingChange{
+		Field:   reconfigureFieldMemory,
+		Current: fmt.Sprintf("%dMiB", currentKB/1024),
+		Desired: fmt.Sprintf("%dMiB", desiredKB/1024),
+		Reason:  reason,
+	})
+	return nil
+}
+
+// restartOnTransientError rejects a change of a transient domain that only takes effect after
+// a restart, since the domain is deleted when it stops
+func restartOnTransientError(id, field, reason string) error {
+	return contracts.NewInvalidStateError(fmt.Sprintf(
+		"%s change of ephemeral VM %s needs a restart (%s), which would delete the VM", field, id, reason), nil)
+}
+
+// powerCycle stops a domain, gracefully if the guest responds in time, and starts it again
+// so that it runs with its persistent definition
+func (p *Provider) powerCycle(ctx context.Context, id string) error {
+	log.Printf("INFO Power cycling domain %s to apply pending changes", id)
+	if _, err := p.ShutdownWithTimeout(ctx, id, defaultGracefulShutdownTimeout); err != nil {
+		return contracts.NewRetryableError("failed to stop domain to apply pending changes", err)
+	}
+	if err := p.virshProvider.startDomain(ctx, id); err != nil {
+		return contracts.NewRetryableError("failed to start domain after applying pending changes", err)
+	}
+	return nil
+}
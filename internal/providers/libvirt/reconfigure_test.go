@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// transientVirsh answers for a running transient domain with 2 of at most 4 vCPUs and
+// 1 GiB of at most 4 GiB of memory
+const transientVirsh = `#!/bin/sh
+echo "$*" >> "$FAKE_VIRSH_LOG"
+case "$1" in
+domstate)
+	echo running
+	;;
+dominfo)
+	printf 'Name: vm1\nState: running\nCPU(s): 2\nMax memory: 4194304 KiB\nUsed memory: 1048576 KiB\nPersistent: no\n'
+	;;
+vcpucount)
+	printf 'maximum      live           4\ncurrent      live           2\n'
+	;;
+dumpxml)
+	echo "<domain><name>vm1</name><devices><disk type='file' device='disk'><target dev='vda' bus='virtio'/></disk></devices></domain>"
+	;;
+esac
+exit 0
+`
+
+func TestChangeScopeFlags(t *testing.T) {
+	tests := []struct {
+		scope changeScope
+		want  []string
+	}{
+		{scope: changeScope{}, want: []string{"--config"}},
+		{scope: changeScope{live: true}, want: []string{"--config", "--live"}},
+		{scope: changeScope{live: true, transient: true}, want: []string{"--live"}},
+		{scope: changeScope{transient: true}, want: []string{"--live"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.scope.flags(), "%+v", tt.scope)
+	}
+}
+
+// loggedCommands returns the virsh commands in the log whose first argument is one of names
+func loggedCommands(t *testing.T, logPath string, names ...string) []string {
+	t.Helper()
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	var commands []string
+	for _, line := range strings.Split(string(data), "\n") {
+		for _, name := range names {
+			if strings.HasPrefix(line, name+" ") {
+				commands = append(commands, line)
+			}
+		}
+	}
+	return commands
+}
+
+func TestReconfigureTransientDomainOnlyChangesLiveState(t *testing.T) {
+	p, logPath := newScriptedVirshProvider(t, transientVirsh)
+
+	desired := contracts.CreateRequest{
+		Class:              contracts.VMClass{CPU: 4, MemoryMiB: 2048},
+		VMMetadata:         map[string]string{"team": "web"},
+		AffinityGroups:     []string{"web"},
+		AntiAffinityGroups: []string{"db"},
+		DiskThrottles:      []contracts.DiskThrottle{{Target: "vda", TotalIOPS: 500}},
+	}
+	result, err := p.ReconfigureVM(context.Background(), "vm1", desired, false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Pending)
+	assert.ElementsMatch(t, []string{reconfigureFieldCPU, reconfigureFieldMemory, reconfigureFieldMetadata,
+		reconfigureFieldAffinity, reconfigureFieldThrottle}, result.Applied)
+
+	commands := loggedCommands(t, logPath, "setvcpus", "setmem", "metadata", "blkdeviotune")
+	require.Len(t, commands, 5)
+	for _, command := range commands {
+		assert.NotContains(t, command, "--config")
+		assert.Contains(t, command, "--live")
+	}
+	assert.Equal(t, "setvcpus vm1 4 --live", commands[0])
+	assert.Equal(t, "setmem vm1 2097152K --live", commands[1])
+}
+
+func TestDeviceAndTuningChangesOfTransientDomain(t *testing.T) {
+	p, logPath := newScriptedVirshProvider(t, transientVirsh)
+	ctx := context.Background()
+	scope := changeScope{live: true, transient: true}
+
+	require.NoError(t, p.applyDeviceXML(ctx, "attach-device", "vm1", "<interface type='network'/>", scope))
+	require.NoError(t, p.virshProvider.setDomainOwner(ctx, "vm1", "instance-a", scope))
+	require.NoError(t, p.applyTuning(ctx, "vm1", contracts.VMClass{
+		CPUTune:  &contracts.CPUTune{Shares: 2048, EmulatorPin: "0-1"},
+		NUMATune: &contracts.NUMATune{Nodeset: "0"},
+	}, scope))
+
+	commands := loggedCommands(t, logPath, "attach-device", "metadata", "schedinfo", "emulatorpin", "numatune")
+	require.Len(t, commands, 5)
+	for _, command := range commands {
+		assert.NotContains(t, command, "--config")
+		assert.True(t, strings.HasSuffix(command, "--live") || strings.Contains(command, "--live "), command)
+	}
+
+	// A persistent domain keeps its NUMA policy change for the next start
+	require.NoError(t, p.applyTuning(ctx, "vm1", contracts.VMClass{NUMATune: &contracts.NUMATune{Nodeset: "0"}}, changeScope{live: true}))
+	numa := loggedCommands(t, logPath, "numatune")
+	require.Len(t, numa, 2)
+	assert.True(t, strings.HasSuffix(numa[1], "--config"), numa[1])
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+)
+
+const (
+	// reconnectInitialBackoff is the delay before the first redial of a broken connection
+	reconnectInitialBackoff = 500 * time.Millisecond
+
+	// reconnectMaxBackoff caps the delay between redials
+	reconnectMaxBackoff = 30 * time.Second
+
+	// reconnectTimeout bounds how long an RPC waits for libvirt to come back
+	reconnectTimeout = 30 * time.Second
+)
+
+// Reasons reported by the reconnect metric
+const (
+	// reconnectReasonError is a command that failed on a broken connection
+	reconnectReasonError = "error"
+
+	// reconnectReasonClosed is the libvirt event session ending, i.e. libvirtd going away
+	reconnectReasonClosed = "closed"
+)
+
+// nextBackoff doubles a redial delay up to reconnectMaxBackoff
+func nextBackoff(backoff time.Duration) time.Duration {
+	return min(backoff*2, reconnectMaxBackoff)
+}
+
+// sleepContext waits for d or until ctx is done, reporting whether the full wait elapsed
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// reconnect redials a broken pooled connection with exponential backoff until libvirt
+// answers again, e.g. while libvirtd is restarting after an upgrade
+func (v *VirshProvider) reconnect(ctx context.Context, pool *connPool, conn *pooledConn) error {
+	connMetrics.RecordReconnect(reconnectReasonError)
+	pool.close(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, reconnectTimeout)
+	defer cancel()
+
+	backoff := reconnectInitialBackoff
+	for attempt := 1; ; attempt++ {
+		result, err := v.execVirshCommand(ctx, pool, conn, "version")
+		if err == nil {
+			log.Printf("INFO Reconnected libvirt connection %d after %d attempt(s)", conn.id, attempt)
+			return nil
+		}
+		if result == nil || !isInvalidConnectionError(result.Stderr) {
+			return err
+		}
+		if !sleepContext(ctx, backoff) {
+			return fmt.Errorf("libvirt did not come back after %d attempt(s): %w", attempt, err)
+		}
+		backoff = nextBackoff(backoff)
+		pool.close(conn)
+	}
+}
+
+// startConnectionWatch starts the close callback of the connection, once per provider
+func (v *VirshProvider) startConnectionWatch() {
+	v.watchMu.Lock()
+	defer v.watchMu.Unlock()
+	if v.stopWatch != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	v.stopWatch = cancel
+	go v.watchConnection(ctx)
+}
+
+// watchConnection keeps a virsh event session open as the connection close callback.
+// virsh cannot register one itself, but the session ends when libvirtd goes away; the
+// pooled connections are then invalidated, so RPCs redial instead of failing on them,
+// and the session is reopened with backoff until libvirt is back.
+func (v *VirshProvider) watchConnection(ctx context.Context) {
+	pool := getConnPool(v.uri)
+	backoff := reconnectInitialBackoff
+
+	for {
+		start := time.Now()
+		err := v.runEventSession(ctx, pool)
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Printf("WARN Libvirt connection closed (%v), reconnecting", err)
+		connMetrics.RecordReconnect(reconnectReasonClosed)
+		pool.invalidate()
+
+		// A session that stayed up for a while was a healthy connection; start over
+		if time.Since(start) > reconnectMaxBackoff {
+			backoff = reconnectInitialBackoff
+		}
+		if !sleepContext(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// runEventSession runs `virsh event --all --loop` on the watch connection until it ends
+func (v *VirshProvider) runEventSession(ctx context.Context, pool *connPool) error {
+	pool.close(pool.watch)
+	cmd, _, err := v.buildCommand(ctx, pool, pool.watch, "event", "--all", "--loop")
+	if err != nil {
+		return err
+	}
+	cmd.Stdout = io.Discard
+	cmd.Stderr = io.Discard
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	return fmt.Errorf("event session ended")
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// RenameVM renames a shut off domain. libvirt keeps the UUID, disks and metadata across the
+// rename; the ownership marker is re-stamped so the domain stays managed by this instance.
+// Domains managed by another instance are refused.
+func (p *Provider) RenameVM(ctx context.Context, vmID, newName string) error {
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if newName == "" || strings.ContainsAny(newName, "/\n") {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("invalid domain name %q", newName), nil)
+	}
+	if newName == vmID {
+		return nil
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	if domain.Name == newName {
+		return nil
+	}
+
+	// virDomainRename only works on inactive domains
+	state, err := p.virshProvider.getDomainState(ctx, domain.Name)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain state", err)
+	}
+	if state != "shut off" {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("domain %s is %s; it must be shut off to be renamed", domain.Name, state), nil)
+	}
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "dominfo", newName); err == nil {
+		return contracts.NewConflictError(fmt.Sprintf("cannot rename %s: domain %s already exists", domain.Name, newName), nil)
+	}
+
+	// libvirt refuses to rename domains with snapshots; report it before trying
+	snapshots, err := p.virshProvider.runVirshCommand(ctx, "snapshot-list", domain.Name, "--name")
+	if err != nil {
+		return contracts.NewRetryableError("failed to list snapshots", err)
+	}
+	if strings.TrimSpace(snapshots.Stdout) != "" {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("domain %s has snapshots; delete them before renaming", domain.Name), nil)
+	}
+
+	owner, err := p.virshProvider.getDomainOwner(ctx, domain.Name)
+	if err != nil {
+		return contracts.NewRetryableError("failed to read domain owner", err)
+	}
+	if owner != "" && owner != p.instanceID() {
+		return contracts.NewConflictError(fmt.Sprintf("domain %s is managed by virtrigaud instance %q", domain.Name, owner), nil)
+	}
+
+	log.Printf("INFO Renaming domain %s to %s", domain.Name, newName)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "domrename", domain.Name, newName); err != nil {
+		return fmt.Errorf("failed to rename domain %s: %w", domain.Name, err)
+	}
+
+	return p.virshProvider.setDomainOwner(ctx, newName, p.instanceID(), changeScope{})
+}
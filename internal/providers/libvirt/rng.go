@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// rngSource is the host entropy source of the paravirtual RNG; unlike /dev/random it
+// never blocks, so a guest cannot stall its neighbours by draining the host pool
+const rngSource = "/dev/urandom"
+
+// rngXML renders a virtio-rng device backed by the host entropy source; new VMs get one
+// unless the request opts out
+func rngXML(rng *contracts.RNGDevice) string {
+	if rng != nil && rng.Disabled {
+		return ""
+	}
+	rate := ""
+	if rng != nil && rng.RateBytes > 0 {
+		period := rng.RatePeriodMs
+		if period == 0 {
+			period = 1000
+		}
+		rate = fmt.Sprintf("\n      <rate bytes='%d' period='%d'/>", rng.RateBytes, period)
+	}
+	return fmt.Sprintf(`
+    <rng model='virtio'>%s
+      <backend model='random'>%s</backend>
+    </rng>`, rate, rngSource)
+}
+
+// contractRNG describes the RNG devices of a domain as a create request setting
+func contractRNG(rngs []domainRNG) *contracts.RNGDevice {
+	for _, rng := range rngs {
+		if rng.Model != "virtio" {
+			continue
+		}
+		if rng.Rate == nil {
+			return nil
+		}
+		return &contracts.RNGDevice{RateBytes: rng.Rate.Bytes, RatePeriodMs: rng.Rate.Period}
+	}
+	return &contracts.RNGDevice{Disabled: true}
+}
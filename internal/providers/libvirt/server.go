@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/internal/version"
 	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
 )
 
@@ -79,16 +80,35 @@ func (s *Server) Create(ctx context.Context, req *providerv1.CreateRequest) (*pr
 	// Parse JSON-encoded specifications
 	createReq, err := s.parseCreateRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse create request: %w", err)
+		return nil, contracts.NewInvalidSpecError("failed to parse create request", err)
 	}
 
-	resp, err := s.provider.Create(ctx, createReq)
+	if req.DryRun {
+		libvirtProvider, ok := s.provider.(*Provider)
+		if !ok || libvirtProvider == nil {
+			return nil, fmt.Errorf("provider does not support dry-run create")
+		}
+		dryRun, err := libvirtProvider.DryRunCreate(ctx, createReq)
+		if err != nil {
+			return nil, fmt.Errorf("dry-run create failed: %w", err)
+		}
+		return &providerv1.CreateResponse{
+			Id:                 createReq.Name,
+			RenderedDefinition: dryRun.DomainXML,
+			Warnings:           dryRun.Warnings,
+		}, nil
+	}
+
+	// A VM that exists on one of several hosts is returned from there
+	resp, err := s.vmProvider(ctx, createReq.Name).Create(ctx, createReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create VM: %w", err)
 	}
 
 	result := &providerv1.CreateResponse{
-		Id: resp.ID,
+		Id:            resp.ID,
+		AlreadyExists: resp.AlreadyExists,
+		Host:          resp.Host,
 	}
 
 	if resp.TaskRef != "" {
@@ -100,7 +120,7 @@ func (s *Server) Create(ctx context.Context, req *providerv1.CreateRequest) (*pr
 
 // Delete deletes a virtual machine
 func (s *Server) Delete(ctx context.Context, req *providerv1.DeleteRequest) (*providerv1.TaskResponse, error) {
-	taskRef, err := s.provider.Delete(ctx, req.Id)
+	taskRef, err := s.vmProvider(ctx, req.Id).Delete(ctx, req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete VM: %w", err)
 	}
@@ -126,10 +146,23 @@ func (s *Server) Power(ctx context.Context, req *providerv1.PowerRequest) (*prov
 	case providerv1.PowerOp_POWER_OP_SHUTDOWN_GRACEFUL:
 		powerOp = contracts.PowerOpShutdownGraceful
 	default:
-		return nil, fmt.Errorf("unsupported power operation: %v", req.Op)
+		return nil, contracts.NewInvalidSpecError(fmt.Sprintf("unsupported power operation: %v", req.Op), nil)
 	}
 
-	taskRef, err := s.provider.Power(ctx, req.Id, powerOp)
+	provider := s.vmProvider(ctx, req.Id)
+
+	// Power-off honours the requested graceful timeout and reports a forced fallback
+	if libvirtProvider, ok := provider.(*Provider); ok &&
+		(powerOp == contracts.PowerOpOff || powerOp == contracts.PowerOpShutdownGraceful) {
+		timeout := time.Duration(req.GracefulTimeoutSeconds) * time.Second
+		forced, err := libvirtProvider.ShutdownWithTimeout(ctx, req.Id, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to perform power operation: %w", err)
+		}
+		return &providerv1.TaskResponse{Forced: forced}, nil
+	}
+
+	taskRef, err := provider.Power(ctx, req.Id, powerOp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform power operation: %w", err)
 	}
@@ -144,18 +177,99 @@ func (s *Server) Power(ctx context.Context, req *providerv1.PowerRequest) (*prov
 
 // Reconfigure reconfigures a virtual machine
 func (s *Server) Reconfigure(ctx context.Context, req *providerv1.ReconfigureRequest) (*providerv1.TaskResponse, error) {
-	// Parse the desired configuration
-	var createReq contracts.CreateRequest
-	if err := json.Unmarshal([]byte(req.DesiredJson), &createReq); err != nil {
-		return nil, fmt.Errorf("failed to parse desired configuration: %w", err)
+	var taskRef string
+	result := &providerv1.TaskResponse{}
+	provider := s.vmProvider(ctx, req.Id)
+
+	// A request may carry only device changes (disks, interfaces, USB devices, memory, vCPUs) or only metadata
+	liveChanges := len(req.DiskResizes) > 0 || req.NetworksJson != "" || req.HostDevicesJson != "" ||
+		req.TargetMemoryMib > 0 || req.TargetVcpus > 0
+	deviceOnly := req.DesiredJson == "" && liveChanges
+	if !deviceOnly || len(req.VmMetadata) > 0 {
+		// Parse the desired configuration
+		var createReq contracts.CreateRequest
+		if req.DesiredJson != "" || len(req.VmMetadata) == 0 {
+			if err := json.Unmarshal([]byte(req.DesiredJson), &createReq); err != nil {
+				return nil, contracts.NewInvalidSpecError("failed to parse desired configuration", err)
+			}
+		}
+		if len(req.VmMetadata) > 0 {
+			createReq.VMMetadata = req.VmMetadata
+		}
+
+		// The libvirt provider reports changes that wait for a power cycle
+		if libvirtProvider, ok := provider.(*Provider); ok && libvirtProvider != nil {
+			reconfigured, err := libvirtProvider.ReconfigureVM(ctx, req.Id, createReq, req.ForceReboot)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reconfigure VM: %w", err)
+			}
+			for _, change := range reconfigured.Pending {
+				result.PendingChanges = append(result.PendingChanges, &providerv1.PendingChange{
+					Field:   change.Field,
+					Current: change.Current,
+					Desired: change.Desired,
+					Reason:  change.Reason,
+				})
+			}
+			result.Rebooted = reconfigured.Rebooted
+		} else {
+			var err error
+			taskRef, err = provider.Reconfigure(ctx, req.Id, createReq)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reconfigure VM: %w", err)
+			}
+		}
 	}
 
-	taskRef, err := s.provider.Reconfigure(ctx, req.Id, createReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to reconfigure VM: %w", err)
+	if liveChanges {
+		virshProvider, ok := provider.(*Provider)
+		if !ok || virshProvider == nil {
+			return nil, fmt.Errorf("provider does not support device reconfiguration")
+		}
+
+		if req.NetworksJson != "" {
+			var networks []contracts.NetworkAttachment
+			if err := json.Unmarshal([]byte(req.NetworksJson), &networks); err != nil {
+				return nil, contracts.NewInvalidSpecError("failed to parse networks JSON", err)
+			}
+			if err := virshProvider.ReconcileNetworkInterfaces(ctx, req.Id, networks); err != nil {
+				return nil, fmt.Errorf("failed to reconcile network interfaces: %w", err)
+			}
+		}
+
+		if req.HostDevicesJson != "" {
+			var devices []contracts.HostDevice
+			if err := json.Unmarshal([]byte(req.HostDevicesJson), &devices); err != nil {
+				return nil, contracts.NewInvalidSpecError("failed to parse host devices JSON", err)
+			}
+			if err := virshProvider.ReconcileUSBDevices(ctx, req.Id, devices); err != nil {
+				return nil, fmt.Errorf("failed to reconcile USB devices: %w", err)
+			}
+		}
+
+		for _, resize := range req.DiskResizes {
+			if _, err := virshProvider.ResizeDisk(ctx, req.Id, resize.Target, resize.SizeBytes); err != nil {
+				return nil, fmt.Errorf("failed to resize disk %s: %w", resize.Target, err)
+			}
+		}
+
+		if req.TargetMemoryMib > 0 {
+			applied, err := virshProvider.SetBalloonMemory(ctx, req.Id, req.TargetMemoryMib)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set memory: %w", err)
+			}
+			result.AppliedMemoryMib = applied
+		}
+
+		if req.TargetVcpus > 0 {
+			online, err := virshProvider.SetVCPUs(ctx, req.Id, req.TargetVcpus)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set vCPUs: %w", err)
+			}
+			result.OnlineVcpus = online
+		}
 	}
 
-	result := &providerv1.TaskResponse{}
 	if taskRef != "" {
 		result.Task = &providerv1.TaskRef{Id: taskRef}
 	}
@@ -169,7 +283,7 @@ func (s *Server) Describe(ctx context.Context, req *providerv1.DescribeRequest)
 		return nil, fmt.Errorf("provider not initialized")
 	}
 
-	resp, err := s.provider.Describe(ctx, req.Id)
+	resp, err := s.vmProvider(ctx, req.Id).Describe(ctx, req.Id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe VM: %w", err)
 	}
@@ -183,18 +297,70 @@ func (s *Server) Describe(ctx context.Context, req *providerv1.DescribeRequest)
 		}
 	}
 
+	guestInterfaces := make([]*providerv1.GuestInterface, 0, len(resp.GuestInterfaces))
+	for _, iface := range resp.GuestInterfaces {
+		guestInterfaces = append(guestInterfaces, &providerv1.GuestInterface{
+			Name:        iface.Name,
+			Mac:         iface.MAC,
+			Addresses:   iface.Addresses,
+			Source:      iface.Source,
+			Provisional: iface.Provisional,
+		})
+	}
+
+	disks := make([]*providerv1.DiskAllocation, 0, len(resp.Disks))
+	for _, disk := range resp.Disks {
+		disks = append(disks, &providerv1.DiskAllocation{
+			Target:          disk.Target,
+			Path:            disk.Path,
+			CapacityBytes:   disk.CapacityBytes,
+			AllocationBytes: disk.AllocationBytes,
+			PhysicalBytes:   disk.PhysicalBytes,
+		})
+	}
+
+	diskThrottles := make([]*providerv1.DiskThrottle, 0, len(resp.DiskThrottles))
+	for _, throttle := range resp.DiskThrottles {
+		diskThrottles = append(diskThrottles, &providerv1.DiskThrottle{
+			Target:           throttle.Target,
+			TotalBytesPerSec: throttle.TotalBytesPerSec,
+			ReadBytesPerSec:  throttle.ReadBytesPerSec,
+			WriteBytesPerSec: throttle.WriteBytesPerSec,
+			TotalIops:        throttle.TotalIOPS,
+			ReadIops:         throttle.ReadIOPS,
+			WriteIops:        throttle.WriteIOPS,
+		})
+	}
+
 	return &providerv1.DescribeResponse{
-		Exists:          resp.Exists,
-		PowerState:      resp.PowerState,
-		Ips:             resp.IPs,
-		ConsoleUrl:      resp.ConsoleURL,
-		ProviderRawJson: providerRawJSON,
+		Exists:               resp.Exists,
+		PowerState:           resp.PowerState,
+		Ips:                  resp.IPs,
+		ConsoleUrl:           resp.ConsoleURL,
+		ProviderRawJson:      providerRawJSON,
+		VmMetadata:           resp.VMMetadata,
+		AffinityGroups:       resp.AffinityGroups,
+		AntiAffinityGroups:   resp.AntiAffinityGroups,
+		BootOrder:            resp.BootOrder,
+		Firmware:             resp.Firmware,
+		SecureBoot:           resp.SecureBoot,
+		GuestInterfaces:      guestInterfaces,
+		HasManagedSave:       resp.HasManagedSave,
+		ManagedSaveSizeBytes: resp.ManagedSaveSizeBytes,
+		WatchdogTriggered:    resp.WatchdogTriggered,
+		Crashed:              resp.Crashed,
+		CrashReason:          resp.CrashReason,
+		GuestHostname:        resp.GuestHostname,
+		DiskThrottles:        diskThrottles,
+		Ephemeral:            resp.Ephemeral,
+		Disks:                disks,
+		SpecHash:             resp.SpecHash,
 	}, nil
 }
 
 // TaskStatus checks the status of an async task
 func (s *Server) TaskStatus(ctx context.Context, req *providerv1.TaskStatusRequest) (*providerv1.TaskStatusResponse, error) {
-	done, err := s.provider.IsTaskComplete(ctx, req.Task.Id)
+	status, err := s.provider.TaskStatus(ctx, req.Task.Id)
 	if err != nil {
 		return &providerv1.TaskStatusResponse{
 			Done:  false,
@@ -203,22 +369,49 @@ func (s *Server) TaskStatus(ctx context.Context, req *providerv1.TaskStatusReque
 	}
 
 	return &providerv1.TaskStatusResponse{
-		Done:  done,
-		Error: "",
+		Done:            status.IsCompleted,
+		Error:           status.Error,
+		Message:         status.Message,
+		ProgressPercent: status.ProgressPercent,
 	}, nil
 }
 
 // parseCreateRequest converts gRPC request to contracts.CreateRequest
 func (s *Server) parseCreateRequest(req *providerv1.CreateRequest) (contracts.CreateRequest, error) {
 	createReq := contracts.CreateRequest{
-		Name: req.Name,
-		Tags: req.Tags,
+		Name:        req.Name,
+		Tags:        req.Tags,
+		VMMetadata:  req.VmMetadata,
+		UUID:        req.Uuid,
+		SerialLog:   req.SerialLog,
+		StoragePool: req.StoragePool,
+		OSType:      req.OsType,
+		OSVariant:   req.OsVariant,
+		QemuArgs:    req.QemuArgs,
+		OnCrash:     req.OnCrash,
+		Ephemeral:   req.Ephemeral,
+
+		AffinityGroups:     req.AffinityGroups,
+		AntiAffinityGroups: req.AntiAffinityGroups,
 	}
 
 	// Parse UserData if provided
 	if len(req.UserData) > 0 {
 		createReq.UserData = &contracts.UserData{
 			CloudInitData: string(req.UserData),
+			Type:          req.UserDataType,
+		}
+	}
+
+	// Parse MetaData and NetworkConfig if provided
+	if len(req.MetaData) > 0 {
+		createReq.MetaData = &contracts.MetaData{
+			MetaDataYAML: string(req.MetaData),
+		}
+	}
+	if len(req.NetworkConfig) > 0 {
+		createReq.NetworkConfig = &contracts.NetworkConfig{
+			NetworkConfigYAML: string(req.NetworkConfig),
 		}
 	}
 
@@ -250,6 +443,62 @@ func (s *Server) parseCreateRequest(req *providerv1.CreateRequest) (contracts.Cr
 		}
 	}
 
+	// Parse HostDevices
+	if req.HostDevicesJson != "" {
+		if err := json.Unmarshal([]byte(req.HostDevicesJson), &createReq.HostDevices); err != nil {
+			return createReq, fmt.Errorf("failed to parse host devices JSON: %w", err)
+		}
+	}
+
+	// Parse RNG
+	if req.RngJson != "" {
+		if err := json.Unmarshal([]byte(req.RngJson), &createReq.RNG); err != nil {
+			return createReq, fmt.Errorf("failed to parse RNG JSON: %w", err)
+		}
+	}
+
+	// Parse Boot
+	if req.BootJson != "" {
+		if err := json.Unmarshal([]byte(req.BootJson), &createReq.Boot); err != nil {
+			return createReq, fmt.Errorf("failed to parse boot JSON: %w", err)
+		}
+	}
+
+	// Parse Graphics
+	if req.GraphicsJson != "" {
+		if err := json.Unmarshal([]byte(req.GraphicsJson), &createReq.Graphics); err != nil {
+			return createReq, fmt.Errorf("failed to parse graphics JSON: %w", err)
+		}
+	}
+
+	// Parse Inputs
+	if req.InputsJson != "" {
+		if err := json.Unmarshal([]byte(req.InputsJson), &createReq.Inputs); err != nil {
+			return createReq, fmt.Errorf("failed to parse inputs JSON: %w", err)
+		}
+	}
+
+	// Parse DiskThrottles
+	if req.DiskThrottlesJson != "" {
+		if err := json.Unmarshal([]byte(req.DiskThrottlesJson), &createReq.DiskThrottles); err != nil {
+			return createReq, fmt.Errorf("failed to parse disk throttles JSON: %w", err)
+		}
+	}
+
+	// Parse Watchdog
+	if req.WatchdogJson != "" {
+		if err := json.Unmarshal([]byte(req.WatchdogJson), &createReq.Watchdog); err != nil {
+			return createReq, fmt.Errorf("failed to parse watchdog JSON: %w", err)
+		}
+	}
+
+	// Parse Clock
+	if req.ClockJson != "" {
+		if err := json.Unmarshal([]byte(req.ClockJson), &createReq.Clock); err != nil {
+			return createReq, fmt.Errorf("failed to parse clock JSON: %w", err)
+		}
+	}
+
 	// Parse Placement
 	if req.PlacementJson != "" {
 		if err := json.Unmarshal([]byte(req.PlacementJson), &createReq.Placement); err != nil {
@@ -260,12 +509,120 @@ func (s *Server) parseCreateRequest(req *providerv1.CreateRequest) (contracts.Cr
 	return createReq, nil
 }
 
+// createRequestProto converts a contracts.CreateRequest back into its gRPC form
+func createRequestProto(req contracts.CreateRequest) (*providerv1.CreateRequest, error) {
+	out := &providerv1.CreateRequest{
+		Name:        req.Name,
+		Tags:        req.Tags,
+		VmMetadata:  req.VMMetadata,
+		Uuid:        req.UUID,
+		SerialLog:   req.SerialLog,
+		StoragePool: req.StoragePool,
+		OsType:      req.OSType,
+		OsVariant:   req.OSVariant,
+		QemuArgs:    req.QemuArgs,
+		OnCrash:     req.OnCrash,
+		Ephemeral:   req.Ephemeral,
+
+		AffinityGroups:     req.AffinityGroups,
+		AntiAffinityGroups: req.AntiAffinityGroups,
+	}
+
+	classJSON, err := json.Marshal(req.Class)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode class JSON: %w", err)
+	}
+	out.ClassJson = string(classJSON)
+
+	if len(req.Networks) > 0 {
+		networksJSON, err := json.Marshal(req.Networks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode networks JSON: %w", err)
+		}
+		out.NetworksJson = string(networksJSON)
+	}
+
+	if len(req.Disks) > 0 {
+		disksJSON, err := json.Marshal(req.Disks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode disks JSON: %w", err)
+		}
+		out.DisksJson = string(disksJSON)
+	}
+
+	if len(req.HostDevices) > 0 {
+		hostDevicesJSON, err := json.Marshal(req.HostDevices)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode host devices JSON: %w", err)
+		}
+		out.HostDevicesJson = string(hostDevicesJSON)
+	}
+
+	if req.RNG != nil {
+		rngJSON, err := json.Marshal(req.RNG)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode RNG JSON: %w", err)
+		}
+		out.RngJson = string(rngJSON)
+	}
+
+	if req.Boot != nil {
+		bootJSON, err := json.Marshal(req.Boot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode boot JSON: %w", err)
+		}
+		out.BootJson = string(bootJSON)
+	}
+
+	if req.Graphics != nil {
+		graphicsJSON, err := json.Marshal(req.Graphics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode graphics JSON: %w", err)
+		}
+		out.GraphicsJson = string(graphicsJSON)
+	}
+
+	if req.Inputs != nil {
+		inputsJSON, err := json.Marshal(req.Inputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode inputs JSON: %w", err)
+		}
+		out.InputsJson = string(inputsJSON)
+	}
+
+	if req.DiskThrottles != nil {
+		throttlesJSON, err := json.Marshal(req.DiskThrottles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode disk throttles JSON: %w", err)
+		}
+		out.DiskThrottlesJson = string(throttlesJSON)
+	}
+
+	if req.Watchdog != nil {
+		watchdogJSON, err := json.Marshal(req.Watchdog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode watchdog JSON: %w", err)
+		}
+		out.WatchdogJson = string(watchdogJSON)
+	}
+
+	if req.Clock != nil {
+		clockJSON, err := json.Marshal(req.Clock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode clock JSON: %w", err)
+		}
+		out.ClockJson = string(clockJSON)
+	}
+
+	return out, nil
+}
+
 // SnapshotCreate creates a VM snapshot
 func (s *Server) SnapshotCreate(ctx context.Context, req *providerv1.SnapshotCreateRequest) (*providerv1.SnapshotCreateResponse, error) {
 	log.Printf("INFO Creating snapshot for VM: %s", req.VmId)
 
 	// Get the provider instance and cast to libvirt Provider
-	libvirtProvider, ok := s.provider.(*Provider)
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
 	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
 		return nil, fmt.Errorf("libvirt provider not initialized")
 	}
@@ -293,6 +650,28 @@ func (s *Server) SnapshotCreate(ctx context.Context, req *providerv1.SnapshotCre
 
 	log.Printf("INFO Domain %s is in state: %s", req.VmId, domainState)
 
+	if req.External {
+		if !req.DiskOnly || req.IncludeMemory {
+			return nil, contracts.NewInvalidSpecError("external snapshots must be disk-only", nil)
+		}
+		snapshot, err := libvirtProvider.CreateExternalSnapshot(ctx, req.VmId, snapshotName, description)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create snapshot: %w", err)
+		}
+		resp := &providerv1.SnapshotCreateResponse{
+			SnapshotId: snapshot.Name,
+			Quiesced:   snapshot.Quiesced,
+		}
+		for _, overlay := range snapshot.Overlays {
+			resp.Overlays = append(resp.Overlays, &providerv1.SnapshotOverlay{
+				Disk:        overlay.Disk,
+				OverlayPath: overlay.OverlayPath,
+				BasePath:    overlay.BasePath,
+			})
+		}
+		return resp, nil
+	}
+
 	// Build virsh snapshot-create-as command
 	// Format: virsh snapshot-create-as DOMAIN NAME --description "DESC" [--disk-only] [--atomic]
 	args := []string{
@@ -304,7 +683,7 @@ func (s *Server) SnapshotCreate(ctx context.Context, req *providerv1.SnapshotCre
 	}
 
 	// Determine snapshot type based on domain state and request
-	if req.IncludeMemory && domainState == "running" {
+	if req.IncludeMemory && !req.DiskOnly && domainState == "running" {
 		// Memory snapshot (full system checkpoint including RAM)
 		log.Printf("INFO Creating memory snapshot (includes RAM state)")
 		// No --disk-only flag = full snapshot with memory
@@ -329,116 +708,94 @@ func (s *Server) SnapshotCreate(ctx context.Context, req *providerv1.SnapshotCre
 	}, nil
 }
 
-// SnapshotDelete deletes a VM snapshot
+// SnapshotDelete deletes a VM snapshot, optionally with its descendants
 func (s *Server) SnapshotDelete(ctx context.Context, req *providerv1.SnapshotDeleteRequest) (*providerv1.TaskResponse, error) {
-	log.Printf("INFO Deleting snapshot %s from VM: %s", req.SnapshotId, req.VmId)
-
 	// Get the provider instance and cast to libvirt Provider
-	libvirtProvider, ok := s.provider.(*Provider)
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
 	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
 		return nil, fmt.Errorf("libvirt provider not initialized")
 	}
 
-	// Check if snapshot exists
-	exists, err := libvirtProvider.virshProvider.snapshotExists(ctx, req.VmId, req.SnapshotId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check snapshot existence: %w", err)
-	}
-
-	if !exists {
-		log.Printf("WARN Snapshot %s does not exist, considering deletion successful", req.SnapshotId)
-		return &providerv1.TaskResponse{}, nil
-	}
-
-	// Delete the snapshot
-	// Format: virsh snapshot-delete DOMAIN SNAPSHOT --metadata
-	// Using --metadata keeps the data but removes snapshot metadata (safer for external snapshots)
-	// For internal snapshots, this will delete both metadata and disk changes
-	args := []string{
-		"snapshot-delete",
-		req.VmId,
-		req.SnapshotId,
-	}
-
-	result, err := libvirtProvider.virshProvider.runVirshCommand(ctx, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete snapshot: %w", err)
+	if err := libvirtProvider.DeleteSnapshot(ctx, req.VmId, req.SnapshotId, req.Children); err != nil {
+		return nil, err
 	}
 
-	log.Printf("INFO Snapshot deleted successfully: %s\nOutput: %s", req.SnapshotId, result.Stdout)
-
 	// Return empty response (synchronous operation)
 	return &providerv1.TaskResponse{}, nil
 }
 
 // SnapshotRevert reverts a VM to a snapshot
 func (s *Server) SnapshotRevert(ctx context.Context, req *providerv1.SnapshotRevertRequest) (*providerv1.TaskResponse, error) {
-	log.Printf("INFO Reverting VM %s to snapshot: %s", req.VmId, req.SnapshotId)
-
 	// Get the provider instance and cast to libvirt Provider
-	libvirtProvider, ok := s.provider.(*Provider)
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
 	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
 		return nil, fmt.Errorf("libvirt provider not initialized")
 	}
 
-	// Check if snapshot exists
-	exists, err := libvirtProvider.virshProvider.snapshotExists(ctx, req.VmId, req.SnapshotId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check snapshot existence: %w", err)
-	}
-
-	if !exists {
-		return nil, fmt.Errorf("snapshot %s does not exist", req.SnapshotId)
+	if err := libvirtProvider.RevertSnapshot(ctx, req.VmId, req.SnapshotId, SnapshotRevertOptions{
+		PowerState: req.PowerState,
+	}); err != nil {
+		return nil, err
 	}
 
-	// Get current domain state
-	domainState, err := libvirtProvider.virshProvider.getDomainState(ctx, req.VmId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get domain state: %w", err)
-	}
-
-	log.Printf("INFO Domain %s current state: %s", req.VmId, domainState)
-
-	// Revert to snapshot
-	// Format: virsh snapshot-revert DOMAIN SNAPSHOT --running|--paused
-	args := []string{
-		"snapshot-revert",
-		req.VmId,
-		req.SnapshotId,
-		"--force", // Force revert even if domain is running
-	}
+	// Return empty response (synchronous operation)
+	return &providerv1.TaskResponse{}, nil
+}
 
-	// If domain was running, keep it running after revert
-	if domainState == "running" {
-		args = append(args, "--running")
+// ListSnapshots lists the snapshots of a VM in creation order
+func (s *Server) ListSnapshots(ctx context.Context, req *providerv1.ListSnapshotsRequest) (*providerv1.ListSnapshotsResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
 	}
 
-	result, err := libvirtProvider.virshProvider.runVirshCommand(ctx, args...)
+	snapshots, err := libvirtProvider.ListSnapshots(ctx, req.VmId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to revert to snapshot: %w", err)
+		return nil, err
+	}
+
+	resp := &providerv1.ListSnapshotsResponse{}
+	for _, snap := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, &providerv1.SnapshotInfo{
+			Id:               snap.Name,
+			Description:      snap.Description,
+			CreationTimeUnix: snap.CreationTime,
+			ParentId:         snap.Parent,
+			IncludesMemory:   snap.IncludesMemory,
+			State:            snap.State,
+			Current:          snap.Current,
+			DeltaBytes:       snap.DeltaBytes,
+		})
+		resp.TotalDeltaBytes += snap.DeltaBytes
 	}
-
-	log.Printf("INFO Successfully reverted to snapshot: %s\nOutput: %s", req.SnapshotId, result.Stdout)
-
-	// Return empty response (synchronous operation)
-	return &providerv1.TaskResponse{}, nil
+	return resp, nil
 }
 
-// Clone creates a VM clone
+// Clone creates a VM clone; linked clones use qcow2 overlays, full clones copy the disks
+// in the background and report progress through TaskStatus
 func (s *Server) Clone(ctx context.Context, req *providerv1.CloneRequest) (*providerv1.CloneResponse, error) {
-	// Libvirt supports cloning via volume clone + new domain definition
-	// Linked clones are supported with qcow2 backing files
-	targetVMID := fmt.Sprintf("vm-clone-%s", generateVMID())
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.SourceVmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
 
-	// Simulate async clone operation
-	taskRef := &providerv1.TaskRef{
-		Id: fmt.Sprintf("task-clone-%s", generateTaskID()),
+	result, err := libvirtProvider.CloneVM(ctx, req.SourceVmId, req.TargetName, CloneOptions{
+		Linked: req.Linked,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &providerv1.CloneResponse{
-		TargetVmId: targetVMID,
-		Task:       taskRef,
-	}, nil
+	resp := &providerv1.CloneResponse{
+		TargetVmId: result.VMID,
+		Linked:     result.Linked,
+	}
+	if result.TaskRef != "" {
+		resp.Task = &providerv1.TaskRef{Id: result.TaskRef}
+	}
+	return resp, nil
 }
 
 // ImagePrepare prepares/imports a VM image
@@ -464,9 +821,40 @@ func (s *Server) GetCapabilities(ctx context.Context, req *providerv1.GetCapabil
 		SupportsImageImport:         true,  // Supports downloading images to storage pools
 		SupportedDiskTypes:          []string{"qcow2", "raw", "vmdk"},
 		SupportedNetworkTypes:       []string{"virtio", "e1000", "rtl8139"},
+		SupportedConsoleTypes:       supportedConsoleTypes,
+		SupportedUserDataTypes:      s.supportedUserDataTypes(),
+		ProviderType:                ProviderType,
+		Version:                     version.String(),
+		Capabilities:                s.capabilities(),
+		SupportedPlatforms:          SupportedPlatforms,
 	}, nil
 }
 
+// vmProvider returns the provider of the host a VM is on (see Provider.providerForVM)
+func (s *Server) vmProvider(ctx context.Context, vmID string) contracts.Provider {
+	if libvirtProvider, ok := s.provider.(*Provider); ok && libvirtProvider != nil {
+		return libvirtProvider.providerForVM(ctx, vmID)
+	}
+	return s.provider
+}
+
+// capabilities lists the features of the provider, as advertised at startup
+func (s *Server) capabilities() []string {
+	if libvirtProvider, ok := s.provider.(*Provider); ok && libvirtProvider != nil {
+		return libvirtProvider.Capabilities()
+	}
+	return append([]string(nil), baseCapabilities...)
+}
+
+// supportedUserDataTypes lists the user data formats accepted by Create
+func (s *Server) supportedUserDataTypes() []string {
+	types := []string{"cloud-init"}
+	if libvirtProvider, ok := s.provider.(*Provider); ok && libvirtProvider.options.EnableIgnition {
+		types = append(types, userDataTypeIgnition)
+	}
+	return types
+}
+
 // ImportDisk imports a disk from an external source (for VM migration)
 func (s *Server) ImportDisk(ctx context.Context, req *providerv1.ImportDiskRequest) (*providerv1.ImportDiskResponse, error) {
 	log.Printf("INFO Starting disk import from %s", req.SourceUrl)
@@ -540,8 +928,8 @@ func (s *Server) ImportDisk(ctx context.Context, req *providerv1.ImportDiskReque
 		log.Printf("DEBUG Source disk info: %s", infoResult.Stdout)
 	}
 
-	// Determine target pool (use StorageHint or default to "default")
-	poolName := "default"
+	// Determine target pool (use StorageHint or the configured pool)
+	poolName := libvirtProvider.virshProvider.storagePool()
 	if req.StorageHint != "" {
 		poolName = req.StorageHint
 	}
@@ -611,7 +999,26 @@ func (s *Server) ListVMs(ctx context.Context, req *providerv1.ListVMsRequest) (*
 		return nil, fmt.Errorf("provider not initialized")
 	}
 
-	vmInfos, err := s.provider.ListVMs(ctx)
+	var vmInfos []contracts.VMInfo
+	var nextPageToken string
+	var err error
+	if req.LabelSelector != "" || req.PageSize > 0 || req.PageToken != "" {
+		opts := ListOptions{PageSize: int(req.PageSize), PageToken: req.PageToken}
+		if req.LabelSelector != "" {
+			selector, parseErr := parseLabelSelector(req.LabelSelector)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			opts.Selector = selector
+		}
+		libvirtProvider, ok := s.provider.(*Provider)
+		if !ok {
+			return nil, fmt.Errorf("label selectors and pagination require the libvirt provider")
+		}
+		vmInfos, nextPageToken, err = libvirtProvider.ListVMsPage(ctx, opts)
+	} else {
+		vmInfos, err = s.provider.ListVMs(ctx)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to list VMs: %w", err)
 	}
@@ -654,10 +1061,162 @@ func (s *Server) ListVMs(ctx context.Context, req *providerv1.ListVMsRequest) (*
 	}
 
 	return &providerv1.ListVMsResponse{
-		Vms: protoVMInfos,
+		Vms:           protoVMInfos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Migrate migrates a VM to another host; progress is reported via TaskStatus
+func (s *Server) Migrate(ctx context.Context, req *providerv1.MigrateRequest) (*providerv1.TaskResponse, error) {
+	log.Printf("INFO Migrating VM %s to %s (live: %t)", req.VmId, req.Destination, req.Live)
+
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	taskRef, err := libvirtProvider.Migrate(ctx, req.VmId, req.Destination, MigrateOptions{
+		Live:           req.Live,
+		BandwidthMiBps: req.BandwidthMibps,
+		MaxDowntimeMs:  req.MaxDowntimeMs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate VM: %w", err)
+	}
+
+	return &providerv1.TaskResponse{
+		Task: &providerv1.TaskRef{Id: taskRef},
+	}, nil
+}
+
+// GetCapacity reports host CPU, memory and storage capacity for placement decisions
+func (s *Server) GetCapacity(ctx context.Context, req *providerv1.GetCapacityRequest) (*providerv1.GetCapacityResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	capacity, err := libvirtProvider.GetCapacity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host capacity: %w", err)
+	}
+	log.Printf("DEBUG Host capacity: %s", capacity)
+
+	cpuAvailable := capacity.CPUs - capacity.AllocatedVCPUs
+	if cpuAvailable < 0 {
+		cpuAvailable = 0
+	}
+
+	resp := &providerv1.GetCapacityResponse{
+		Cpu: &providerv1.ResourceCapacity{
+			Total:                capacity.CPUs,
+			Allocated:            capacity.AllocatedVCPUs,
+			Available:            cpuAvailable,
+			Schedulable:          capacity.SchedulableCPUs,
+			SchedulableAvailable: capacity.SchedulableAvailableCPUs(),
+			OvercommitRatio:      capacity.CPUOvercommit,
+			CommitmentRatio:      commitmentRatio(capacity.AllocatedVCPUs, capacity.CPUs),
+		},
+		MemoryBytes: &providerv1.ResourceCapacity{
+			Total:                capacity.MemoryBytes,
+			Allocated:            capacity.AllocatedMemoryBytes,
+			Available:            capacity.FreeMemoryBytes,
+			Schedulable:          capacity.SchedulableMemoryBytes,
+			SchedulableAvailable: capacity.SchedulableAvailableMemoryBytes(),
+			OvercommitRatio:      capacity.MemoryOvercommit,
+			CommitmentRatio:      commitmentRatio(capacity.AllocatedMemoryBytes, capacity.MemoryBytes),
+		},
+		RunningVms: int32(capacity.RunningDomains),
+		TotalVms:   int32(capacity.TotalDomains),
+	}
+	for _, pool := range capacity.StoragePools {
+		resp.StoragePools = append(resp.StoragePools, &providerv1.StoragePoolCapacity{
+			Name:           pool.Name,
+			CapacityBytes:  pool.CapacityBytes,
+			AllocatedBytes: pool.AllocatedBytes,
+			AvailableBytes: pool.AvailableBytes,
+		})
+	}
+
+	return resp, nil
+}
+
+// EnsureVM converges a VM to the desired spec and power state, reporting the actions taken
+func (s *Server) EnsureVM(ctx context.Context, req *providerv1.EnsureVMRequest) (*providerv1.EnsureVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+	if req.Spec == nil {
+		return nil, contracts.NewInvalidSpecError("desired spec is required", nil)
+	}
+
+	desired, err := s.parseCreateRequest(req.Spec)
+	if err != nil {
+		return nil, contracts.NewInvalidSpecError("failed to parse desired spec", err)
+	}
+
+	result, err := libvirtProvider.providerForVM(ctx, desired.Name).EnsureVM(ctx, desired, contracts.PowerState(req.PowerState))
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure VM: %w", err)
+	}
+
+	return &providerv1.EnsureVMResponse{
+		Id:         result.ID,
+		Created:    result.Created,
+		Actions:    result.Actions,
+		PowerState: string(result.PowerState),
 	}, nil
 }
 
+// GetVMStats reports cumulative CPU, memory, disk and network counters of a VM
+func (s *Server) GetVMStats(ctx context.Context, req *providerv1.GetVMStatsRequest) (*providerv1.GetVMStatsResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	stats, err := libvirtProvider.GetVMStats(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VM stats: %w", err)
+	}
+
+	resp := &providerv1.GetVMStatsResponse{
+		State:             stats.State,
+		TimestampUnixNano: stats.Timestamp.UnixNano(),
+		CpuTimeNs:         stats.CPUTimeNs,
+		Vcpus:             stats.VCPUs,
+		MemoryActualBytes: stats.MemoryActualBytes,
+		MemoryUsedBytes:   stats.MemoryUsedBytes,
+		MemoryRssBytes:    stats.MemoryRSSBytes,
+	}
+	for _, disk := range stats.Disks {
+		resp.Disks = append(resp.Disks, &providerv1.DiskStats{
+			Device:     disk.Device,
+			Path:       disk.Path,
+			ReadBytes:  disk.ReadBytes,
+			WriteBytes: disk.WriteBytes,
+			ReadOps:    disk.ReadOps,
+			WriteOps:   disk.WriteOps,
+		})
+	}
+	for _, iface := range stats.Interfaces {
+		resp.Interfaces = append(resp.Interfaces, &providerv1.InterfaceStats{
+			Name:      iface.Name,
+			RxBytes:   iface.RxBytes,
+			TxBytes:   iface.TxBytes,
+			RxPackets: iface.RxPackets,
+			TxPackets: iface.TxPackets,
+		})
+	}
+
+	return resp, nil
+}
+
 // copyDiskToRemote copies a disk file from local pod storage to the remote libvirt host
 func (s *Server) copyDiskToRemote(ctx context.Context, virshProvider *VirshProvider, localPath, volumeName string) (string, error) {
 	// IMPORTANT: Copy directly to libvirt pool directory for efficient in-place usage
@@ -688,7 +1247,8 @@ func (s *Server) copyDiskToRemote(ctx context.Context, virshProvider *VirshProvi
 	
 	// Run scp LOCALLY on the pod to copy to remote host
 	var cmd *exec.Cmd
-	if virshProvider.credentials.Password != "" {
+	creds, _ := virshProvider.session()
+	if creds.Password != "" {
 		// Use sshpass with scp for password authentication
 		cmd = exec.CommandContext(ctx, "sshpass", "-e", "scp",
 			"-o", "StrictHostKeyChecking=accept-new",
@@ -696,7 +1256,7 @@ func (s *Server) copyDiskToRemote(ctx context.Context, virshProvider *VirshProvi
 			localPath,
 			fmt.Sprintf("%s:%s", sshTarget, remotePath))
 		// Set password via environment variable for sshpass
-		cmd.Env = append(os.Environ(), fmt.Sprintf("SSHPASS=%s", virshProvider.credentials.Password))
+		cmd.Env = append(os.Environ(), fmt.Sprintf("SSHPASS=%s", creds.Password))
 	} else {
 		// Fallback to scp without sshpass (for key-based auth)
 		cmd = exec.CommandContext(ctx, "scp",
@@ -750,3 +1310,428 @@ func sanitizeSnapshotName(name string) string {
 
 	return sanitized
 }
+
+// AdoptVM takes over management of a pre-existing domain and returns its normalized spec
+func (s *Server) AdoptVM(ctx context.Context, req *providerv1.AdoptVMRequest) (*providerv1.AdoptVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.Id).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	result, err := libvirtProvider.AdoptVM(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adopt VM: %w", err)
+	}
+
+	spec, err := createRequestProto(result.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerv1.AdoptVMResponse{
+		Id:             result.ID,
+		Uuid:           result.UUID,
+		Spec:           spec,
+		AlreadyAdopted: result.AlreadyAdopted,
+		Warnings:       result.Warnings,
+	}, nil
+}
+
+// RenameVM renames a shut off virtual machine
+func (s *Server) RenameVM(ctx context.Context, req *providerv1.RenameVMRequest) (*providerv1.RenameVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.Id).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	if err := libvirtProvider.RenameVM(ctx, req.Id, req.NewName); err != nil {
+		return nil, fmt.Errorf("failed to rename VM: %w", err)
+	}
+
+	return &providerv1.RenameVMResponse{Id: req.NewName}, nil
+}
+
+// InsertMedia loads an ISO image into a CD-ROM drive of a virtual machine
+func (s *Server) InsertMedia(ctx context.Context, req *providerv1.InsertMediaRequest) (*providerv1.InsertMediaResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	if err := libvirtProvider.InsertMedia(ctx, req.VmId, req.Device, req.Source); err != nil {
+		return nil, fmt.Errorf("failed to insert media: %w", err)
+	}
+
+	return &providerv1.InsertMediaResponse{}, nil
+}
+
+// EjectMedia empties a CD-ROM drive of a virtual machine
+func (s *Server) EjectMedia(ctx context.Context, req *providerv1.EjectMediaRequest) (*providerv1.EjectMediaResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	ejected, err := libvirtProvider.EjectMedia(ctx, req.VmId, req.Device)
+	if err != nil {
+		return nil, fmt.Errorf("failed to eject media: %w", err)
+	}
+
+	return &providerv1.EjectMediaResponse{Ejected: ejected}, nil
+}
+
+// SuspendVM pauses a running virtual machine
+func (s *Server) SuspendVM(ctx context.Context, req *providerv1.SuspendVMRequest) (*providerv1.SuspendVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	state, err := libvirtProvider.SuspendVM(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suspend VM: %w", err)
+	}
+
+	return &providerv1.SuspendVMResponse{State: state}, nil
+}
+
+// ResumeVM continues a paused virtual machine
+func (s *Server) ResumeVM(ctx context.Context, req *providerv1.ResumeVMRequest) (*providerv1.ResumeVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	state, err := libvirtProvider.ResumeVM(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume VM: %w", err)
+	}
+
+	return &providerv1.ResumeVMResponse{State: state}, nil
+}
+
+// SaveVM saves the memory state of a virtual machine and stops it until the next power-on
+func (s *Server) SaveVM(ctx context.Context, req *providerv1.SaveVMRequest) (*providerv1.SaveVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	size, err := libvirtProvider.SaveVM(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save VM: %w", err)
+	}
+
+	return &providerv1.SaveVMResponse{SizeBytes: size}, nil
+}
+
+// DiscardManagedSave removes the saved memory state of a virtual machine
+func (s *Server) DiscardManagedSave(ctx context.Context, req *providerv1.DiscardManagedSaveRequest) (*providerv1.DiscardManagedSaveResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	discarded, err := libvirtProvider.DiscardManagedSave(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard managed save: %w", err)
+	}
+
+	return &providerv1.DiscardManagedSaveResponse{Discarded: discarded}, nil
+}
+
+// ValidateSpec checks a virtual machine spec against the host capabilities
+func (s *Server) ValidateSpec(ctx context.Context, req *providerv1.ValidateSpecRequest) (*providerv1.ValidateSpecResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+	if req.Spec == nil {
+		return nil, contracts.NewInvalidSpecError("spec is required", nil)
+	}
+
+	spec, err := s.parseCreateRequest(req.Spec)
+	if err != nil {
+		return nil, contracts.NewInvalidSpecError("failed to parse spec", err)
+	}
+
+	result, err := libvirtProvider.ValidateSpec(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate spec: %w", err)
+	}
+
+	findings := make([]*providerv1.SpecFinding, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		findings = append(findings, &providerv1.SpecFinding{
+			Severity: finding.Severity,
+			Check:    finding.Check,
+			Code:     errorCodeForType(finding.Type),
+			Message:  finding.Message,
+		})
+	}
+
+	return &providerv1.ValidateSpecResponse{Valid: result.Valid(), Findings: findings}, nil
+}
+
+// RebootVM reboots a running virtual machine in place
+func (s *Server) RebootVM(ctx context.Context, req *providerv1.RebootVMRequest) (*providerv1.RebootVMResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.Id).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	method, err := libvirtProvider.RebootVM(ctx, req.Id, req.Force)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reboot VM: %w", err)
+	}
+
+	return &providerv1.RebootVMResponse{Method: method}, nil
+}
+
+// ExecInGuest runs a command inside a virtual machine through the guest agent
+func (s *Server) ExecInGuest(ctx context.Context, req *providerv1.ExecInGuestRequest) (*providerv1.ExecInGuestResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	result, err := libvirtProvider.ExecInGuest(ctx, req.VmId, req.Path, req.Args, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute command in guest: %w", err)
+	}
+
+	return &providerv1.ExecInGuestResponse{
+		ExitCode:        result.ExitCode,
+		Stdout:          result.Stdout,
+		Stderr:          result.Stderr,
+		StdoutTruncated: result.StdoutTruncated,
+		StderrTruncated: result.StderrTruncated,
+	}, nil
+}
+
+// GetGuestHostname returns the hostname the guest reports through its guest agent
+func (s *Server) GetGuestHostname(ctx context.Context, req *providerv1.GetGuestHostnameRequest) (*providerv1.GetGuestHostnameResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	hostname, err := libvirtProvider.GetGuestHostname(ctx, req.VmId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest hostname: %w", err)
+	}
+
+	return &providerv1.GetGuestHostnameResponse{Hostname: hostname}, nil
+}
+
+// SetGuestHostname sets the hostname inside the guest through its guest agent
+func (s *Server) SetGuestHostname(ctx context.Context, req *providerv1.SetGuestHostnameRequest) (*providerv1.SetGuestHostnameResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	previous, err := libvirtProvider.SetGuestHostname(ctx, req.VmId, req.Hostname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set guest hostname: %w", err)
+	}
+
+	return &providerv1.SetGuestHostnameResponse{PreviousHostname: previous}, nil
+}
+
+// GetRawXML returns the domain XML libvirt has for a VM, with secrets redacted
+func (s *Server) GetRawXML(ctx context.Context, req *providerv1.GetRawXMLRequest) (*providerv1.GetRawXMLResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	domainXML, err := libvirtProvider.GetRawXML(ctx, req.VmId, req.Inactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain XML: %w", err)
+	}
+
+	return &providerv1.GetRawXMLResponse{Xml: domainXML}, nil
+}
+
+// GetConsoleLog returns the end of a VM's serial console log
+func (s *Server) GetConsoleLog(ctx context.Context, req *providerv1.GetConsoleLogRequest) (*providerv1.GetConsoleLogResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	result, err := libvirtProvider.GetConsoleLog(ctx, req.VmId, req.MaxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get console log: %w", err)
+	}
+
+	return &providerv1.GetConsoleLogResponse{
+		Data:      result.Data,
+		Path:      result.Path,
+		SizeBytes: result.SizeBytes,
+		Truncated: result.Truncated,
+	}, nil
+}
+
+// ExportSpec returns the VMClass and VirtualMachine manifests describing a VM
+func (s *Server) ExportSpec(ctx context.Context, req *providerv1.ExportSpecRequest) (*providerv1.ExportSpecResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(ctx, req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+
+	result, err := libvirtProvider.ExportSpec(ctx, req.VmId, ExportOptions{
+		Namespace:    req.Namespace,
+		ProviderName: req.ProviderName,
+		ClassName:    req.ClassName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export VM spec: %w", err)
+	}
+
+	return &providerv1.ExportSpecResponse{
+		Manifest:      result.Manifest,
+		Unrepresented: result.Unrepresented,
+	}, nil
+}
+
+// ConsolidateSnapshots merges external snapshot overlays back into their base disk images,
+// streaming progress until done. Cancelling the stream aborts the running block job.
+func (s *Server) ConsolidateSnapshots(req *providerv1.ConsolidateSnapshotsRequest, stream providerv1.Provider_ConsolidateSnapshotsServer) error {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.vmProvider(stream.Context(), req.VmId).(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return fmt.Errorf("libvirt provider not initialized")
+	}
+
+	var sendErr error
+	result, err := libvirtProvider.ConsolidateSnapshots(stream.Context(), req.VmId, req.Disk, func(progress ConsolidateProgress) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&providerv1.ConsolidateSnapshotsProgress{
+			Disk:            progress.Disk,
+			Phase:           progress.Phase,
+			ProgressPercent: progress.ProgressPercent,
+			OperationId:     progress.OperationID,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to consolidate snapshots: %w", err)
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return stream.Send(&providerv1.ConsolidateSnapshotsProgress{
+		Disk:             req.Disk,
+		Phase:            consolidatePhaseDone,
+		ProgressPercent:  100,
+		RemovedOverlays:  result.RemovedOverlays,
+		DeletedSnapshots: result.DeletedSnapshots,
+		OperationId:      result.OperationID,
+	})
+}
+
+// WatchEvents streams domain lifecycle events, replaying the current state first
+func (s *Server) WatchEvents(req *providerv1.WatchEventsRequest, stream providerv1.Provider_WatchEventsServer) error {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return fmt.Errorf("libvirt provider not initialized")
+	}
+
+	err := libvirtProvider.WatchEvents(stream.Context(), req.VmIds, func(event DomainEvent) error {
+		return stream.Send(&providerv1.DomainEvent{
+			VmId:              event.VMID,
+			Type:              event.Type,
+			Detail:            event.Detail,
+			State:             event.State,
+			Replay:            event.Replay,
+			TimestampUnixNano: event.Time.UnixNano(),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch events: %w", err)
+	}
+	return nil
+}
+
+// CollectGarbage removes the artifacts left behind by VMs that no longer exist
+func (s *Server) CollectGarbage(ctx context.Context, req *providerv1.CollectGarbageRequest) (*providerv1.CollectGarbageResponse, error) {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil || libvirtProvider.virshProvider == nil {
+		return nil, fmt.Errorf("libvirt provider not initialized")
+	}
+	if req.MinAgeSeconds < 0 {
+		return nil, contracts.NewInvalidSpecError("min_age_seconds must not be negative", nil)
+	}
+
+	report, err := libvirtProvider.CollectGarbage(ctx, GarbageOptions{
+		DryRun: req.DryRun,
+		MinAge: time.Duration(req.MinAgeSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect garbage: %w", err)
+	}
+
+	artifacts := make([]*providerv1.GarbageArtifact, 0, len(report.Artifacts))
+	for _, artifact := range report.Artifacts {
+		artifacts = append(artifacts, &providerv1.GarbageArtifact{
+			Kind:      artifact.Kind,
+			VmId:      artifact.VMID,
+			Pool:      artifact.Pool,
+			Path:      artifact.Path,
+			SizeBytes: artifact.SizeBytes,
+			Removed:   artifact.Removed,
+			Error:     artifact.Error,
+		})
+	}
+	return &providerv1.CollectGarbageResponse{Artifacts: artifacts, ReclaimedBytes: report.ReclaimedBytes}, nil
+}
+
+// WatchOperation streams the progress of a clone, migration, snapshot consolidation or image
+// conversion until it finishes or is aborted. Cancelling the stream stops watching without affecting the operation.
+func (s *Server) WatchOperation(req *providerv1.WatchOperationRequest, stream providerv1.Provider_WatchOperationServer) error {
+	// Get the provider instance and cast to libvirt Provider
+	libvirtProvider, ok := s.provider.(*Provider)
+	if !ok || libvirtProvider == nil {
+		return fmt.Errorf("libvirt provider not initialized")
+	}
+
+	err := libvirtProvider.WatchOperation(stream.Context(), req.OperationId, func(progress OperationProgress) error {
+		return stream.Send(&providerv1.OperationProgress{
+			OperationId:     progress.OperationID,
+			State:           progress.State,
+			ProgressPercent: progress.ProgressPercent,
+			ProcessedBytes:  progress.ProcessedBytes,
+			TotalBytes:      progress.TotalBytes,
+			BytesPerSecond:  progress.BytesPerSecond,
+			Message:         progress.Message,
+			Error:           progress.Error,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch operation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Power states a domain can be left in after reverting to a snapshot
+const (
+	snapshotPowerRunning = "running"
+	snapshotPowerPaused  = "paused"
+	snapshotPowerStopped = "stopped"
+)
+
+// SnapshotRevertOptions tunes a snapshot revert
+type SnapshotRevertOptions struct {
+	// PowerState forces the power state after the revert (running, paused or stopped).
+	// Empty keeps the power state the domain had before the revert.
+	PowerState string
+}
+
+// RevertSnapshot reverts a domain to a snapshot and leaves it in the requested power state
+func (p *Provider) RevertSnapshot(ctx context.Context, vmID, snapshotID string, opts SnapshotRevertOptions) error {
+	log.Printf("INFO Reverting VM %s to snapshot: %s", vmID, snapshotID)
+
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if err := p.ensureSnapshotExists(ctx, vmID, snapshotID); err != nil {
+		return err
+	}
+	if err := p.checkNoLinkedClones(ctx, vmID, "reverted"); err != nil {
+		return err
+	}
+
+	domainState, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain state", err)
+	}
+	log.Printf("INFO Domain %s current state: %s", vmID, domainState)
+
+	target := opts.PowerState
+	if target == "" {
+		switch domainState {
+		case "running":
+			target = snapshotPowerRunning
+		case "paused":
+			target = snapshotPowerPaused
+		default:
+			target = snapshotPowerStopped
+		}
+	}
+
+	// Force revert even if domain is running
+	args := []string{"snapshot-revert", vmID, snapshotID, "--force"}
+	switch target {
+	case snapshotPowerRunning:
+		args = append(args, "--running")
+	case snapshotPowerPaused:
+		args = append(args, "--paused")
+	case snapshotPowerStopped:
+		// Without a flag the domain takes the state recorded in the snapshot, stopped below if needed
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported power state %q after revert (running, paused or stopped)", target), nil)
+	}
+
+	result, err := p.virshProvider.runVirshCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to revert to snapshot: %w", err)
+	}
+	log.Printf("INFO Successfully reverted to snapshot: %s\nOutput: %s", snapshotID, result.Stdout)
+
+	if target == snapshotPowerStopped {
+		state, err := p.virshProvider.getDomainState(ctx, vmID)
+		if err != nil {
+			return contracts.NewRetryableError("failed to get domain state after revert", err)
+		}
+		if state == "running" || state == "paused" {
+			log.Printf("INFO Stopping domain %s restored in state %s from snapshot %s", vmID, state, snapshotID)
+			if err := p.virshProvider.destroyDomain(ctx, vmID); err != nil {
+				return fmt.Errorf("failed to stop domain after revert: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteSnapshot deletes a snapshot; children also deletes all of its descendants
+func (p *Provider) DeleteSnapshot(ctx context.Context, vmID, snapshotID string, children bool) error {
+	log.Printf("INFO Deleting snapshot %s from VM: %s (children: %t)", snapshotID, vmID, children)
+
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if err := p.ensureSnapshotExists(ctx, vmID, snapshotID); err != nil {
+		return err
+	}
+
+	// For internal snapshots this deletes both metadata and disk changes
+	args := []string{"snapshot-delete", vmID, snapshotID}
+	if children {
+		args = append(args, "--children")
+	}
+
+	result, err := p.virshProvider.runVirshCommand(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	log.Printf("INFO Snapshot deleted successfully: %s\nOutput: %s", snapshotID, result.Stdout)
+	return nil
+}
+
+// ensureSnapshotExists returns NotFound if the domain has no snapshot with the given name
+func (p *Provider) ensureSnapshotExists(ctx context.Context, vmID, snapshotID string) error {
+	exists, err := p.virshProvider.snapshotExists(ctx, vmID, snapshotID)
+	if err != nil {
+		return fmt.Errorf("failed to check snapshot existence: %w", err)
+	}
+	if !exists {
+		return contracts.NewNotFoundError(fmt.Sprintf("snapshot %s of VM %s not found", snapshotID, vmID), nil)
+	}
+	return nil
+}
+
+// SnapshotInfo describes a domain snapshot
+type SnapshotInfo struct {
+	Name           string
+	Description    string
+	CreationTime   int64
+	Parent         string
+	IncludesMemory bool
+	State          string
+	Current        bool
+	// DeltaBytes is the size of the snapshot's external disk overlays (0 for internal snapshots)
+	DeltaBytes int64
+}
+
+// domainSnapshotXML is the subset of snapshot-dumpxml output used to describe a snapshot
+type domainSnapshotXML struct {
+	Name         string `xml:"name"`
+	Description  string `xml:"description"`
+	State        string `xml:"state"`
+	CreationTime int64  `xml:"creationTime"`
+	Parent       struct {
+		Name string `xml:"name"`
+	} `xml:"parent"`
+	Memory struct {
+		Snapshot string `xml:"snapshot,attr"`
+	} `xml:"memory"`
+	Disks []struct {
+		Name     string `xml:"name,attr"`
+		Snapshot string `xml:"snapshot,attr"`
+		Source   struct {
+			File string `xml:"file,attr"`
+		} `xml:"source"`
+	} `xml:"disks>disk"`
+}
+
+// ListSnapshots returns the snapshots of a domain ordered by creation time
+func (p *Provider) ListSnapshots(ctx context.Context, vmID string) ([]SnapshotInfo, error) {
+	if p.virshProvider == nil {
+		return nil, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	names, err := p.virshProvider.runVirshCommand(ctx, "snapshot-list", vmID, "--name")
+	if err != nil {
+		return nil, contracts.NewNotFoundError(fmt.Sprintf("failed to list snapshots of VM %s", vmID), err)
+	}
+
+	// snapshot-current fails when the domain has no current snapshot
+	current := ""
+	if result, err := p.virshProvider.runVirshCommand(ctx, "snapshot-current", vmID, "--name"); err == nil {
+		current = strings.TrimSpace(result.Stdout)
+	}
+
+	var snapshots []SnapshotInfo
+	for _, name := range strings.Split(strings.TrimSpace(names.Stdout), "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		result, err := p.virshProvider.runVirshCommand(ctx, "snapshot-dumpxml", vmID, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get snapshot %s: %w", name, err)
+		}
+		var snap domainSnapshotXML
+		if err := xml.Unmarshal([]byte(result.Stdout), &snap); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot %s: %w", name, err)
+		}
+
+		info := SnapshotInfo{
+			Name:         name,
+			Description:  snap.Description,
+			CreationTime: snap.CreationTime,
+			Parent:       snap.Parent.Name,
+			// Disk-only snapshots record no memory or state "disk-snapshot"
+			IncludesMemory: snap.State != "disk-snapshot" && snap.Memory.Snapshot != "no",
+			State:          snap.State,
+			Current:        name == current,
+		}
+		for _, disk := range snap.Disks {
+			if disk.Snapshot == "external" && disk.Source.File != "" {
+				info.DeltaBytes += p.fileSize(ctx, disk.Source.File)
+			}
+		}
+		snapshots = append(snapshots, info)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].CreationTime != snapshots[j].CreationTime {
+			return snapshots[i].CreationTime < snapshots[j].CreationTime
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+// fileSize returns the allocated size of a host file in bytes, or 0 if it cannot be determined
+func (p *Provider) fileSize(ctx context.Context, path string) int64 {
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "du", "-B1", "--", path)
+	if err != nil {
+		return 0
+	}
+	fields := strings.Fields(result.Stdout)
+	if len(fields) == 0 {
+		return 0
+	}
+	size, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
+// SnapshotOverlay describes the overlay an external snapshot put on top of a disk
+type SnapshotOverlay struct {
+	// Disk is the target device (vda)
+	Disk string
+	// OverlayPath is the new file receiving guest writes
+	OverlayPath string
+	// BasePath is the file frozen by the snapshot, safe to copy until it is committed
+	BasePath string
+}
+
+// ExternalSnapshotResult describes an external disk-only snapshot
+type ExternalSnapshotResult struct {
+	Name     string
+	Overlays []SnapshotOverlay
+	// Quiesced is set when the guest filesystems were frozen through the guest agent
+	Quiesced bool
+}
+
+// CreateExternalSnapshot takes a disk-only snapshot that redirects guest writes to new overlay
+// files, leaving the previous images unchanged for a backup agent to copy. Guest filesystems
+// are quiesced when the guest agent responds. Blockcommit merges the overlays back afterwards.
+func (p *Provider) CreateExternalSnapshot(ctx context.Context, vmID, name, description string) (ExternalSnapshotResult, error) {
+	result := ExternalSnapshotResult{Name: name}
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return result, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	args := []string{"snapshot-create-as", vmID, name, "--description", p.virshProvider.quoteRemoteArg(description), "--disk-only", "--atomic"}
+	for _, disk := range domain.Devices.Disks {
+		base := disk.Source.File
+		if (disk.Device != "" && disk.Device != "disk") || disk.ReadOnly != nil || disk.Shareable != nil || base == "" {
+			// CD-ROMs, shared and non-file disks are left out of the snapshot
+			args = append(args, "--diskspec", disk.Target.Dev+",snapshot=no")
+			continue
+		}
+		overlay := strings.TrimSuffix(base, filepath.Ext(base)) + "." + name + ".qcow2"
+		args = append(args, "--diskspec", fmt.Sprintf("%s,snapshot=external,file=%s", disk.Target.Dev, overlay))
+		result.Overlays = append(result.Overlays, SnapshotOverlay{Disk: disk.Target.Dev, OverlayPath: overlay, BasePath: base})
+	}
+	if len(result.Overlays) == 0 {
+		return result, contracts.NewNotSupportedError(fmt.Sprintf("domain %s has no file-backed disks to snapshot", vmID))
+	}
+
+	quiesce := state == "running" && NewGuestAgentProvider(p.virshProvider).isGuestAgentAvailable(ctx, vmID)
+	if quiesce {
+		if _, err := p.virshProvider.runVirshCommand(ctx, append(args, "--quiesce")...); err == nil {
+			result.Quiesced = true
+		} else {
+			// The agent may lack freeze support (e.g. no fsfreeze hooks); fall back to a crash-consistent snapshot
+			log.Printf("WARN Quiesced snapshot %s of %s failed, retrying without quiesce: %v", name, vmID, err)
+			quiesce = false
+		}
+	}
+	if !quiesce {
+		if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+			return result, fmt.Errorf("failed to create external snapshot: %w", err)
+		}
+	}
+
+	log.Printf("INFO Created external snapshot %s of %s with %d overlays (quiesced: %t)", name, vmID, len(result.Overlays), result.Quiesced)
+	return result, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// specHash returns a stable SHA-256 of the virtrigaud-relevant parts of a domain definition,
+// so that controllers can detect changes made out of band (e.g. with "virsh edit").
+// It should be computed from the persistent definition. The hash covers, in this order:
+//
+//   - name, maximum memory (MiB) and vCPU count
+//   - firmware (bios, uefi, uefi-secure) and boot order
+//   - clock offset and timers (name, tickpolicy, present)
+//   - disks, sorted by target: target, bus, device, source path or pool/volume,
+//     driver type, cache, io, discard, readonly and shareable
+//   - interfaces, sorted by MAC: MAC, type, network or bridge, model and bandwidth
+//   - host devices, sorted: type, PCI address or USB vendor:product
+//   - TPMs (model, backend version), graphics types, video models, watchdogs and RNG models
+//
+// Everything else is ignored, in particular the UUID, current (balloon) memory, domain
+// metadata, PCI addresses and aliases libvirt assigns, and the live-only backing chain
+// and tap device names, so the hash only changes when one of the fields above does.
+func specHash(domain *domainXML) string {
+	var b strings.Builder
+	field := func(key string, values ...any) {
+		b.WriteString(key)
+		for _, value := range values {
+			fmt.Fprintf(&b, "|%v", value)
+		}
+		b.WriteByte('\n')
+	}
+
+	field("name", domain.Name)
+	field("memory", domain.Memory.MiB())
+	field("vcpu", domain.VCPU)
+	field("firmware", domain.firmware())
+	field("boot", strings.Join(domain.bootOrder(), ","))
+
+	field("clock", domain.Clock.Offset)
+	for _, timer := range domain.Clock.Timers {
+		field("timer", timer.Name, timer.TickPolicy, timer.Present)
+	}
+
+	disks := make([]string, 0, len(domain.Devices.Disks))
+	for _, disk := range domain.Devices.Disks {
+		source := disk.sourcePath()
+		if source == "" && disk.Source.Volume != "" {
+			source = disk.Source.Pool + "/" + disk.Source.Volume
+		}
+		disks = append(disks, fmt.Sprintf("disk|%s|%s|%s|%s|%s|%s|%s|%s|%t|%t",
+			disk.Target.Dev, disk.Target.Bus, disk.Device, source, disk.Driver.Type,
+			disk.Driver.Cache, disk.Driver.IO, disk.Driver.Discard, disk.ReadOnly != nil, disk.Shareable != nil))
+	}
+	writeSorted(&b, disks)
+
+	interfaces := make([]string, 0, len(domain.Devices.Interfaces))
+	for _, iface := range domain.Devices.Interfaces {
+		line := fmt.Sprintf("interface|%s|%s|%s|%s|%s", strings.ToLower(iface.MAC.Address), iface.Type,
+			iface.Source.Network, iface.Source.Bridge, iface.Model.Type)
+		if bw := iface.Bandwidth; bw != nil {
+			for _, limit := range []*domainBandwidthLimit{bw.Inbound, bw.Outbound} {
+				if limit == nil {
+					line += "|-"
+					continue
+				}
+				line += fmt.Sprintf("|%d/%d/%d", limit.Average, limit.Peak, limit.Burst)
+			}
+		}
+		interfaces = append(interfaces, line)
+	}
+	writeSorted(&b, interfaces)
+
+	hostdevs := make([]string, 0, len(domain.Devices.HostDevs))
+	for _, dev := range domain.Devices.HostDevs {
+		address := dev.Source.Address
+		hostdevs = append(hostdevs, fmt.Sprintf("hostdev|%s|%s:%s:%s.%s|%s:%s", dev.Type,
+			address.Domain, address.Bus, address.Slot, address.Function, dev.Source.Vendor.ID, dev.Source.Product.ID))
+	}
+	writeSorted(&b, hostdevs)
+
+	for _, tpm := range domain.Devices.TPMs {
+		field("tpm", tpm.Model, tpm.Backend.Version)
+	}
+	for _, graphics := range domain.Devices.Graphics {
+		field("graphics", graphics.Type)
+	}
+	for _, video := range domain.Devices.Videos {
+		field("video", video.Model.Type)
+	}
+	for _, watchdog := range domain.Devices.Watchdogs {
+		field("watchdog", watchdog.Model, watchdog.Action)
+	}
+	for _, rng := range domain.Devices.RNGs {
+		field("rng", rng.Model, rng.Backend.Model)
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeSorted writes lines in sorted order so that device order does not affect the hash
+func writeSorted(b *strings.Builder, lines []string) {
+	sort.Strings(lines)
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const specHashDomain = `<domain type="kvm">
+  <name>web-1</name>
+  <uuid>6f1c2a52-8d3e-4d0b-9c1e-2f0d3b7a9e11</uuid>
+  <memory unit="KiB">2097152</memory>
+  <currentMemory unit="KiB">1048576</currentMemory>
+  <vcpu>2</vcpu>
+  <os><type>hvm</type><boot dev="hd"/></os>
+  <clock offset="utc"><timer name="rtc" tickpolicy="catchup"/></clock>
+  <devices>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2" cache="none"/>
+      <source file="/var/lib/libvirt/images/web-1.qcow2"/>
+      <target dev="vda" bus="virtio"/>
+    </disk>
+    <disk type="volume" device="disk">
+      <driver name="qemu" type="raw"/>
+      <source pool="data" volume="web-1-data"/>
+      <target dev="vdb" bus="virtio"/>
+    </disk>
+    <interface type="network">
+      <mac address="52:54:00:aa:bb:01"/>
+      <source network="default"/>
+      <model type="virtio"/>
+    </interface>
+    <interface type="bridge">
+      <mac address="52:54:00:aa:bb:02"/>
+      <source bridge="br0"/>
+      <model type="virtio"/>
+    </interface>
+    <graphics type="vnc" port="5900"/>
+    <video><model type="virtio"/></video>
+  </devices>
+</domain>`
+
+// hashOf parses a domain definition and returns its spec hash
+func hashOf(t *testing.T, definition string) string {
+	t.Helper()
+	var domain domainXML
+	require.NoError(t, xml.Unmarshal([]byte(definition), &domain))
+	return specHash(&domain)
+}
+
+// editDomain returns the base definition with old replaced by new, failing if old is absent
+func editDomain(t *testing.T, old, new string) string {
+	t.Helper()
+	require.Contains(t, specHashDomain, old)
+	return strings.Replace(specHashDomain, old, new, 1)
+}
+
+// swapBlocks swaps the first two occurrences of the block opened by open and closed by end
+func swapBlocks(t *testing.T, definition, open, end string) string {
+	t.Helper()
+	first := strings.Index(definition, open)
+	firstEnd := strings.Index(definition[first:], end) + first + len(end)
+	second := strings.Index(definition[firstEnd:], open) + firstEnd
+	secondEnd := strings.Index(definition[second:], end) + second + len(end)
+	require.True(t, first >= 0 && second > firstEnd, "definition has fewer than two %s blocks", open)
+	return definition[:first] + definition[second:secondEnd] + definition[firstEnd:second] +
+		definition[first:firstEnd] + definition[secondEnd:]
+}
+
+func TestSpecHashIgnoresOrderAndIrrelevantFields(t *testing.T) {
+	base := hashOf(t, specHashDomain)
+
+	equivalent := map[string]string{
+		"disk order":      swapBlocks(t, specHashDomain, "<disk ", "</disk>"),
+		"interface order": swapBlocks(t, specHashDomain, "<interface ", "</interface>"),
+		"attribute order": editDomain(t, `<target dev="vda" bus="virtio"/>`, `<target bus="virtio" dev="vda"/>`),
+		"memory unit":     editDomain(t, `<memory unit="KiB">2097152</memory>`, `<memory unit="GiB">2</memory>`),
+		"MAC case":        editDomain(t, `52:54:00:aa:bb:01`, `52:54:00:AA:BB:01`),
+		"uuid":            editDomain(t, `6f1c2a52-8d3e-4d0b-9c1e-2f0d3b7a9e11`, `0a0b0c0d-0000-4000-8000-000000000000`),
+		"balloon":         editDomain(t, `<currentMemory unit="KiB">1048576</currentMemory>`, `<currentMemory unit="KiB">2097152</currentMemory>`),
+		"metadata": editDomain(t, `<vcpu>2</vcpu>`,
+			`<vcpu>2</vcpu><metadata><vr:labels xmlns:vr="https://virtrigaud.io/xmlns/labels/1.0"><entry key="a">b</entry></vr:labels></metadata>`),
+		"assigned address": editDomain(t, `<target dev="vda" bus="virtio"/>`,
+			`<target dev="vda" bus="virtio"/><address type="pci" domain="0x0000" bus="0x04" slot="0x00" function="0x0"/><alias name="virtio-disk0"/>`),
+		"tap device": editDomain(t, `<source bridge="br0"/>`, `<source bridge="br0"/><target dev="vnet3"/>`),
+		"vnc port":   editDomain(t, `<graphics type="vnc" port="5900"/>`, `<graphics type="vnc" port="5901" autoport="yes"/>`),
+		"whitespace": strings.ReplaceAll(specHashDomain, "\n    ", "\n\t\t"),
+	}
+	for name, definition := range equivalent {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, base, hashOf(t, definition))
+		})
+	}
+}
+
+func TestSpecHashChangesWithRelevantFields(t *testing.T) {
+	base := hashOf(t, specHashDomain)
+
+	changed := map[string]string{
+		"name":         editDomain(t, `<name>web-1</name>`, `<name>web-2</name>`),
+		"memory":       editDomain(t, `<memory unit="KiB">2097152</memory>`, `<memory unit="KiB">4194304</memory>`),
+		"vcpu":         editDomain(t, `<vcpu>2</vcpu>`, `<vcpu>4</vcpu>`),
+		"firmware":     editDomain(t, `<type>hvm</type>`, `<type>hvm</type><loader readonly="yes" type="pflash">/usr/share/OVMF/OVMF_CODE.fd</loader>`),
+		"boot order":   editDomain(t, `<boot dev="hd"/>`, `<boot dev="cdrom"/><boot dev="hd"/>`),
+		"clock offset": editDomain(t, `offset="utc"`, `offset="localtime"`),
+		"timer":        editDomain(t, `tickpolicy="catchup"`, `tickpolicy="delay"`),
+		"disk source":  editDomain(t, `web-1.qcow2`, `web-1-clone.qcow2`),
+		"disk volume":  editDomain(t, `volume="web-1-data"`, `volume="web-1-logs"`),
+		"disk bus":     editDomain(t, `<target dev="vda" bus="virtio"/>`, `<target dev="vda" bus="scsi"/>`),
+		"disk cache":   editDomain(t, `cache="none"`, `cache="writeback"`),
+		"disk format":  editDomain(t, `type="qcow2"`, `type="raw"`),
+		"readonly":     editDomain(t, `<target dev="vdb" bus="virtio"/>`, `<target dev="vdb" bus="virtio"/><readonly/>`),
+		"disk target":  editDomain(t, `<target dev="vdb" bus="virtio"/>`, `<target dev="vdc" bus="virtio"/>`),
+		"MAC":          editDomain(t, `52:54:00:aa:bb:02`, `52:54:00:aa:bb:03`),
+		"network":      editDomain(t, `network="default"`, `network="isolated"`),
+		"bridge":       editDomain(t, `bridge="br0"`, `bridge="br1"`),
+		"NIC model": editDomain(t, `<source network="default"/>
+      <model type="virtio"/>`, `<source network="default"/>
+      <model type="e1000"/>`),
+		"bandwidth": editDomain(t, `<source bridge="br0"/>`, `<source bridge="br0"/><bandwidth><inbound average="1000"/></bandwidth>`),
+		"hostdev": editDomain(t, `<graphics `,
+			`<hostdev mode="subsystem" type="pci" managed="yes"><source><address domain="0x0000" bus="0x3b" slot="0x00" function="0x1"/></source></hostdev><graphics `),
+		"tpm":      editDomain(t, `<graphics `, `<tpm model="tpm-crb"><backend type="emulator" version="2.0"/></tpm><graphics `),
+		"graphics": editDomain(t, `<graphics type="vnc" port="5900"/>`, `<graphics type="spice" port="5900"/>`),
+		"video":    editDomain(t, `<video><model type="virtio"/></video>`, `<video><model type="qxl"/></video>`),
+		"watchdog": editDomain(t, `<graphics `, `<watchdog model="i6300esb" action="reset"/><graphics `),
+		"rng":      editDomain(t, `<graphics `, `<rng model="virtio"><backend model="random">/dev/urandom</backend></rng><graphics `),
+	}
+	seen := map[string]string{base: "base"}
+	for name, definition := range changed {
+		t.Run(name, func(t *testing.T) {
+			hash := hashOf(t, definition)
+			assert.NotEqual(t, base, hash)
+			if other, ok := seen[hash]; ok {
+				t.Errorf("%s hashes the same as %s", name, other)
+			}
+			seen[hash] = name
+		})
+	}
+}
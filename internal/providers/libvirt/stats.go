@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// VMStats holds cumulative utilization counters of a domain
+type VMStats struct {
+	// State is the domain state; counters are zero unless it is running
+	State string
+	// Timestamp is when the counters were sampled
+	Timestamp time.Time
+	// CPUTimeNs is the total CPU time consumed by the guest
+	CPUTimeNs int64
+	// VCPUs is the number of online vCPUs
+	VCPUs int32
+	// MemoryActualBytes is the current balloon size
+	MemoryActualBytes int64
+	// MemoryUsedBytes is the guest-reported used memory (0 without balloon stats)
+	MemoryUsedBytes int64
+	// MemoryRSSBytes is the resident size of the QEMU process
+	MemoryRSSBytes int64
+	// Disks lists per-disk I/O counters
+	Disks []DiskStats
+	// Interfaces lists per-interface traffic counters
+	Interfaces []InterfaceStats
+}
+
+// DiskStats holds the I/O counters of one disk
+type DiskStats struct {
+	Device     string
+	Path       string
+	ReadBytes  int64
+	WriteBytes int64
+	ReadOps    int64
+	WriteOps   int64
+}
+
+// InterfaceStats holds the traffic counters of one interface
+type InterfaceStats struct {
+	Name      string
+	RxBytes   int64
+	TxBytes   int64
+	RxPackets int64
+	TxPackets int64
+}
+
+// GetVMStats samples the CPU, memory, block and interface counters of a domain.
+// Domains that are not running yield zeroed counters with their state set.
+func (p *Provider) GetVMStats(ctx context.Context, vmID string) (VMStats, error) {
+	stats := VMStats{Timestamp: time.Now()}
+
+	if p.virshProvider == nil {
+		return stats, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return stats, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	stats.State = state
+	if state != "running" {
+		return stats, nil
+	}
+
+	result, err := p.virshProvider.runVirshCommand(ctx, "domstats",
+		"--cpu-total", "--balloon", "--vcpu", "--interface", "--block", vmID)
+	if err != nil {
+		return stats, contracts.NewRetryableError("failed to get domain stats", err)
+	}
+	stats.Timestamp = time.Now()
+
+	fields := parseDomstats(result.Stdout)
+	stats.CPUTimeNs = fields.int("cpu.time")
+	stats.VCPUs = int32(fields.int("vcpu.current"))
+	stats.MemoryActualBytes = fields.int("balloon.current") * 1024
+	stats.MemoryRSSBytes = fields.int("balloon.rss") * 1024
+	if available, unused := fields.int("balloon.available"), fields.int("balloon.unused"); available > 0 {
+		stats.MemoryUsedBytes = (available - unused) * 1024
+	}
+
+	for i := int64(0); i < fields.int("block.count"); i++ {
+		prefix := fmt.Sprintf("block.%d.", i)
+		stats.Disks = append(stats.Disks, DiskStats{
+			Device:     fields[prefix+"name"],
+			Path:       fields[prefix+"path"],
+			ReadBytes:  fields.int(prefix + "rd.bytes"),
+			WriteBytes: fields.int(prefix + "wr.bytes"),
+			ReadOps:    fields.int(prefix + "rd.reqs"),
+			WriteOps:   fields.int(prefix + "wr.reqs"),
+		})
+	}
+
+	for i := int64(0); i < fields.int("net.count"); i++ {
+		prefix := fmt.Sprintf("net.%d.", i)
+		stats.Interfaces = append(stats.Interfaces, InterfaceStats{
+			Name:      fields[prefix+"name"],
+			RxBytes:   fields.int(prefix + "rx.bytes"),
+			TxBytes:   fields.int(prefix + "tx.bytes"),
+			RxPackets: fields.int(prefix + "rx.pkts"),
+			TxPackets: fields.int(prefix + "tx.pkts"),
+		})
+	}
+
+	return stats, nil
+}
+
+// domstatsFields holds the key=value pairs of a single domain's domstats output
+type domstatsFields map[string]string
+
+// parseDomstats parses the output of virsh domstats for one domain
+func parseDomstats(output string) domstatsFields {
+	fields := make(domstatsFields)
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		fields[key] = value
+	}
+	return fields
+}
+
+// int returns a numeric field, or 0 when it is missing or malformed
+func (f domstatsFields) int(key string) int64 {
+	n, err := strconv.ParseInt(f[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
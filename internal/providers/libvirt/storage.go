@@ -186,8 +186,9 @@ func (s *StorageProvider) ensurePoolActive(ctx context.Context, poolName string)
 	return nil
 }
 
-// CreateVolume creates a new storage volume
-func (s *StorageProvider) CreateVolume(ctx context.Context, poolName, volumeName, format string, sizeGB int) (*StorageVolume, error) {
+// CreateVolume creates a new storage volume; allocationPolicy (thin, thick, falloc) controls
+// how much of its capacity is allocated up front, empty leaves it to the pool
+func (s *StorageProvider) CreateVolume(ctx context.Context, poolName, volumeName, format string, sizeGB int, allocationPolicy string) (*StorageVolume, error) {
 	log.Printf("INFO Creating storage volume: %s in pool %s (%dGB, %s)", volumeName, poolName, sizeGB, format)
 
 	// Ensure pool is active
@@ -197,9 +198,17 @@ func (s *StorageProvider) CreateVolume(ctx context.Context, poolName, volumeName
 
 	// Create volume using vol-create-as
 	sizeBytes := fmt.Sprintf("%dG", sizeGB)
-	result, err := s.virshProvider.runVirshCommand(ctx, "vol-create-as", poolName, volumeName, sizeBytes, "--format", format)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create volume: %w, output: %s", err, result.Stderr)
+	if allocationPolicy == allocationFalloc {
+		if err := s.createFallocVolume(ctx, poolName, volumeName, format, sizeBytes); err != nil {
+			return nil, err
+		}
+	} else {
+		args := append([]string{"vol-create-as", poolName, volumeName, sizeBytes, "--format", format},
+			volumeAllocationArgs(allocationPolicy, sizeBytes)...)
+		result, err := s.virshProvider.runVirshCommand(ctx, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create volume: %w, output: %s", err, result.Stderr)
+		}
 	}
 
 	// Get volume information
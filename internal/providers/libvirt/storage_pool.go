@@ -0,0 +1,280 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// poolTypeDir stores volumes as files in a host directory
+	poolTypeDir = "dir"
+
+	// poolTypeLogical stores volumes as logical volumes of an LVM volume group
+	poolTypeLogical = "logical"
+
+	// autoPoolBaseDir holds the directories of pools auto-created by name
+	autoPoolBaseDir = "/var/lib/libvirt/pools"
+
+	// autoPoolPrefix names pools auto-created for a path
+	autoPoolPrefix = "virtrigaud-"
+)
+
+// fileBasedPoolTypes store volumes as files, which qcow2 images require
+var fileBasedPoolTypes = []string{poolTypeDir, "fs", "netfs"}
+
+// poolNamePattern matches the storage pool names accepted for auto-creation
+var poolNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// poolDefinition is the subset of a storage pool definition (pool-dumpxml) the provider uses
+type poolDefinition struct {
+	XMLName xml.Name `xml:"pool"`
+	Type    string   `xml:"type,attr"`
+	Name    string   `xml:"name"`
+	Source  struct {
+		Name string `xml:"name"`
+	} `xml:"source"`
+	Target struct {
+		Path string `xml:"path"`
+	} `xml:"target"`
+}
+
+// matches reports whether a pool is the one named by a pool name or target path
+func (d poolDefinition) matches(spec string) bool {
+	if !filepath.IsAbs(spec) {
+		return d.Name == spec
+	}
+	return d.Target.Path != "" && filepath.Clean(d.Target.Path) == filepath.Clean(spec)
+}
+
+// xml renders the definition passed to pool-define
+func (d poolDefinition) xml() string {
+	source := ""
+	if d.Source.Name != "" {
+		source = fmt.Sprintf("\n  <source>\n    <name>%s</name>\n  </source>", xmlEscape(d.Source.Name))
+	}
+	return fmt.Sprintf("<pool type='%s'>\n  <name>%s</name>%s\n  <target>\n    <path>%s</path>\n  </target>\n</pool>",
+		d.Type, xmlEscape(d.Name), source, xmlEscape(d.Target.Path))
+}
+
+// validatePoolFormat checks that a pool can hold volumes of the given disk format.
+// qcow2 images are files, so they need a file-based pool; block pools such as LVM hold raw volumes only.
+func validatePoolFormat(pool poolDefinition, format string) error {
+	if format == "qcow2" && !slices.Contains(fileBasedPoolTypes, pool.Type) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"storage pool %s of type %s cannot hold %s disks (supported pool types: %s)",
+			pool.Name, pool.Type, format, strings.Join(fileBasedPoolTypes, ", ")), nil)
+	}
+	return nil
+}
+
+// selectStoragePool picks the pool a disk is stored in, in order: the requested pool name or path,
+// the pool configured for the provider and, when neither is set, the active pool with the most
+// free space that can hold the disk format.
+// A requested pool that does not exist is returned with create set when --auto-create-pools allows it.
+func (p *Provider) selectStoragePool(ctx context.Context, requested, format string) (pool poolDefinition, create bool, err error) {
+	spec := strings.TrimSpace(requested)
+	if spec == "" && p.virshProvider.config != nil {
+		spec = p.virshProvider.config.Spec.StoragePool
+	}
+
+	pools, err := p.virshProvider.listStoragePools(ctx)
+	if err != nil {
+		return pool, false, contracts.NewRetryableError("failed to list storage pools", err)
+	}
+
+	if spec == "" {
+		pool, err = p.selectDefaultStoragePool(ctx, pools, format)
+		return pool, false, err
+	}
+
+	for _, candidate := range pools {
+		if candidate.matches(spec) {
+			return candidate, false, validatePoolFormat(candidate, format)
+		}
+	}
+
+	if !p.options.AutoCreatePools {
+		return pool, false, contracts.NewNotFoundError(
+			fmt.Sprintf("storage pool %s not found (start the provider with --auto-create-pools to create it)", spec), nil)
+	}
+	pool, err = autoPoolDefinition(spec)
+	if err != nil {
+		return pool, false, err
+	}
+	return pool, true, validatePoolFormat(pool, format)
+}
+
+// selectDefaultStoragePool returns the active pool with the most free space that can hold the format.
+// Without one the "default" directory pool is used, which Create defines if necessary.
+func (p *Provider) selectDefaultStoragePool(ctx context.Context, pools []poolDefinition, format string) (poolDefinition, error) {
+	var best poolDefinition
+	var bestAvailable int64 = -1
+	for _, candidate := range pools {
+		if validatePoolFormat(candidate, format) != nil {
+			continue
+		}
+		capacity, err := p.getPoolCapacity(ctx, candidate.Name)
+		if err != nil {
+			// Inactive pools have no capacity to report
+			continue
+		}
+		if capacity.AvailableBytes > bestAvailable {
+			best, bestAvailable = candidate, capacity.AvailableBytes
+		}
+	}
+
+	if bestAvailable < 0 {
+		log.Printf("INFO No usable storage pool found, using the %s pool", defaultStoragePool)
+		best = poolDefinition{Type: poolTypeDir, Name: defaultStoragePool}
+		best.Target.Path = "/var/lib/libvirt/images"
+		return best, nil
+	}
+	log.Printf("INFO Selected storage pool %s with %d bytes available", best.Name, bestAvailable)
+	return best, nil
+}
+
+// autoPoolDefinition describes the pool to create for a pool name or path.
+// Paths below /dev name an existing LVM volume group; other paths and bare names get a directory pool.
+func autoPoolDefinition(spec string) (poolDefinition, error) {
+	pool := poolDefinition{Type: poolTypeDir}
+
+	switch {
+	case strings.HasPrefix(filepath.Clean(spec), "/dev/"):
+		volumeGroup := filepath.Base(spec)
+		pool.Type = poolTypeLogical
+		pool.Name = autoPoolPrefix + volumeGroup
+		pool.Source.Name = volumeGroup
+		pool.Target.Path = filepath.Join("/dev", volumeGroup)
+	case filepath.IsAbs(spec):
+		pool.Name = autoPoolPrefix + strings.ReplaceAll(strings.Trim(filepath.Clean(spec), "/"), "/", "-")
+		pool.Target.Path = filepath.Clean(spec)
+	default:
+		pool.Name = spec
+		pool.Target.Path = filepath.Join(autoPoolBaseDir, spec)
+	}
+
+	if !poolNamePattern.MatchString(pool.Name) {
+		return pool, contracts.NewInvalidSpecError(fmt.Sprintf("invalid storage pool name or path %q", spec), nil)
+	}
+	return pool, nil
+}
+
+// ensureStoragePool makes a selected pool usable: auto-created pools are defined first,
+// the "default" pool is created by EnsureDefaultStoragePool and every pool is started
+func (p *Provider) ensureStoragePool(ctx context.Context, pool poolDefinition, create bool) error {
+	storageProvider := NewStorageProvider(p.virshProvider)
+
+	switch {
+	case create:
+		if err := p.createStoragePool(ctx, pool); err != nil {
+			return fmt.Errorf("failed to create storage pool %s: %w", pool.Name, err)
+		}
+	case pool.Name == defaultStoragePool:
+		return storageProvider.EnsureDefaultStoragePool(ctx)
+	}
+	return storageProvider.ensurePoolActive(ctx, pool.Name)
+}
+
+// createStoragePool defines, builds and autostarts a pool.
+// Logical pools are not built: the volume group must already exist.
+func (p *Provider) createStoragePool(ctx context.Context, pool poolDefinition) error {
+	log.Printf("INFO Creating %s storage pool %s at %s", pool.Type, pool.Name, pool.Target.Path)
+
+	poolFile := fmt.Sprintf("/tmp/%s-pool-%s.xml", pool.Name, generateTaskID())
+	if err := NewCloudInitProvider(p.virshProvider).writeRemoteFile(ctx, poolFile, pool.xml()); err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = p.virshProvider.runVirshCommand(ctx, "!", "rm", "-f", poolFile)
+	}()
+
+	if _, err := p.virshProvider.runVirshCommand(ctx, "pool-define", poolFile); err != nil {
+		return fmt.Errorf("failed to define pool: %w", err)
+	}
+	if pool.Type == poolTypeDir {
+		if _, err := p.virshProvider.runVirshCommand(ctx, "pool-build", pool.Name); err != nil {
+			_, _ = p.virshProvider.runVirshCommand(ctx, "pool-undefine", pool.Name)
+			return fmt.Errorf("failed to build pool: %w", err)
+		}
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "pool-start", pool.Name); err != nil {
+		_, _ = p.virshProvider.runVirshCommand(ctx, "pool-undefine", pool.Name)
+		return fmt.Errorf("failed to start pool: %w", err)
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "pool-autostart", pool.Name); err != nil {
+		log.Printf("WARN Failed to set autostart of storage pool %s: %v", pool.Name, err)
+	}
+	return nil
+}
+
+// listStoragePools returns the definitions of all storage pools, active or not
+func (v *VirshProvider) listStoragePools(ctx context.Context) ([]poolDefinition, error) {
+	result, err := v.runVirshCommand(ctx, "pool-list", "--all", "--name")
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []poolDefinition
+	for _, name := range strings.Split(result.Stdout, "\n") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		dump, err := v.runVirshCommand(ctx, "pool-dumpxml", name)
+		if err != nil {
+			log.Printf("WARN Failed to read definition of storage pool %s: %v", name, err)
+			continue
+		}
+		var pool poolDefinition
+		if err := xml.Unmarshal([]byte(dump.Stdout), &pool); err != nil {
+			log.Printf("WARN Failed to parse definition of storage pool %s: %v", name, err)
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// rootDiskPool returns the pool holding the root disk of a domain, falling back to the configured pool
+func (v *VirshProvider) rootDiskPool(ctx context.Context, domainName string) string {
+	domain, err := v.getDomainXML(ctx, domainName)
+	if err != nil {
+		return v.storagePool()
+	}
+	for _, disk := range domain.Devices.Disks {
+		if disk.Device != "disk" || disk.sourcePath() == "" {
+			continue
+		}
+		if result, err := v.runVirshCommand(ctx, "vol-pool", disk.sourcePath()); err == nil {
+			if name := strings.TrimSpace(result.Stdout); name != "" {
+				return name
+			}
+		}
+		break
+	}
+	return v.storagePool()
+}
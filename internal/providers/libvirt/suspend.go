@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// SuspendVM pauses the vCPUs of a running domain, keeping its memory resident, and
+// returns the resulting domain state. Suspending a paused domain is a no-op.
+func (p *Provider) SuspendVM(ctx context.Context, vmID string) (string, error) {
+	state, err := p.existingDomainState(ctx, vmID)
+	if err != nil {
+		return "", err
+	}
+
+	if state == "paused" {
+		return state, nil
+	}
+	if state != "running" {
+		return "", contracts.NewInvalidStateError(fmt.Sprintf("domain %s is %s; only a running domain can be suspended", vmID, state), nil)
+	}
+
+	log.Printf("INFO Suspending domain %s", vmID)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "suspend", vmID); err != nil {
+		return "", fmt.Errorf("failed to suspend domain %s: %w", vmID, err)
+	}
+	return p.existingDomainState(ctx, vmID)
+}
+
+// ResumeVM continues a paused domain and returns the resulting domain state.
+// Resuming a running domain is a no-op.
+func (p *Provider) ResumeVM(ctx context.Context, vmID string) (string, error) {
+	state, err := p.existingDomainState(ctx, vmID)
+	if err != nil {
+		return "", err
+	}
+
+	if state == "running" {
+		return state, nil
+	}
+	if state != "paused" {
+		return "", contracts.NewInvalidStateError(fmt.Sprintf("domain %s is %s; only a paused domain can be resumed", vmID, state), nil)
+	}
+
+	log.Printf("INFO Resuming domain %s", vmID)
+	if _, err := p.virshProvider.runVirshCommand(ctx, "resume", vmID); err != nil {
+		return "", fmt.Errorf("failed to resume domain %s: %w", vmID, err)
+	}
+	return p.existingDomainState(ctx, vmID)
+}
+
+// existingDomainState returns the state of an existing domain as reported by virsh domstate
+func (p *Provider) existingDomainState(ctx context.Context, vmID string) (string, error) {
+	if p.virshProvider == nil {
+		return "", contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if _, err := p.virshProvider.getDomainXML(ctx, vmID); err != nil {
+		return "", contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+
+	state, err := p.virshProvider.getDomainState(ctx, vmID)
+	if err != nil {
+		return "", contracts.NewRetryableError("failed to get domain state", err)
+	}
+	return state, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// taskRetention is how long completed tasks remain queryable
+const taskRetention = time.Hour
+
+// taskState holds the progress of a long-running background operation
+type taskState struct {
+	Done      bool
+	Error     string
+	Message   string
+	Progress  int32
+	StartedAt time.Time
+	UpdatedAt time.Time
+	// Aborted is set when the operation was cancelled or timed out rather than failing
+	Aborted bool
+	// ProcessedBytes and TotalBytes measure data-moving operations; TotalBytes is 0 while unknown
+	ProcessedBytes int64
+	TotalBytes     int64
+}
+
+// taskHoldKey is the context key of the hold an RPC can hand over to the task it starts
+type taskHoldKey struct{}
+
+// WithTaskHold returns a context that lets the RPC handed it pass hold on to the background
+// task it starts. The server uses it for the per-VM lock of an RPC, so that the VM stays
+// locked until the task finishes rather than until the RPC returns. hold is called at most
+// once, by the task, and returns the release the task calls when it is done.
+func WithTaskHold(ctx context.Context, hold func() (release func())) context.Context {
+	return context.WithValue(ctx, taskHoldKey{}, hold)
+}
+
+// holdForTask takes over the hold of the RPC in ctx for a background task and returns its
+// release; without a hold it returns a no-op
+func holdForTask(ctx context.Context) func() {
+	if hold, ok := ctx.Value(taskHoldKey{}).(func() func()); ok {
+		return hold()
+	}
+	return func() {}
+}
+
+// taskTracker records background operations (e.g. migrations) so that
+// TaskStatus can report on them. Unknown task IDs are treated as complete,
+// matching the synchronous behaviour of most virsh operations.
+type taskTracker struct {
+	mu    sync.RWMutex
+	tasks map[string]*taskState
+}
+
+// newTaskTracker creates an empty task tracker
+func newTaskTracker() *taskTracker {
+	return &taskTracker{
+		tasks: make(map[string]*taskState),
+	}
+}
+
+// start registers a new running task
+func (t *taskTracker) start(id, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	for taskID, task := range t.tasks {
+		if task.Done && now.Sub(task.UpdatedAt) > taskRetention {
+			delete(t.tasks, taskID)
+		}
+	}
+	t.tasks[id] = &taskState{
+		Message:   message,
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// updateBytes records the data processed by a running task and derives its progress from it
+func (t *taskTracker) updateBytes(id string, processed, total int64, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if task, ok := t.tasks[id]; ok && !task.Done {
+		task.Progress = bytesProgress(processed, total)
+		task.ProcessedBytes = processed
+		task.TotalBytes = total
+		task.Message = message
+		task.UpdatedAt = time.Now()
+	}
+}
+
+// bytesProgress converts processed bytes to percent; 100 is reserved for the completed task
+func bytesProgress(processed, total int64) int32 {
+	if total <= 0 {
+		return 0
+	}
+	progress := processed * 100 / total
+	if progress > 99 {
+		progress = 99
+	}
+	return int32(progress)
+}
+
+// finish marks a task as done, recording the error if it failed
+func (t *taskTracker) finish(id string, err error, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	task, ok := t.tasks[id]
+	if !ok {
+		return
+	}
+	task.Done = true
+	task.Message = message
+	task.UpdatedAt = time.Now()
+	if err != nil {
+		task.Error = err.Error()
+		task.Aborted = errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+	} else {
+		task.Progress = 100
+		if task.TotalBytes > 0 {
+			task.ProcessedBytes = task.TotalBytes
+		}
+	}
+}
+
+// get returns a copy of the task state, if the task is tracked
+func (t *taskTracker) get(id string) (taskState, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	task, ok := t.tasks[id]
+	if !ok {
+		return taskState{}, false
+	}
+	return *task, true
+}
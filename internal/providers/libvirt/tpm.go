@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// swtpmStateDir is where libvirt keeps the state of emulated TPMs, one directory per domain UUID
+const swtpmStateDir = "/var/lib/libvirt/swtpm"
+
+// checkTPMAvailable verifies that swtpm, which backs emulated TPMs, is installed on the host
+func (p *Provider) checkTPMAvailable(ctx context.Context, class contracts.VMClass) error {
+	if !vmspec.TPMEnabled(class) {
+		return nil
+	}
+	if _, err := p.virshProvider.runVirshCommand(ctx, "!", "which", "swtpm"); err != nil {
+		return contracts.NewNotSupportedError("TPM requested but swtpm is not installed on the libvirt host")
+	}
+	return nil
+}
+
+// tpmXML renders an emulated TPM 2.0 device backed by swtpm
+func tpmXML(class contracts.VMClass) string {
+	if !vmspec.TPMEnabled(class) {
+		return ""
+	}
+	persistent := ""
+	if class.SecurityProfile.TPMPersistentState {
+		persistent = " persistent_state='yes'"
+	}
+	return fmt.Sprintf(`
+    <tpm model='tpm-crb'>
+      <backend type='emulator' version='2.0'%s/>
+    </tpm>`, persistent)
+}
+
+// undefineDomainWithState undefines a domain together with its UEFI NVRAM store, its managed
+// save image and the state of its emulated TPM. Older libvirt releases lack undefine --tpm, so the TPM state
+// directory is then removed directly.
+func (p *Provider) undefineDomainWithState(ctx context.Context, id string) error {
+	domain, err := p.virshProvider.getDomainXML(ctx, id)
+	if err != nil {
+		return p.virshProvider.undefineDomain(ctx, id)
+	}
+
+	flags := []string{"--managed-save"}
+	if domain.OS.NVRAM != "" {
+		flags = append(flags, "--nvram")
+	}
+	if !hasEmulatedTPM(domain) {
+		return p.virshProvider.undefineDomain(ctx, id, flags...)
+	}
+
+	result, err := p.virshProvider.runVirshCommand(ctx, append([]string{"undefine", id, "--tpm"}, flags...)...)
+	if err == nil {
+		log.Printf("INFO Undefined domain %s and removed its TPM state", id)
+		return nil
+	}
+	if result == nil || !strings.Contains(result.Stderr, "--tpm") {
+		return fmt.Errorf("failed to undefine domain %s: %w", id, err)
+	}
+
+	if err := p.virshProvider.undefineDomain(ctx, id, flags...); err != nil {
+		return err
+	}
+	if domain.UUID != "" {
+		stateDir := swtpmStateDir + "/" + domain.UUID
+		if _, err := p.virshProvider.runVirshCommand(ctx, "!", "rm", "-rf", stateDir); err != nil {
+			log.Printf("WARN Failed to remove TPM state %s of domain %s: %v", stateDir, id, err)
+		}
+	}
+	return nil
+}
+
+// hasEmulatedTPM reports whether a domain has a swtpm-backed TPM
+func hasEmulatedTPM(domain *domainXML) bool {
+	for _, tpm := range domain.Devices.TPMs {
+		if tpm.Backend.Type == "emulator" {
+			return true
+		}
+	}
+	return false
+}
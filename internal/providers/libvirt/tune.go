@@ -0,0 +1,209 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// cpuTuneXML renders the <cputune> element of a VM class
+func cpuTuneXML(tune *contracts.CPUTune) string {
+	if tune == nil || (tune.Shares == 0 && tune.PeriodMicros == 0 && tune.QuotaMicros == 0 &&
+		len(tune.VCPUPins) == 0 && tune.EmulatorPin == "") {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("  <cputune>\n")
+	for _, pin := range tune.VCPUPins {
+		fmt.Fprintf(&b, "    <vcpupin vcpu='%d' cpuset='%s'/>\n", pin.VCPU, xmlEscape(strings.ReplaceAll(pin.CPUSet, " ", "")))
+	}
+	if tune.EmulatorPin != "" {
+		fmt.Fprintf(&b, "    <emulatorpin cpuset='%s'/>\n", xmlEscape(strings.ReplaceAll(tune.EmulatorPin, " ", "")))
+	}
+	if tune.Shares != 0 {
+		fmt.Fprintf(&b, "    <shares>%d</shares>\n", tune.Shares)
+	}
+	if tune.PeriodMicros != 0 {
+		fmt.Fprintf(&b, "    <period>%d</period>\n", tune.PeriodMicros)
+	}
+	if tune.QuotaMicros != 0 {
+		fmt.Fprintf(&b, "    <quota>%d</quota>\n", tune.QuotaMicros)
+	}
+	b.WriteString("  </cputune>\n")
+	return b.String()
+}
+
+// blkioTuneXML renders the <blkiotune> element of a VM class
+func blkioTuneXML(tune *contracts.BlkioTune) string {
+	if tune == nil || (tune.Weight == 0 && len(tune.Devices) == 0) {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("  <blkiotune>\n")
+	if tune.Weight != 0 {
+		fmt.Fprintf(&b, "    <weight>%d</weight>\n", tune.Weight)
+	}
+	for _, device := range tune.Devices {
+		fmt.Fprintf(&b, "    <device>\n      <path>%s</path>\n", xmlEscape(device.Path))
+		if device.ReadIOPS > 0 {
+			fmt.Fprintf(&b, "      <read_iops_sec>%d</read_iops_sec>\n", device.ReadIOPS)
+		}
+		if device.WriteIOPS > 0 {
+			fmt.Fprintf(&b, "      <write_iops_sec>%d</write_iops_sec>\n", device.WriteIOPS)
+		}
+		b.WriteString("    </device>\n")
+	}
+	b.WriteString("  </blkiotune>\n")
+	return b.String()
+}
+
+// numaTuneXML renders the <numatune> element of a VM class
+func numaTuneXML(tune *contracts.NUMATune) string {
+	if tune == nil {
+		return ""
+	}
+	return fmt.Sprintf("  <numatune>\n    <memory mode='%s' nodeset='%s'/>\n  </numatune>\n",
+		vmspec.NUMAMode(tune), xmlEscape(strings.ReplaceAll(tune.Nodeset, " ", "")))
+}
+
+// checkTuningAvailable verifies that the host CPUs vCPUs and emulator threads are pinned
+// to, the NUMA nodes memory is bound to and the block devices I/O is throttled on exist
+// on the host
+func (p *Provider) checkTuningAvailable(ctx context.Context, class contracts.VMClass) error {
+	type hostPin struct{ owner, cpus string }
+	var pins []hostPin
+	if tune := class.CPUTune; tune != nil {
+		for _, pin := range tune.VCPUPins {
+			pins = append(pins, hostPin{fmt.Sprintf("vCPU %d", pin.VCPU), pin.CPUSet})
+		}
+		if tune.EmulatorPin != "" {
+			pins = append(pins, hostPin{"the emulator", tune.EmulatorPin})
+		}
+	}
+
+	if len(pins) > 0 || class.NUMATune != nil {
+		hostCaps, err := p.virshProvider.getHostCapabilities(ctx)
+		if err != nil {
+			return contracts.NewRetryableError("failed to read host capabilities", err)
+		}
+		for _, pin := range pins {
+			cpus, _ := vmspec.ParseCPUSet(pin.cpus)
+			for _, cpu := range cpus {
+				if !hostCaps.hasCPU(cpu) {
+					return contracts.NewInvalidSpecError(fmt.Sprintf(
+						"%s is pinned to host CPU %d, which the host does not have", pin.owner, cpu), nil)
+				}
+			}
+		}
+		if class.NUMATune != nil {
+			nodes, _ := vmspec.ParseCPUSet(class.NUMATune.Nodeset)
+			for _, node := range nodes {
+				if !hostCaps.hasNUMANode(node) {
+					return contracts.NewInvalidSpecError(fmt.Sprintf(
+						"memory is bound to host NUMA node %d, which the host does not have (%d nodes)", node, len(hostCaps.Host.Cells)), nil)
+				}
+			}
+		}
+	}
+
+	if class.BlkioTune != nil {
+		for _, device := range class.BlkioTune.Devices {
+			if _, err := p.virshProvider.runVirshCommand(ctx, "!", "test", "-b", device.Path); err != nil {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("block I/O device %s is not a block device on the host", device.Path), err)
+			}
+		}
+	}
+	return nil
+}
+
+// applyTuning updates the CPU scheduler parameters, pinning, NUMA memory policy and block
+// I/O tuning of a domain in the definitions of scope. The NUMA memory policy of a persistent
+// domain only changes at the next start; a transient domain has it changed live.
+func (p *Provider) applyTuning(ctx context.Context, vmID string, class contracts.VMClass, scope changeScope) error {
+	flags := scope.flags()
+
+	if tune := class.CPUTune; tune != nil {
+		for _, pin := range tune.VCPUPins {
+			args := append([]string{"vcpupin", vmID, strconv.Itoa(int(pin.VCPU)), strings.ReplaceAll(pin.CPUSet, " ", "")}, flags...)
+			if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+				return fmt.Errorf("failed to pin vCPU %d: %w", pin.VCPU, err)
+			}
+		}
+		if tune.EmulatorPin != "" {
+			args := append([]string{"emulatorpin", vmID, strings.ReplaceAll(tune.EmulatorPin, " ", "")}, flags...)
+			if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+				return fmt.Errorf("failed to pin emulator threads: %w", err)
+			}
+		}
+		for _, param := range []struct {
+			name  string
+			value int64
+		}{
+			{"cpu_shares", tune.Shares},
+			{"vcpu_period", tune.PeriodMicros},
+			{"vcpu_quota", tune.QuotaMicros},
+		} {
+			if param.value == 0 {
+				continue
+			}
+			args := append([]string{"schedinfo", vmID, "--set", param.name + "=" + strconv.FormatInt(param.value, 10)}, flags...)
+			if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+				return fmt.Errorf("failed to set %s: %w", param.name, err)
+			}
+		}
+	}
+
+	if tune := class.NUMATune; tune != nil {
+		numaFlags := changeScope{transient: scope.transient}.flags()
+		args := append([]string{"numatune", vmID, "--mode", vmspec.NUMAMode(tune),
+			"--nodeset", strings.ReplaceAll(tune.Nodeset, " ", "")}, numaFlags...)
+		if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+			return fmt.Errorf("failed to set NUMA memory policy: %w", err)
+		}
+	}
+
+	if tune := class.BlkioTune; tune != nil {
+		args := []string{"blkiotune", vmID}
+		if tune.Weight != 0 {
+			args = append(args, "--weight", strconv.Itoa(int(tune.Weight)))
+		}
+		// Each device list is path,value pairs; 0 removes a limit
+		var reads, writes []string
+		for _, device := range tune.Devices {
+			reads = append(reads, device.Path, strconv.FormatInt(device.ReadIOPS, 10))
+			writes = append(writes, device.Path, strconv.FormatInt(device.WriteIOPS, 10))
+		}
+		if len(tune.Devices) > 0 {
+			args = append(args, "--device-read-iops-sec", strings.Join(reads, ","),
+				"--device-write-iops-sec", strings.Join(writes, ","))
+		}
+		if len(args) > 2 {
+			if _, err := p.virshProvider.runVirshCommand(ctx, append(args, flags...)...); err != nil {
+				return fmt.Errorf("failed to set block I/O tuning: %w", err)
+			}
+		}
+	}
+	return nil
+}
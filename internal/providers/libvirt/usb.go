@@ -0,0 +1,255 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+const (
+	// hostDeviceTypeUSB is the USB host device type
+	hostDeviceTypeUSB = "usb"
+
+	// usbDevicesDir lists the USB devices and interfaces of the host
+	usbDevicesDir = "/sys/bus/usb/devices"
+)
+
+var (
+	// usbIDPattern matches a USB vendor or product ID (046d or 0x046d)
+	usbIDPattern = regexp.MustCompile(`^(?:0x)?([0-9a-f]{4})$`)
+
+	// usbAddressPattern matches a USB bus and device number (1:4 or 001:004)
+	usbAddressPattern = regexp.MustCompile(`^([0-9]{1,3}):([0-9]{1,3})$`)
+)
+
+// usbDevice identifies a USB host device by vendor and product ID (lowercase hexadecimal
+// without 0x prefix) or, when no IDs are set, by bus and device number
+type usbDevice struct {
+	Vendor, Product string
+	Bus, Device     int
+}
+
+// parseUSBDevice parses a requested USB host device
+func parseUSBDevice(device contracts.HostDevice) (usbDevice, error) {
+	if device.VendorID == "" && device.ProductID == "" {
+		m := usbAddressPattern.FindStringSubmatch(strings.TrimSpace(device.Address))
+		if m == nil {
+			return usbDevice{}, fmt.Errorf("USB device needs a vendorID and productID or an address (bus:device, e.g. 1:4), got %q", device.Address)
+		}
+		bus, _ := strconv.Atoi(m[1])
+		dev, _ := strconv.Atoi(m[2])
+		if bus == 0 || dev == 0 {
+			return usbDevice{}, fmt.Errorf("invalid USB address %q: bus and device numbers start at 1", device.Address)
+		}
+		return usbDevice{Bus: bus, Device: dev}, nil
+	}
+
+	if device.Address != "" {
+		return usbDevice{}, fmt.Errorf("USB device %s: set either an address or a vendorID and productID, not both", device.Address)
+	}
+	vendor := usbIDPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(device.VendorID)))
+	product := usbIDPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(device.ProductID)))
+	if vendor == nil || product == nil {
+		return usbDevice{}, fmt.Errorf("invalid USB vendor/product ID %q:%q (expected four hexadecimal digits, e.g. 046d:c52b)", device.VendorID, device.ProductID)
+	}
+	return usbDevice{Vendor: vendor[1], Product: product[1]}, nil
+}
+
+// String returns vendor:product, or the bus and device number of an address-based device
+func (d usbDevice) String() string {
+	if d.Vendor != "" {
+		return d.Vendor + ":" + d.Product
+	}
+	return fmt.Sprintf("bus %d device %d", d.Bus, d.Device)
+}
+
+// matches reports whether a host device, listed with all fields set, is the requested device
+func (d usbDevice) matches(host usbDevice) bool {
+	if d.Vendor != "" {
+		return d.Vendor == host.Vendor && d.Product == host.Product
+	}
+	return d.Bus == host.Bus && d.Device == host.Device
+}
+
+// hostdevXML renders the <hostdev> element of the device
+func (d usbDevice) hostdevXML() string {
+	source := fmt.Sprintf("<address bus='%d' device='%d'/>", d.Bus, d.Device)
+	if d.Vendor != "" {
+		source = fmt.Sprintf("<vendor id='0x%s'/>\n    <product id='0x%s'/>", d.Vendor, d.Product)
+	}
+	return fmt.Sprintf("<hostdev mode='subsystem' type='usb' managed='yes'>\n  <source>\n    %s\n  </source>\n</hostdev>", source)
+}
+
+// usbHostDevices returns the parsed USB host devices of a request
+func usbHostDevices(devices []contracts.HostDevice) []usbDevice {
+	var result []usbDevice
+	for _, device := range devices {
+		if !strings.EqualFold(device.Type, hostDeviceTypeUSB) {
+			continue
+		}
+		if usb, err := parseUSBDevice(device); err == nil {
+			result = append(result, usb)
+		}
+	}
+	return result
+}
+
+// listHostUSBDevices lists the USB devices plugged into the libvirt host
+func (p *Provider) listHostUSBDevices(ctx context.Context) ([]usbDevice, error) {
+	// Interfaces share the directory with devices but have no idVendor
+	script := fmt.Sprintf(`for d in %s/*; do if [ -f "$d/idVendor" ]; then echo "$(cat "$d/busnum") $(cat "$d/devnum") $(cat "$d/idVendor") $(cat "$d/idProduct")"; fi; done`,
+		usbDevicesDir)
+	result, err := p.virshProvider.runVirshCommand(ctx, "!", "sh", "-c", p.virshProvider.quoteRemoteArg(script))
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []usbDevice
+	for _, line := range strings.Split(strings.TrimSpace(result.Stdout), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+		bus, errBus := strconv.Atoi(fields[0])
+		dev, errDev := strconv.Atoi(fields[1])
+		if errBus != nil || errDev != nil {
+			continue
+		}
+		devices = append(devices, usbDevice{Vendor: strings.ToLower(fields[2]), Product: strings.ToLower(fields[3]), Bus: bus, Device: dev})
+	}
+	return devices, nil
+}
+
+// checkUSBDevicesAvailable verifies that every requested USB device is plugged into the host
+// and that vendor/product IDs select exactly one device
+func (p *Provider) checkUSBDevicesAvailable(ctx context.Context, devices []usbDevice) error {
+	if len(devices) == 0 {
+		return nil
+	}
+
+	host, err := p.listHostUSBDevices(ctx)
+	if err != nil {
+		return contracts.NewRetryableError("failed to list host USB devices", err)
+	}
+
+	for _, device := range devices {
+		matches := 0
+		for _, candidate := range host {
+			if device.matches(candidate) {
+				matches++
+			}
+		}
+		switch {
+		case matches == 0:
+			return contracts.NewNotFoundError(fmt.Sprintf("USB device %s not found on the libvirt host", device), nil)
+		case matches > 1:
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"USB device %s matches %d host devices; select it by bus:device address instead", device, matches), nil)
+		}
+	}
+	return nil
+}
+
+// usbDevice returns the USB device a host device entry selects
+func (h domainHostdev) usbDevice() (usbDevice, bool) {
+	if h.Type != hostDeviceTypeUSB {
+		return usbDevice{}, false
+	}
+	// Running domains report the resolved address next to the IDs; the IDs are what was requested
+	if h.Source.Vendor.ID != "" {
+		vendor, _ := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(h.Source.Vendor.ID), "0x"), 16, 16)
+		product, _ := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(h.Source.Product.ID), "0x"), 16, 16)
+		return usbDevice{Vendor: fmt.Sprintf("%04x", vendor), Product: fmt.Sprintf("%04x", product)}, true
+	}
+	bus, _ := strconv.ParseUint(h.Source.Address.Bus, 0, 16)
+	dev, _ := strconv.ParseUint(h.Source.Address.Device, 0, 16)
+	return usbDevice{Bus: int(bus), Device: int(dev)}, true
+}
+
+// ReconcileUSBDevices makes the USB host devices of a domain match the desired host devices:
+// missing devices are hot-plugged and devices that are no longer desired are unplugged, so
+// repeated calls are no-ops. PCI devices cannot change on an existing domain and must match
+// its definition.
+func (p *Provider) ReconcileUSBDevices(ctx context.Context, vmID string, desired []contracts.HostDevice) error {
+	if p.virshProvider == nil {
+		return contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	if err := validateHostDevices(desired); err != nil {
+		return err
+	}
+
+	domain, err := p.virshProvider.getDomainXML(ctx, vmID)
+	if err != nil {
+		return contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+	}
+	if have, want := domainPCIDevices(domain), pciHostDevices(desired); !samePCIDevices(have, want) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"PCI host devices of %s are %v and cannot be changed to %v without recreating the VM", vmID, have, want), nil)
+	}
+
+	scope, err := p.virshProvider.domainChangeScope(ctx, vmID)
+	if err != nil {
+		return contracts.NewRetryableError("failed to get domain state", err)
+	}
+
+	current := make(map[usbDevice]bool)
+	for _, hostdev := range domain.Devices.HostDevs {
+		if usb, ok := hostdev.usbDevice(); ok {
+			current[usb] = true
+		}
+	}
+	wanted := make(map[usbDevice]bool)
+	var missing []usbDevice
+	for _, usb := range usbHostDevices(desired) {
+		wanted[usb] = true
+		if !current[usb] {
+			missing = append(missing, usb)
+		}
+	}
+
+	// Validate every new device before touching the domain
+	if err := p.checkUSBDevicesAvailable(ctx, missing); err != nil {
+		return err
+	}
+
+	for usb := range current {
+		if wanted[usb] {
+			continue
+		}
+		log.Printf("INFO Detaching USB device %s from domain %s", usb, vmID)
+		if err := p.applyDeviceXML(ctx, "detach-device", vmID, usb.hostdevXML(), scope); err != nil {
+			return fmt.Errorf("failed to detach USB device %s: %w", usb, err)
+		}
+	}
+
+	for _, usb := range missing {
+		log.Printf("INFO Attaching USB device %s to domain %s", usb, vmID)
+		if err := p.applyDeviceXML(ctx, "attach-device", vmID, usb.hostdevXML(), scope); err != nil {
+			return fmt.Errorf("failed to attach USB device %s: %w", usb, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Severities of spec findings
+const (
+	// FindingError marks a finding that makes Create fail
+	FindingError = "error"
+	// FindingWarning marks a finding Create tolerates
+	FindingWarning = "warning"
+)
+
+// SpecFinding is a single result of validating a VM spec against the host
+type SpecFinding struct {
+	// Severity is error or warning
+	Severity string
+	// Check names the check that produced the finding (e.g. cpu, hugepages, firmware)
+	Check string
+	// Type categorizes errors the way Create would report them
+	Type contracts.ErrorType
+	// Message describes the finding
+	Message string
+}
+
+// SpecValidation lists the findings of ValidateSpec
+type SpecValidation struct {
+	Findings []SpecFinding
+}
+
+// Valid reports whether Create would accept the spec
+func (v SpecValidation) Valid() bool {
+	for _, finding := range v.Findings {
+		if finding.Severity == FindingError {
+			return false
+		}
+	}
+	return true
+}
+
+// addError records a failed check; a nil error records nothing
+func (v *SpecValidation) addError(check string, err error) {
+	if err == nil {
+		return
+	}
+	finding := SpecFinding{Severity: FindingError, Check: check, Message: err.Error()}
+	var providerErr *contracts.ProviderError
+	if errors.As(err, &providerErr) {
+		finding.Type = providerErr.Type
+		finding.Message = providerErr.Message
+	}
+	v.Findings = append(v.Findings, finding)
+}
+
+// addWarnings records non-fatal findings of a check
+func (v *SpecValidation) addWarnings(check string, messages ...string) {
+	for _, message := range messages {
+		v.Findings = append(v.Findings, SpecFinding{Severity: FindingWarning, Check: check, Message: message})
+	}
+}
+
+// ValidateSpec runs every check of Create against the host capabilities without stopping at
+// the first failure, so that a spec can be linted before it is submitted. The returned error
+// is only set when the host could not be inspected.
+func (p *Provider) ValidateSpec(ctx context.Context, req contracts.CreateRequest) (SpecValidation, error) {
+	var result SpecValidation
+
+	if p.virshProvider == nil {
+		return result, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+
+	domainCaps, err := p.virshProvider.getDomainCapabilities(ctx)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read host domain capabilities", err)
+	}
+	hostCaps, err := p.virshProvider.getHostCapabilities(ctx)
+	if err != nil {
+		return result, contracts.NewRetryableError("failed to read host capabilities", err)
+	}
+
+	req, warnings := vmspec.Default(req)
+	result.addWarnings("os-hint", warnings...)
+
+	if req.Name == "" {
+		result.addError("name", contracts.NewInvalidSpecError("VM name is required", nil))
+	}
+	for _, check := range vmspec.Checks {
+		result.addError(check.Name, check.Validate(req))
+	}
+	result.addError("cloud-init", p.validateCloudInitPayload(req))
+	result.addError("cpu", checkCPUModelUsable(domainCaps, req.Class))
+	result.checkResources(domainCaps, hostCaps, req.Class)
+	result.addError("hugepages", p.checkHugepagesAvailable(ctx, req.Class))
+	if vmspec.ValidateCPUTune(req.Class) == nil && vmspec.ValidateBlkioTune(req.Class.BlkioTune) == nil &&
+		vmspec.ValidateNUMATune(req.Class.NUMATune) == nil {
+		result.addError("tuning", p.checkTuningAvailable(ctx, req.Class))
+	}
+	result.addError("disks", validateDisks(req.Disks))
+	result.addError("boot", validateBootSpec(req))
+	result.addError("qemu-passthrough", p.checkQEMUPassthrough(req.QemuArgs))
+
+	if vmspec.ValidateTPM(req.Class) == nil {
+		result.addError("tpm", p.checkTPMAvailable(ctx, req.Class))
+	}
+	if err := validateHostDevices(req.HostDevices); err != nil {
+		result.addError("host-devices", err)
+	} else {
+		result.addError("host-devices", p.checkHostDevicesAvailable(ctx, req.Name, req.HostDevices))
+	}
+	if vmspec.ValidateGraphics(req.Graphics) == nil && req.Graphics != nil && req.Graphics.Display != "" &&
+		!domainCaps.supportsGraphics(req.Graphics.Display) {
+		result.addError("graphics", contracts.NewNotSupportedError(fmt.Sprintf(
+			"%s display requested but the QEMU on the libvirt host does not support it", req.Graphics.Display)))
+	}
+	if _, err := vmspec.FirmwareType(req.Class); err == nil {
+		_, err = p.resolveFirmware(ctx, req.Class)
+		result.addError("firmware", err)
+	}
+
+	if err := p.validateNetworkSources(req.Networks); err != nil {
+		result.addError("networks", err)
+	} else {
+		for _, network := range p.withNetworkDefaults(req.Networks) {
+			result.addError("networks", p.checkNetworkAvailable(ctx, network))
+		}
+	}
+	p.validateStorage(ctx, req, &result)
+
+	log.Printf("INFO Validated spec of VM %s: %d findings, valid=%t", req.Name, len(result.Findings), result.Valid())
+	return result, nil
+}
+
+// checkCPUModelUsable verifies that the host can run the custom CPU model of a VM class
+func checkCPUModelUsable(caps *domainCapabilities, class contracts.VMClass) error {
+	if vmspec.CPUMode(class.CPUModel) != cpuModeCustom || class.CPUModel.Name == "" {
+		return nil
+	}
+	known, usable := caps.cpuModelUsable(class.CPUModel.Name)
+	if !known {
+		return contracts.NewNotSupportedError(fmt.Sprintf("CPU model %s is not known to the QEMU on the libvirt host", class.CPUModel.Name))
+	}
+	if !usable {
+		return contracts.NewNotSupportedError(fmt.Sprintf("CPU model %s is not usable on the libvirt host's CPU", class.CPUModel.Name))
+	}
+	return nil
+}
+
+// checkResources compares the vCPUs and memory of a VM class with the host. Exceeding the
+// vCPU limit of QEMU is an error; more vCPUs or memory than the host has are warnings
+// because libvirt overcommits them.
+func (v *SpecValidation) checkResources(domainCaps *domainCapabilities, hostCaps *hostCapabilities, class contracts.VMClass) {
+	vcpus := vmspec.VCPUCount(class)
+	if limit := domainCaps.VCPU.Max; limit > 0 && vcpus > limit {
+		v.addError("resources", contracts.NewInvalidSpecError(fmt.Sprintf("%d vCPUs exceed the maximum of %d supported by the host", vcpus, limit), nil))
+	}
+
+	hostCPUs, hostMemoryMiB := hostCaps.totals()
+	if hostCPUs > 0 && vcpus > hostCPUs {
+		v.addWarnings("resources", fmt.Sprintf("%d vCPUs exceed the %d logical CPUs of the host", vcpus, hostCPUs))
+	}
+	if memory := vmspec.MemoryMiB(class); hostMemoryMiB > 0 && memory > hostMemoryMiB {
+		v.addWarnings("resources", fmt.Sprintf("%d MiB of memory exceed the %d MiB of the host", memory, hostMemoryMiB))
+	}
+}
+
+// validateStorage checks the storage pools, source images and pool capacity of the disks
+func (p *Provider) validateStorage(ctx context.Context, req contracts.CreateRequest, result *SpecValidation) {
+	disks, err := p.planDisks(ctx, req)
+	if err != nil {
+		result.addError("storage", err)
+		return
+	}
+
+	storageProvider := NewStorageProvider(p.virshProvider)
+	created := make(map[string]bool)
+	for _, disk := range disks {
+		if disk.CreatePool && !created[disk.Pool.Name] {
+			created[disk.Pool.Name] = true
+			result.addWarnings("storage", fmt.Sprintf("storage pool %s does not exist; Create would define a %s pool at %s",
+				disk.Pool.Name, disk.Pool.Type, disk.Pool.Target.Path))
+		}
+		if disk.Source != "" {
+			result.addError("image", p.checkImageAvailable(ctx, storageProvider, disk.Source))
+		}
+	}
+
+	warnings, err := p.checkDiskCapacity(ctx, disks)
+	result.addWarnings("storage", warnings...)
+	result.addWarnings("storage", shareableDiskWarnings(disks)...)
+	result.addError("storage", err)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// vcpuCounts holds the output of virsh vcpucount
+type vcpuCounts struct {
+	MaximumConfig int32
+	MaximumLive   int32
+	CurrentConfig int32
+	CurrentLive   int32
+}
+
+// SetVCPUs changes the vCPU count of a domain, hot-plugging or unplugging when it is running.
+// Hot-unplug requires the guest agent to report enough vCPUs that can be taken offline.
+// It returns the number of vCPUs online after the change.
+func (p *Provider) SetVCPUs(ctx context.Context, vmID string, target int32) (int32, error) {
+	if p.virshProvider == nil {
+		return 0, contracts.NewRetryableError("virsh provider not initialized", nil)
+	}
+	if target <= 0 {
+		return 0, contracts.NewInvalidSpecError("target vCPU count must be positive", nil)
+	}
+
+	scope, err := p.virshProvider.domainChangeScope(ctx, vmID)
+	if err != nil {
+		if domainGone(err) {
+			return 0, contracts.NewNotFoundError(fmt.Sprintf("domain %s not found", vmID), err)
+		}
+		return 0, contracts.NewRetryableError("failed to get domain state", err)
+	}
+	live := scope.live
+
+	counts, err := p.virshProvider.getVCPUCounts(ctx, vmID)
+	if err != nil {
+		return 0, contracts.NewRetryableError("failed to get vCPU counts", err)
+	}
+
+	// A transient domain only has live counts
+	maximum, current := counts.MaximumConfig, counts.CurrentConfig
+	if live || scope.transient {
+		maximum, current = counts.MaximumLive, counts.CurrentLive
+	}
+	if target > maximum {
+		return 0, contracts.NewInvalidSpecError(fmt.Sprintf(
+			"target of %d vCPUs exceeds the maximum of %d; raising the maximum requires a cold reconfigure", target, maximum), nil)
+	}
+	if target == current {
+		return p.onlineVCPUs(ctx, vmID, current), nil
+	}
+
+	if live && target < current {
+		if err := p.checkVCPUUnplug(ctx, vmID, current-target); err != nil {
+			return 0, err
+		}
+	}
+
+	args := append([]string{"setvcpus", vmID, strconv.Itoa(int(target))}, scope.flags()...)
+	log.Printf("INFO Setting vCPUs of domain %s from %d to %d (live: %t)", vmID, current, target, live)
+	if _, err := p.virshProvider.runVirshCommand(ctx, args...); err != nil {
+		return 0, fmt.Errorf("failed to set vCPUs: %w", err)
+	}
+
+	if !live && !scope.transient {
+		return target, nil
+	}
+	return p.onlineVCPUs(ctx, vmID, target), nil
+}
+
+// checkVCPUUnplug verifies through the guest agent that the guest can take count vCPUs offline
+func (p *Provider) checkVCPUUnplug(ctx context.Context, vmID string, count int32) error {
+	guestAgent := NewGuestAgentProvider(p.virshProvider)
+	if !guestAgent.isGuestAgentAvailable(ctx, vmID) {
+		return contracts.NewNotSupportedError(
+			"vCPU hot-unplug requires the QEMU guest agent to confirm guest support; stop the VM to reduce vCPUs")
+	}
+
+	info, err := p.virshProvider.getGuestVCPUs(ctx, vmID)
+	if err != nil {
+		return contracts.NewNotSupportedError(fmt.Sprintf("guest does not report vCPU hot-unplug support: %v", err))
+	}
+	if offlinable := countCPUList(info["can_offline"]); offlinable < int(count) {
+		return contracts.NewNotSupportedError(fmt.Sprintf(
+			"guest can take %d vCPUs offline but %d must be removed; stop the VM to reduce vCPUs", offlinable, count))
+	}
+	return nil
+}
+
+// onlineVCPUs returns the vCPUs online in the guest, falling back to the hypervisor's count
+func (p *Provider) onlineVCPUs(ctx context.Context, vmID string, fallback int32) int32 {
+	if info, err := p.virshProvider.getGuestVCPUs(ctx, vmID); err == nil {
+		if online := countCPUList(info["online"]); online > 0 {
+			return int32(online)
+		}
+	}
+	if counts, err := p.virshProvider.getVCPUCounts(ctx, vmID); err == nil && counts.CurrentLive > 0 {
+		return counts.CurrentLive
+	}
+	return fallback
+}
+
+// getVCPUCounts parses virsh vcpucount
+func (v *VirshProvider) getVCPUCounts(ctx context.Context, vmID string) (vcpuCounts, error) {
+	var counts vcpuCounts
+
+	result, err := v.runVirshCommand(ctx, "vcpucount", vmID)
+	if err != nil {
+		return counts, err
+	}
+
+	// Lines look like "maximum      config         4"
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		n, err := strconv.ParseInt(fields[2], 10, 32)
+		if err != nil {
+			continue
+		}
+		switch fields[0] + " " + fields[1] {
+		case "maximum config":
+			counts.MaximumConfig = int32(n)
+		case "maximum live":
+			counts.MaximumLive = int32(n)
+		case "current config":
+			counts.CurrentConfig = int32(n)
+		case "current live":
+			counts.CurrentLive = int32(n)
+		}
+	}
+	return counts, nil
+}
+
+// getGuestVCPUs queries the guest agent for vCPU state (vcpus, online, offline, can_offline)
+func (v *VirshProvider) getGuestVCPUs(ctx context.Context, vmID string) (map[string]string, error) {
+	result, err := v.runVirshCommand(ctx, "guestvcpus", vmID)
+	if err != nil {
+		return nil, err
+	}
+	return parseColonFields(result.Stdout), nil
+}
+
+// countCPUList counts the CPUs in a list such as "0-3,6"
+func countCPUList(list string) int {
+	count := 0
+	for _, part := range strings.Split(strings.TrimSpace(list), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if start, end, ok := strings.Cut(part, "-"); ok {
+			first, err1 := strconv.Atoi(start)
+			last, err2 := strconv.Atoi(end)
+			if err1 == nil && err2 == nil && last >= first {
+				count += last - first + 1
+			}
+			continue
+		}
+		if _, err := strconv.Atoi(part); err == nil {
+			count++
+		}
+	}
+	return count
+}
@@ -25,15 +25,32 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/projectbeskar/virtrigaud/internal/obs/metrics"
 )
 
+// connMetrics tracks active libvirt connections opened by virsh invocations
+var connMetrics = metrics.NewProviderRPCMetrics("libvirt")
+
 // VirshProvider implements a virsh command-line based libvirt provider
 type VirshProvider struct {
-	config      *ProviderConfig
+	config *ProviderConfig
+	uri    string
+
+	// credsMu guards credentials and env, which are replaced when the credential files change
+	credsMu     sync.RWMutex
 	credentials *Credentials
-	uri         string
 	env         []string
+
+	// watchMu guards stopWatch, which ends the connection watch started by Initialize,
+	// and credsWatch, which reloads the credential files
+	watchMu    sync.Mutex
+	stopWatch  context.CancelFunc
+	credsWatch *fsnotify.Watcher
 }
 
 // VirshDomain represents a VM domain from virsh list output
@@ -67,13 +84,14 @@ func NewVirshProvider(config *ProviderConfig) *VirshProvider {
 func (v *VirshProvider) Initialize(ctx context.Context) error {
 	log.Printf("INFO Initializing virsh-based libvirt provider")
 
-	// Load credentials from environment variables (secure approach)
-	if err := v.loadCredentialsFromEnv(); err != nil {
+	// Load credentials from environment variables and credential files (secure approach)
+	creds, err := v.loadCredentials()
+	if err != nil {
 		return fmt.Errorf("failed to load credentials: %w", err)
 	}
 
 	// Build libvirt URI and environment
-	if err := v.setupConnection(); err != nil {
+	if err := v.setupConnection(creds); err != nil {
 		return fmt.Errorf("failed to setup connection: %w", err)
 	}
 
@@ -82,65 +100,79 @@ func (v *VirshProvider) Initialize(ctx context.Context) error {
 		return fmt.Errorf("failed to connect to libvirt: %w", err)
 	}
 
+	v.startConnectionWatch()
+	v.startCredentialsWatch()
+
 	log.Printf("INFO Successfully initialized virsh provider with endpoint: %s", v.uri)
 	return nil
 }
 
-// loadCredentialsFromEnv loads credentials from environment variables for security
-func (v *VirshProvider) loadCredentialsFromEnv() error {
+// loadCredentials loads credentials from environment variables for security, then from the
+// configured credential files, which take precedence
+func (v *VirshProvider) loadCredentials() (*Credentials, error) {
 	log.Printf("INFO Loading credentials from environment variables (secure method)")
 
-	v.credentials = &Credentials{}
+	creds := &Credentials{}
 
 	// Load username from environment
 	if username := os.Getenv("LIBVIRT_USERNAME"); username != "" {
-		v.credentials.Username = username
-		log.Printf("INFO Successfully loaded username from env username_length=%d", len(v.credentials.Username))
+		creds.Username = username
+		log.Printf("INFO Successfully loaded username from env username_length=%d", len(creds.Username))
 	}
 
 	// Load password from environment
 	if password := os.Getenv("LIBVIRT_PASSWORD"); password != "" {
-		v.credentials.Password = password
-		log.Printf("INFO Successfully loaded password from env password_length=%d", len(v.credentials.Password))
+		creds.Password = password
+		log.Printf("INFO Successfully loaded password from env password_length=%d", len(creds.Password))
 	}
 
 	// Load SSH private key from environment
 	if sshKey := os.Getenv("LIBVIRT_SSH_PRIVATE_KEY"); sshKey != "" {
-		v.credentials.SSHPrivateKey = sshKey
-		log.Printf("INFO Successfully loaded SSH private key from env ssh_key_length=%d", len(v.credentials.SSHPrivateKey))
+		creds.SSHPrivateKey = sshKey
+		log.Printf("INFO Successfully loaded SSH private key from env ssh_key_length=%d", len(creds.SSHPrivateKey))
 	}
 
 	// Fallback: Load from mounted files if environment variables not set
-	if v.credentials.Username == "" {
+	if creds.Username == "" {
 		if usernameData, err := os.ReadFile("/etc/virtrigaud/credentials/username"); err == nil {
-			v.credentials.Username = strings.TrimSpace(string(usernameData))
-			log.Printf("INFO Fallback: loaded username from file username_length=%d", len(v.credentials.Username))
+			creds.Username = strings.TrimSpace(string(usernameData))
+			log.Printf("INFO Fallback: loaded username from file username_length=%d", len(creds.Username))
 		}
 	}
 
-	if v.credentials.Password == "" {
+	if creds.Password == "" {
 		if passwordData, err := os.ReadFile("/etc/virtrigaud/credentials/password"); err == nil {
-			v.credentials.Password = strings.TrimSpace(string(passwordData))
-			log.Printf("INFO Fallback: loaded password from file password_length=%d", len(v.credentials.Password))
+			creds.Password = strings.TrimSpace(string(passwordData))
+			log.Printf("INFO Fallback: loaded password from file password_length=%d", len(creds.Password))
 		}
 	}
 
-	if v.credentials.SSHPrivateKey == "" {
+	if creds.SSHPrivateKey == "" {
 		if sshKeyData, err := os.ReadFile("/etc/virtrigaud/credentials/ssh-privatekey"); err == nil {
-			v.credentials.SSHPrivateKey = strings.TrimSpace(string(sshKeyData))
-			log.Printf("INFO Fallback: loaded SSH private key from file ssh_key_length=%d", len(v.credentials.SSHPrivateKey))
+			creds.SSHPrivateKey = strings.TrimSpace(string(sshKeyData))
+			log.Printf("INFO Fallback: loaded SSH private key from file ssh_key_length=%d", len(creds.SSHPrivateKey))
 		}
 	}
 
-	if v.credentials.Username == "" && v.credentials.Password == "" && v.credentials.SSHPrivateKey == "" {
-		return fmt.Errorf("no valid credentials found in environment variables or mounted files")
+	if v.config != nil {
+		if err := loadCredentialFiles(v.config.CredentialFiles, creds); err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	if creds.Username == "" && creds.Password == "" && creds.SSHPrivateKey == "" && creds.CertData == "" {
+		return nil, fmt.Errorf("no valid credentials found in environment variables or mounted files")
+	}
+
+	return creds, nil
 }
 
 // setupConnection prepares the libvirt URI and environment for virsh commands
-func (v *VirshProvider) setupConnection() error {
+func (v *VirshProvider) setupConnection(creds *Credentials) error {
+	v.credsMu.Lock()
+	defer v.credsMu.Unlock()
+	v.credentials = creds
+
 	// Get base URI from config
 	uri := v.config.Spec.Endpoint
 	if uri == "" {
@@ -170,11 +202,20 @@ func (v *VirshProvider) setupConnection() error {
 		log.Printf("INFO Added SSH options for container environment")
 	}
 
+	// Client certificates and SASL logins are handed to virsh as files
+	authFile, err := writeConnectionFiles(creds, parsedURI)
+	if err != nil {
+		return fmt.Errorf("failed to write connection credentials: %w", err)
+	}
+
 	v.uri = parsedURI.String()
 
 	// Set up environment variables for virsh
 	v.env = os.Environ()
 	v.env = append(v.env, fmt.Sprintf("LIBVIRT_DEFAULT_URI=%s", v.uri))
+	if authFile != "" {
+		v.env = append(v.env, "LIBVIRT_AUTH_FILE="+authFile)
+	}
 
 	// Set SSH authentication via environment variables for non-interactive use
 	if v.credentials.Password != "" {
@@ -227,38 +268,107 @@ type VirshResult struct {
 	Duration time.Duration
 }
 
-// runVirshCommand executes a virsh command with proper environment and error handling
-// Special case: if first arg is "!", execute the remaining args as a direct command (not virsh)
+// runVirshCommand executes a virsh command with proper environment and error handling.
+// Commands run on a pooled connection; if the connection was broken it is redialed with
+// backoff and the command is retried once.
 func (v *VirshProvider) runVirshCommand(ctx context.Context, args ...string) (*VirshResult, error) {
+	pool := getConnPool(v.uri)
+
+	conn, err := pool.acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire libvirt connection: %w", err)
+	}
+	defer pool.release(conn)
+
+	result, err := v.execVirshCommand(ctx, pool, conn, args...)
+	if err != nil && result != nil && isInvalidConnectionError(result.Stderr) {
+		log.Printf("WARN Libvirt connection %d is no longer valid, reconnecting", conn.id)
+		if reconnectErr := v.reconnect(ctx, pool, conn); reconnectErr != nil {
+			return result, fmt.Errorf("%w (reconnect failed: %v)", err, reconnectErr)
+		}
+		result, err = v.execVirshCommand(ctx, pool, conn, args...)
+	}
+	return result, err
+}
+
+// runVirshJob executes a long-running command (a migration or disk copy) on a dedicated
+// connection outside the pool, so that the job never holds a slot the commands polling or
+// aborting it need. The connection is closed when the command ends.
+func (v *VirshProvider) runVirshJob(ctx context.Context, args ...string) (*VirshResult, error) {
+	pool := getConnPool(v.uri)
+	conn := pool.streamConn()
+	defer pool.close(conn)
+
+	return v.execVirshCommand(ctx, pool, conn, args...)
+}
+
+// execVirshCommand runs a single virsh (or direct "!"-prefixed) command on a pooled connection
+func (v *VirshProvider) execVirshCommand(ctx context.Context, pool *connPool, conn *pooledConn, args ...string) (*VirshResult, error) {
 	start := time.Now()
 
+	cmd, command, err := v.buildCommand(ctx, pool, conn, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("DEBUG Executing: %s", command)
+
+	// Run the command; each virsh invocation holds its own libvirt connection
+	connMetrics.IncActiveConnections()
+	err = cmd.Run()
+	connMetrics.DecActiveConnections()
+	duration := time.Since(start)
+
+	result := &VirshResult{
+		Command:  command,
+		ExitCode: cmd.ProcessState.ExitCode(),
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Duration: duration,
+	}
+
+	if err != nil {
+		log.Printf("ERROR Command failed: %s (exit code: %d, duration: %v)",
+			command, result.ExitCode, duration)
+		log.Printf("ERROR Stderr: %s", result.Stderr)
+		return result, &VirshError{
+			Command:  command,
+			ExitCode: result.ExitCode,
+			Stderr:   result.Stderr,
+			Stdout:   result.Stdout,
+		}
+	}
+
+	log.Printf("DEBUG Command successful: %s (duration: %v)", command, duration)
+	return result, nil
+}
+
+// buildCommand prepares a virsh (or direct "!"-prefixed) command on a pooled connection
+// and returns it with its printable form
+func (v *VirshProvider) buildCommand(ctx context.Context, pool *connPool, conn *pooledConn, args ...string) (*exec.Cmd, string, error) {
 	var cmd *exec.Cmd
 	var command string
+	creds, env := v.session()
 
 	// Special handling for direct commands (prefixed with "!")
 	if len(args) > 0 && args[0] == "!" {
 		// Execute direct command (not through virsh)
 		directArgs := args[1:] // Remove the "!" prefix
 		if len(directArgs) == 0 {
-			return nil, fmt.Errorf("no command specified after '!' prefix")
+			return nil, "", fmt.Errorf("no command specified after '!' prefix")
 		}
 
-		if v.credentials.Password != "" && strings.Contains(v.uri, "ssh://") {
+		if creds.Password != "" && strings.Contains(v.uri, "ssh://") {
 			// For remote execution, use SSH
 			parsedURI, _ := url.Parse(v.uri)
 			host := parsedURI.Host
 			user := parsedURI.User.Username()
 
-			sshArgs := []string{
-				"-e", // Read password from SSHPASS environment variable
-				"ssh",
-				"-o", "StrictHostKeyChecking=accept-new",
-				"-o", "PasswordAuthentication=yes",
-				"-o", "PubkeyAuthentication=no",
-				"-o", "UserKnownHostsFile=/tmp/known_hosts",
-				"-o", "LogLevel=ERROR",
-				fmt.Sprintf("%s@%s", user, host),
-			}
+			sshArgs := v.sshArgs(pool, conn, user, host)
 			sshArgs = append(sshArgs, directArgs...)
 
 			cmd = exec.CommandContext(ctx, "sshpass", sshArgs...)
@@ -268,10 +378,10 @@ func (v *VirshProvider) runVirshCommand(ctx context.Context, args ...string) (*V
 			cmd = exec.CommandContext(ctx, directArgs[0], directArgs[1:]...)
 			command = strings.Join(directArgs, " ")
 		}
-		cmd.Env = v.env
+		cmd.Env = env
 	} else {
 		// Standard virsh command execution
-		if v.credentials.Password != "" && strings.Contains(v.uri, "ssh://") {
+		if creds.Password != "" && strings.Contains(v.uri, "ssh://") {
 			// Build command: SSHPASS=password sshpass -e ssh -o [options] user@host virsh [args]
 			// This directly uses SSH with options rather than relying on config files
 
@@ -281,62 +391,56 @@ func (v *VirshProvider) runVirshCommand(ctx context.Context, args ...string) (*V
 			user := parsedURI.User.Username()
 
 			// Build SSH command with all necessary options
-			sshArgs := []string{
-				"-e", // Read password from SSHPASS environment variable
-				"ssh",
-				"-o", "StrictHostKeyChecking=accept-new",
-				"-o", "PasswordAuthentication=yes",
-				"-o", "PubkeyAuthentication=no",
-				"-o", "UserKnownHostsFile=/tmp/known_hosts",
-				"-o", "LogLevel=ERROR",
-				fmt.Sprintf("%s@%s", user, host),
-				"virsh",
-			}
+			sshArgs := v.sshArgs(pool, conn, user, host)
+			sshArgs = append(sshArgs, "virsh")
 			sshArgs = append(sshArgs, args...)
 
 			cmd = exec.CommandContext(ctx, "sshpass", sshArgs...)
 			command = fmt.Sprintf("sshpass -e ssh %s@%s virsh %s", user, host, strings.Join(args, " "))
-			cmd.Env = v.env
+			cmd.Env = env
 		} else {
 			// Standard virsh command for local or key-based connections
 			cmd = exec.CommandContext(ctx, "virsh", args...)
 			command = "virsh " + strings.Join(args, " ")
-			cmd.Env = v.env
+			cmd.Env = env
 		}
 	}
+	return cmd, command, nil
+}
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	log.Printf("DEBUG Executing: %s", command)
+// sshArgs builds the sshpass/ssh arguments for a password-authenticated remote command,
+// multiplexed over the pooled connection's control socket
+func (v *VirshProvider) sshArgs(pool *connPool, conn *pooledConn, user, host string) []string {
+	target := fmt.Sprintf("%s@%s", user, host)
 
-	// Run the command
-	err := cmd.Run()
-	duration := time.Since(start)
-
-	result := &VirshResult{
-		Command:  command,
-		ExitCode: cmd.ProcessState.ExitCode(),
-		Stdout:   stdout.String(),
-		Stderr:   stderr.String(),
-		Duration: duration,
+	sshArgs := []string{
+		"-e", // Read password from SSHPASS environment variable
+		"ssh",
+		"-o", "StrictHostKeyChecking=accept-new",
+		"-o", "PasswordAuthentication=yes",
+		"-o", "PubkeyAuthentication=no",
+		"-o", "UserKnownHostsFile=/tmp/known_hosts",
+		"-o", "LogLevel=ERROR",
 	}
+	sshArgs = append(sshArgs, pool.sshOptions(conn, target)...)
+	return append(sshArgs, target)
+}
 
-	if err != nil {
-		log.Printf("ERROR Command failed: %s (exit code: %d, duration: %v)",
-			command, result.ExitCode, duration)
-		log.Printf("ERROR Stderr: %s", result.Stderr)
-		return result, &VirshError{
-			Command:  command,
-			ExitCode: result.ExitCode,
-			Stderr:   result.Stderr,
-			Stdout:   result.Stdout,
-		}
+// quoteRemoteArg quotes an argument that contains spaces or quotes when commands
+// run over sshpass, where the remote shell re-splits the command line
+func (v *VirshProvider) quoteRemoteArg(arg string) string {
+	if _, _, remote := v.passwordSSHTarget(); remote {
+		return shellQuote(arg)
 	}
+	return arg
+}
 
-	log.Printf("DEBUG Command successful: %s (duration: %v)", command, duration)
-	return result, nil
+// storagePool returns the storage pool volumes are created in
+func (v *VirshProvider) storagePool() string {
+	if v.config != nil && v.config.Spec.StoragePool != "" {
+		return v.config.Spec.StoragePool
+	}
+	return defaultStoragePool
 }
 
 // listDomains lists all domains (VMs) using virsh
@@ -426,10 +530,10 @@ func (v *VirshProvider) destroyDomain(ctx context.Context, domainName string) er
 }
 
 // undefineDomain removes a domain definition
-func (v *VirshProvider) undefineDomain(ctx context.Context, domainName string) error {
+func (v *VirshProvider) undefineDomain(ctx context.Context, domainName string, flags ...string) error {
 	log.Printf("INFO Undefining domain: %s", domainName)
 
-	_, err := v.runVirshCommand(ctx, "undefine", domainName)
+	_, err := v.runVirshCommand(ctx, append([]string{"undefine", domainName}, flags...)...)
 	if err != nil {
 		return fmt.Errorf("failed to undefine domain %s: %w", domainName, err)
 	}
@@ -506,8 +610,13 @@ func (v *VirshProvider) createSSHConfig() error {
 func (v *VirshProvider) Cleanup() error {
 	log.Printf("INFO Cleaning up virsh provider")
 
-	// No persistent connections to close with virsh approach
-	// All commands are stateless
+	// Commands are stateless; only the connection watch outlives them
+	v.watchMu.Lock()
+	if v.stopWatch != nil {
+		v.stopWatch()
+		v.stopWatch = nil
+	}
+	v.watchMu.Unlock()
 
 	return nil
 }
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+	"github.com/projectbeskar/virtrigaud/pkg/vmspec"
+)
+
+// Watchdog models QEMU emulates on x86 and the actions libvirt takes when one fires
+const (
+	watchdogModelI6300ESB = vmspec.WatchdogModelI6300ESB
+	watchdogModelIB700    = vmspec.WatchdogModelIB700
+
+	watchdogActionReset    = vmspec.WatchdogActionReset
+	watchdogActionPoweroff = vmspec.WatchdogActionPoweroff
+	watchdogActionPause    = vmspec.WatchdogActionPause
+	watchdogActionNone     = vmspec.WatchdogActionNone
+)
+
+// domainWatchdog describes the watchdog device of a domain
+type domainWatchdog struct {
+	Model  string `xml:"model,attr"`
+	Action string `xml:"action,attr"`
+}
+
+// watchdogXML renders the watchdog device; it resets a hung guest unless told otherwise
+func watchdogXML(watchdog *contracts.WatchdogDevice) string {
+	if watchdog == nil {
+		return ""
+	}
+	model := watchdog.Model
+	if model == "" {
+		model = watchdogModelI6300ESB
+	}
+	action := watchdog.Action
+	if action == "" {
+		action = watchdogActionReset
+	}
+	return fmt.Sprintf("\n    <watchdog model='%s' action='%s'/>", model, action)
+}
+
+// contractWatchdog describes the watchdog device of a domain as a create request setting
+func contractWatchdog(domain *domainXML) *contracts.WatchdogDevice {
+	if len(domain.Devices.Watchdogs) == 0 {
+		return nil
+	}
+	watchdog := domain.Devices.Watchdogs[0]
+	return &contracts.WatchdogDevice{Model: watchdog.Model, Action: watchdog.Action}
+}
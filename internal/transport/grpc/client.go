@@ -29,8 +29,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/projectbeskar/virtrigaud/internal/obs/logging"
 	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
 	providerv1 "github.com/projectbeskar/virtrigaud/proto/rpc/provider/v1"
 )
@@ -64,6 +66,8 @@ func NewClient(ctx context.Context, endpoint string, tlsConfig *TLSConfig) (*Cli
 		grpc.WithDefaultCallOptions(
 			grpc.WaitForReady(true),
 		),
+		grpc.WithChainUnaryInterceptor(requestIDInterceptor),
+		grpc.WithChainStreamInterceptor(requestIDStreamInterceptor),
 	)
 
 	conn, err := grpc.NewClient(endpoint, opts...)
@@ -78,6 +82,25 @@ func NewClient(ctx context.Context, endpoint string, tlsConfig *TLSConfig) (*Cli
 	}, nil
 }
 
+// withRequestID forwards the correlation ID of a context to the provider, so that its
+// logs for the RPC can be matched with the caller's
+func withRequestID(ctx context.Context) context.Context {
+	if id := logging.CorrelationID(ctx); id != "" {
+		return metadata.AppendToOutgoingContext(ctx, logging.RequestIDMetadataKey, id)
+	}
+	return ctx
+}
+
+// requestIDInterceptor forwards the correlation ID of unary RPCs
+func requestIDInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withRequestID(ctx), method, req, reply, cc, opts...)
+}
+
+// requestIDStreamInterceptor forwards the correlation ID of streaming RPCs
+func requestIDStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withRequestID(ctx), desc, cc, method, opts...)
+}
+
 // Close closes the gRPC connection
 func (c *Client) Close() error {
 	return c.conn.Close()
@@ -116,7 +139,8 @@ func (c *Client) Create(ctx context.Context, req contracts.CreateRequest) (contr
 	}
 
 	result := contracts.CreateResponse{
-		ID: resp.Id,
+		ID:   resp.Id,
+		Host: resp.Host,
 	}
 
 	if resp.Task != nil {
@@ -188,6 +212,7 @@ func (c *Client) Reconfigure(ctx context.Context, id string, desired contracts.C
 	resp, err := c.client.Reconfigure(ctx, &providerv1.ReconfigureRequest{
 		Id:          id,
 		DesiredJson: string(desiredJSON),
+		VmMetadata:  desired.VMMetadata,
 	})
 	if err != nil {
 		return "", c.mapGRPCError("reconfigure", err)
@@ -233,9 +258,38 @@ func (c *Client) Describe(ctx context.Context, id string) (contracts.DescribeRes
 		IPs:         resp.Ips,
 		ConsoleURL:  resp.ConsoleUrl,
 		ProviderRaw: providerRaw,
+		VMMetadata:  resp.VmMetadata,
+
+		AffinityGroups:     resp.AffinityGroups,
+		AntiAffinityGroups: resp.AntiAffinityGroups,
+		Crashed:            resp.Crashed,
+		CrashReason:        resp.CrashReason,
+		GuestHostname:      resp.GuestHostname,
+		DiskThrottles:      diskThrottlesFromProto(resp.DiskThrottles),
+		Ephemeral:          resp.Ephemeral,
 	}, nil
 }
 
+// diskThrottlesFromProto converts the disk I/O limits reported by Describe
+func diskThrottlesFromProto(throttles []*providerv1.DiskThrottle) []contracts.DiskThrottle {
+	if len(throttles) == 0 {
+		return nil
+	}
+	result := make([]contracts.DiskThrottle, 0, len(throttles))
+	for _, throttle := range throttles {
+		result = append(result, contracts.DiskThrottle{
+			Target:           throttle.Target,
+			TotalBytesPerSec: throttle.TotalBytesPerSec,
+			ReadBytesPerSec:  throttle.ReadBytesPerSec,
+			WriteBytesPerSec: throttle.WriteBytesPerSec,
+			TotalIOPS:        throttle.TotalIops,
+			ReadIOPS:         throttle.ReadIops,
+			WriteIOPS:        throttle.WriteIops,
+		})
+	}
+	return result
+}
+
 // IsTaskComplete implements contracts.Provider
 func (c *Client) IsTaskComplete(ctx context.Context, taskRef string) (done bool, err error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -268,9 +322,10 @@ func (c *Client) TaskStatus(ctx context.Context, taskRef string) (contracts.Task
 	}
 
 	return contracts.TaskStatus{
-		IsCompleted: resp.Done,
-		Error:       resp.Error,
-		Message:     "", // Message field not in proto
+		IsCompleted:     resp.Done,
+		Error:           resp.Error,
+		Message:         resp.Message,
+		ProgressPercent: resp.ProgressPercent,
 	}, nil
 }
 
@@ -502,13 +557,19 @@ func (c *Client) ListVMs(ctx context.Context) ([]contracts.VMInfo, error) {
 // convertCreateRequest converts contracts.CreateRequest to gRPC format
 func (c *Client) convertCreateRequest(req contracts.CreateRequest) (*providerv1.CreateRequest, error) {
 	grpcReq := &providerv1.CreateRequest{
-		Name: req.Name,
-		Tags: req.Tags,
+		Name:               req.Name,
+		Tags:               req.Tags,
+		VmMetadata:         req.VMMetadata,
+		AffinityGroups:     req.AffinityGroups,
+		AntiAffinityGroups: req.AntiAffinityGroups,
+		OnCrash:            req.OnCrash,
+		Ephemeral:          req.Ephemeral,
 	}
 
 	// Convert UserData
 	if req.UserData != nil {
 		grpcReq.UserData = []byte(req.UserData.CloudInitData)
+		grpcReq.UserDataType = req.UserData.Type
 
 		// Convert MetaData
 		if req.MetaData != nil {
@@ -516,6 +577,11 @@ func (c *Client) convertCreateRequest(req contracts.CreateRequest) (*providerv1.
 		}
 	}
 
+	// Convert NetworkConfig
+	if req.NetworkConfig != nil {
+		grpcReq.NetworkConfig = []byte(req.NetworkConfig.NetworkConfigYAML)
+	}
+
 	// Convert each component to JSON
 	if classData, err := json.Marshal(req.Class); err == nil {
 		grpcReq.ClassJson = string(classData)
@@ -543,6 +609,12 @@ func (c *Client) convertCreateRequest(req contracts.CreateRequest) (*providerv1.
 		}
 	}
 
+	if req.DiskThrottles != nil {
+		if throttlesData, err := json.Marshal(req.DiskThrottles); err == nil {
+			grpcReq.DiskThrottlesJson = string(throttlesData)
+		}
+	}
+
 	return grpcReq, nil
 }
 
@@ -569,11 +641,22 @@ func (c *Client) mapGRPCError(operation string, err error) error {
 		return fmt.Errorf("%s failed: %w", operation, err)
 	}
 
+	// Prefer the structured error code when the provider attached one
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*providerv1.ErrorDetail); ok {
+			if mapped := mapErrorDetail(operation, d, err); mapped != nil {
+				return mapped
+			}
+		}
+	}
+
 	switch st.Code() {
 	case codes.NotFound:
 		return contracts.NewNotFoundError(fmt.Sprintf("%s: %s", operation, st.Message()), err)
 	case codes.InvalidArgument:
 		return contracts.NewInvalidSpecError(fmt.Sprintf("%s: %s", operation, st.Message()), err)
+	case codes.FailedPrecondition:
+		return contracts.NewInvalidStateError(fmt.Sprintf("%s: %s", operation, st.Message()), err)
 	case codes.Unavailable, codes.DeadlineExceeded:
 		return contracts.NewRetryableError(fmt.Sprintf("%s: %s", operation, st.Message()), err)
 	default:
@@ -581,6 +664,29 @@ func (c *Client) mapGRPCError(operation string, err error) error {
 	}
 }
 
+// mapErrorDetail converts a structured provider error detail to a contracts error
+func mapErrorDetail(operation string, d *providerv1.ErrorDetail, err error) error {
+	message := fmt.Sprintf("%s: %s", operation, d.Message)
+	switch d.Code {
+	case providerv1.ErrorCode_ERROR_CODE_NOT_FOUND:
+		return contracts.NewNotFoundError(message, err)
+	case providerv1.ErrorCode_ERROR_CODE_ALREADY_EXISTS:
+		return contracts.NewConflictError(message, err)
+	case providerv1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED:
+		return contracts.NewQuotaExceededError(message, err)
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_SPEC:
+		return contracts.NewInvalidSpecError(message, err)
+	case providerv1.ErrorCode_ERROR_CODE_INVALID_STATE:
+		return contracts.NewInvalidStateError(message, err)
+	case providerv1.ErrorCode_ERROR_CODE_UNSUPPORTED:
+		return contracts.NewNotSupportedError(message)
+	case providerv1.ErrorCode_ERROR_CODE_TRANSIENT:
+		return contracts.NewRetryableError(message, err)
+	default:
+		return nil
+	}
+}
+
 // TLSConfig represents TLS configuration for gRPC clients
 type TLSConfig struct {
 	CertFile string
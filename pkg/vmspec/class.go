@@ -0,0 +1,237 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmspec
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// CPU modes accepted in the CPU model of a VM class
+const (
+	CPUModeHostPassthrough = "host-passthrough"
+	CPUModeHostModel       = "host-model"
+	CPUModeCustom          = "custom"
+)
+
+// Firmware types accepted in the Firmware field of a VM class
+const (
+	FirmwareBIOS       = "bios"
+	FirmwareUEFI       = "uefi"
+	FirmwareUEFISecure = "uefi-secure"
+)
+
+// cpuModelNamePattern matches libvirt CPU model names (e.g. Skylake-Server-v4, EPYC-Rome)
+var cpuModelNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// VCPUCount returns the vCPU count a VM is created with
+func VCPUCount(class contracts.VMClass) int32 {
+	if class.CPU > 0 {
+		return class.CPU
+	}
+	return 1
+}
+
+// MemoryMiB returns the memory a VM is created with
+func MemoryMiB(class contracts.VMClass) int64 {
+	if class.MemoryMiB > 0 {
+		return int64(class.MemoryMiB)
+	}
+	return 1024
+}
+
+// CPUMode returns the effective CPU mode of a class; a model name alone implies custom
+func CPUMode(model *contracts.CPUModel) string {
+	switch {
+	case model == nil:
+		return CPUModeHostModel
+	case model.Mode != "":
+		return strings.ToLower(model.Mode)
+	case model.Name != "":
+		return CPUModeCustom
+	default:
+		return CPUModeHostModel
+	}
+}
+
+// ValidateCPU checks the CPU topology and model of a VM class
+func ValidateCPU(class contracts.VMClass) error {
+	if topology := class.CPUTopology; topology != nil {
+		if topology.Sockets <= 0 || topology.Cores <= 0 || topology.Threads <= 0 {
+			return contracts.NewInvalidSpecError("CPU topology sockets, cores and threads must all be positive", nil)
+		}
+		if product := topology.Sockets * topology.Cores * topology.Threads; product != VCPUCount(class) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"CPU topology %d sockets x %d cores x %d threads = %d does not match the vCPU count %d",
+				topology.Sockets, topology.Cores, topology.Threads, product, VCPUCount(class)), nil)
+		}
+	}
+
+	if model := class.CPUModel; model != nil {
+		switch mode := CPUMode(model); mode {
+		case CPUModeHostPassthrough, CPUModeHostModel:
+			if model.Name != "" {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("CPU model name %q can only be used with mode custom", model.Name), nil)
+			}
+		case CPUModeCustom:
+			if !cpuModelNamePattern.MatchString(model.Name) {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("CPU mode custom requires a valid model name, got %q", model.Name), nil)
+			}
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"unknown CPU mode %q (supported: host-passthrough, host-model, custom)", mode), nil)
+		}
+	}
+	return nil
+}
+
+// ValidateNUMA checks the NUMA cells and hugepage size of a VM class
+func ValidateNUMA(class contracts.VMClass) error {
+	if class.HugepageSizeKiB < 0 {
+		return contracts.NewInvalidSpecError("hugepage size must not be negative", nil)
+	}
+	if size := class.HugepageSizeKiB; size > 0 {
+		if size&(size-1) != 0 {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("hugepage size %d KiB is not a power of two", size), nil)
+		}
+		if (MemoryMiB(class)*1024)%size != 0 {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"memory %d MiB is not a multiple of the hugepage size %d KiB", MemoryMiB(class), size), nil)
+		}
+	}
+
+	if len(class.NUMACells) == 0 {
+		return nil
+	}
+
+	vcpus := int(VCPUCount(class))
+	assigned := make(map[int]int, vcpus)
+	var totalMiB int64
+	for i, cell := range class.NUMACells {
+		if cell.MemoryMiB <= 0 {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("NUMA cell %d must have positive memory", i), nil)
+		}
+		totalMiB += cell.MemoryMiB
+
+		cpus, err := ParseCPUSet(cell.CPUs)
+		if err != nil {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("NUMA cell %d: invalid CPU set %q", i, cell.CPUs), err)
+		}
+		for _, cpu := range cpus {
+			if cpu >= vcpus {
+				return contracts.NewInvalidSpecError(fmt.Sprintf(
+					"NUMA cell %d references vCPU %d but the VM has %d vCPUs", i, cpu, vcpus), nil)
+			}
+			if other, ok := assigned[cpu]; ok {
+				return contracts.NewInvalidSpecError(fmt.Sprintf(
+					"vCPU %d is assigned to both NUMA cells %d and %d", cpu, other, i), nil)
+			}
+			assigned[cpu] = i
+		}
+	}
+	if len(assigned) != vcpus {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"NUMA cells cover %d of %d vCPUs; every vCPU must belong to a cell", len(assigned), vcpus), nil)
+	}
+	if totalMiB != MemoryMiB(class) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"NUMA cell memory sums to %d MiB but the VM has %d MiB", totalMiB, MemoryMiB(class)), nil)
+	}
+	return nil
+}
+
+// ParseCPUSet expands a cpuset string such as "0-3,6" into sorted CPU numbers
+func ParseCPUSet(set string) ([]int, error) {
+	var cpus []int
+	for _, part := range strings.Split(set, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		start, end, isRange := strings.Cut(part, "-")
+		if !isRange {
+			end = start
+		}
+		first, err := strconv.Atoi(strings.TrimSpace(start))
+		if err != nil {
+			return nil, err
+		}
+		last, err := strconv.Atoi(strings.TrimSpace(end))
+		if err != nil {
+			return nil, err
+		}
+		if first < 0 || last < first {
+			return nil, fmt.Errorf("invalid range %q", part)
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	if len(cpus) == 0 {
+		return nil, fmt.Errorf("empty CPU set")
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FirmwareType returns the normalized firmware of a VM class. SecurityProfile.SecureBoot
+// selects uefi-secure when no firmware is set; it cannot be combined with BIOS or plain UEFI.
+func FirmwareType(class contracts.VMClass) (string, error) {
+	secureBoot := class.SecurityProfile != nil && class.SecurityProfile.SecureBoot
+
+	firmware := strings.ToLower(class.Firmware)
+	switch firmware {
+	case "":
+		if secureBoot {
+			return FirmwareUEFISecure, nil
+		}
+		return FirmwareBIOS, nil
+	case FirmwareBIOS, FirmwareUEFI, FirmwareUEFISecure:
+	case "efi":
+		firmware = FirmwareUEFI
+	default:
+		return "", contracts.NewInvalidSpecError(fmt.Sprintf(
+			"unknown firmware %q (supported: bios, uefi, uefi-secure)", class.Firmware), nil)
+	}
+
+	if secureBoot && firmware != FirmwareUEFISecure {
+		return "", contracts.NewInvalidSpecError(fmt.Sprintf(
+			"secure boot requires firmware uefi-secure, got %s", firmware), nil)
+	}
+	return firmware, nil
+}
+
+// TPMEnabled reports whether a VM class requests a TPM
+func TPMEnabled(class contracts.VMClass) bool {
+	return class.SecurityProfile != nil && class.SecurityProfile.TPMEnabled
+}
+
+// ValidateTPM checks the TPM settings of a VM class; only TPM 2.0 is emulated
+func ValidateTPM(class contracts.VMClass) error {
+	if !TPMEnabled(class) {
+		return nil
+	}
+	if version := class.SecurityProfile.TPMVersion; version != "" && version != "2.0" {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported TPM version %q (supported: 2.0)", version), nil)
+	}
+	return nil
+}
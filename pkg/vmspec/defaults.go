@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Disk buses a disk can be attached to
+const (
+	DiskBusVirtio = "virtio"
+	DiskBusSCSI   = "scsi"
+	DiskBusSATA   = "sata"
+)
+
+// Guest operating system families an OS hint resolves to
+const (
+	OSTypeLinux   = "linux"
+	OSTypeWindows = "windows"
+	OSTypeGeneric = "generic"
+)
+
+// osProfile holds the device defaults suited to a family of guest operating systems.
+// Empty fields leave the provider's generic defaults in place.
+type osProfile struct {
+	DiskBus    string
+	NICModel   string
+	VideoModel string
+	Clock      *contracts.ClockConfig
+}
+
+// osProfiles maps each OS family to its defaults. Windows ships without virtio drivers,
+// so it gets emulated SATA and e1000e devices and the local-time clock it expects.
+var osProfiles = map[string]osProfile{
+	OSTypeLinux: {
+		DiskBus:    DiskBusVirtio,
+		NICModel:   "virtio",
+		VideoModel: VideoModelVirtio,
+	},
+	OSTypeWindows: {
+		DiskBus:    DiskBusSATA,
+		NICModel:   "e1000e",
+		VideoModel: VideoModelQXL,
+		Clock:      &contracts.ClockConfig{Offset: ClockOffsetLocaltime, HyperVClock: true},
+	},
+	OSTypeGeneric: {},
+}
+
+// virtioVariantPrefixes are the osinfo short ID prefixes of guests with virtio drivers built in
+var virtioVariantPrefixes = []string{
+	"linux", "ubuntu", "debian", "fedora", "rhel", "rhcos", "centos", "rocky", "almalinux",
+	"ol", "sles", "sled", "opensuse", "archlinux", "alpine", "gentoo", "fcos", "flatcar",
+	"freebsd", "openbsd", "netbsd",
+}
+
+// ResolveOSType maps an OS type or osinfo variant hint (e.g. ubuntu22.04, win11) to an OS
+// family. Hints that are not recognised fall back to the generic family with a warning.
+func ResolveOSType(osType, osVariant string) (string, string) {
+	osType = strings.ToLower(strings.TrimSpace(osType))
+	osVariant = strings.ToLower(strings.TrimSpace(osVariant))
+
+	if osType != "" {
+		if _, ok := osProfiles[osType]; ok {
+			return osType, ""
+		}
+		return OSTypeGeneric, fmt.Sprintf("unknown OS type %q; using generic device defaults", osType)
+	}
+
+	switch {
+	case osVariant == "":
+		return OSTypeGeneric, ""
+	case strings.HasPrefix(osVariant, "win"):
+		return OSTypeWindows, ""
+	}
+	for _, prefix := range virtioVariantPrefixes {
+		if strings.HasPrefix(osVariant, prefix) {
+			return OSTypeLinux, ""
+		}
+	}
+	return OSTypeGeneric, fmt.Sprintf("unknown OS variant %q; using generic device defaults", osVariant)
+}
+
+// Default fills the disk buses, NIC models, clock and video model a request leaves unset
+// with the defaults of its OS hint. A request without disks gets the root disk every VM is
+// created with. It returns the updated request and any warning; the input is not modified.
+func Default(req contracts.CreateRequest) (contracts.CreateRequest, []string) {
+	osType, warning := ResolveOSType(req.OSType, req.OSVariant)
+	var warnings []string
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+	profile := osProfiles[osType]
+
+	if profile.DiskBus != "" {
+		disks := append([]contracts.DiskSpec(nil), req.Disks...)
+		if len(disks) == 0 {
+			disks = []contracts.DiskSpec{{}}
+		}
+		for i := range disks {
+			if disks[i].Bus == "" {
+				disks[i].Bus = profile.DiskBus
+			}
+		}
+		req.Disks = disks
+	}
+
+	if profile.NICModel != "" && len(req.Networks) > 0 {
+		networks := append([]contracts.NetworkAttachment(nil), req.Networks...)
+		for i := range networks {
+			if networks[i].Model == "" {
+				networks[i].Model = profile.NICModel
+			}
+		}
+		req.Networks = networks
+	}
+
+	if profile.Clock != nil && req.Clock == nil {
+		clock := *profile.Clock
+		req.Clock = &clock
+	}
+
+	if profile.VideoModel != "" && req.Graphics != nil && req.Graphics.Display != "" && req.Graphics.VideoModel == "" {
+		graphics := *req.Graphics
+		graphics.VideoModel = profile.VideoModel
+		req.Graphics = &graphics
+	}
+
+	return req, warnings
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmspec
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Video adapter models a VM can be given
+const (
+	VideoModelCirrus = "cirrus"
+	VideoModelQXL    = "qxl"
+	VideoModelVirtio = "virtio"
+	VideoModelNone   = "none"
+)
+
+// Remote display protocols a VM console can be exposed over
+const (
+	DisplayVNC   = "vnc"
+	DisplaySPICE = "spice"
+)
+
+// maxVNCPasswordLength is the longest password the VNC authentication scheme uses;
+// QEMU silently truncates longer ones
+const maxVNCPasswordLength = 8
+
+// Input device types and buses a VM can be given
+const (
+	InputTypeTablet   = "tablet"
+	InputTypeKeyboard = "keyboard"
+	InputTypeMouse    = "mouse"
+
+	InputBusUSB    = "usb"
+	InputBusVirtio = "virtio"
+)
+
+// Watchdog models QEMU emulates on x86 and the actions taken when one fires
+const (
+	WatchdogModelI6300ESB = "i6300esb"
+	WatchdogModelIB700    = "ib700"
+
+	WatchdogActionReset    = "reset"
+	WatchdogActionPoweroff = "poweroff"
+	WatchdogActionPause    = "pause"
+	WatchdogActionNone     = "none"
+)
+
+// Actions taken when the guest reports a crash through its panic device
+const (
+	OnCrashDestroy  = "destroy"
+	OnCrashRestart  = "restart"
+	OnCrashPreserve = "preserve"
+)
+
+// Guest clock offsets
+const (
+	ClockOffsetUTC       = "utc"
+	ClockOffsetLocaltime = "localtime"
+)
+
+// TimerTickPolicies are the ways QEMU can deliver timer ticks a guest missed
+var TimerTickPolicies = []string{"delay", "catchup", "merge", "discard"}
+
+// ValidateRNG checks the rate limit of an RNG device
+func ValidateRNG(rng *contracts.RNGDevice) error {
+	if rng == nil || rng.Disabled {
+		return nil
+	}
+	if rng.RateBytes < 0 || rng.RatePeriodMs < 0 {
+		return contracts.NewInvalidSpecError("RNG rate bytes and period must not be negative", nil)
+	}
+	if rng.RatePeriodMs > 0 && rng.RateBytes == 0 {
+		return contracts.NewInvalidSpecError("RNG rate period requires rate bytes", nil)
+	}
+	return nil
+}
+
+// ValidateGraphics checks the video model and display settings of a create request
+func ValidateGraphics(graphics *contracts.GraphicsConfig) error {
+	if graphics == nil {
+		return nil
+	}
+
+	switch graphics.Display {
+	case "", DisplayVNC, DisplaySPICE:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported display %q (must be vnc or spice)", graphics.Display), nil)
+	}
+	if graphics.Password != "" && graphics.Display == "" {
+		return contracts.NewInvalidSpecError("a display password requires a vnc or spice display", nil)
+	}
+	if graphics.Display == DisplayVNC && len(graphics.Password) > maxVNCPasswordLength {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("VNC passwords are at most %d characters", maxVNCPasswordLength), nil)
+	}
+
+	switch graphics.VideoModel {
+	case "", VideoModelCirrus, VideoModelQXL, VideoModelVirtio, VideoModelNone:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported video model %q (must be cirrus, qxl, virtio or none)", graphics.VideoModel), nil)
+	}
+	model := VideoModel(graphics)
+	if model == VideoModelNone && graphics.Display != "" {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("a %s display requires a video model other than none", graphics.Display), nil)
+	}
+	if graphics.VRAMKiB < 0 {
+		return contracts.NewInvalidSpecError("video memory must not be negative", nil)
+	}
+	if graphics.VRAMKiB > 0 && (model == VideoModelNone || model == VideoModelVirtio) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("video memory cannot be set for video model %s", model), nil)
+	}
+	return nil
+}
+
+// VideoModel returns the video adapter of a request: headless VMs get none, VNC displays
+// cirrus and SPICE displays qxl unless the request names one
+func VideoModel(graphics *contracts.GraphicsConfig) string {
+	if graphics == nil {
+		return VideoModelNone
+	}
+	if graphics.VideoModel != "" {
+		return graphics.VideoModel
+	}
+	switch graphics.Display {
+	case DisplayVNC:
+		return VideoModelCirrus
+	case DisplaySPICE:
+		return VideoModelQXL
+	default:
+		return VideoModelNone
+	}
+}
+
+// ValidateInputDevices checks the type and bus of each requested input device
+func ValidateInputDevices(inputs []contracts.InputDevice) error {
+	for _, input := range inputs {
+		switch input.Type {
+		case InputTypeTablet, InputTypeKeyboard, InputTypeMouse:
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported input device type %q (must be tablet, keyboard or mouse)", input.Type), nil)
+		}
+		switch input.Bus {
+		case "", InputBusUSB, InputBusVirtio:
+		default:
+			return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported bus %q for %s input device (must be usb or virtio)", input.Bus, input.Type), nil)
+		}
+	}
+	return nil
+}
+
+// InputDevices returns the input devices of a request. Without an explicit list a VM with
+// a display gets a USB tablet, whose absolute pointer keeps the VNC/SPICE cursor in sync.
+func InputDevices(inputs []contracts.InputDevice, graphics *contracts.GraphicsConfig) []contracts.InputDevice {
+	if inputs != nil {
+		return inputs
+	}
+	if graphics != nil && graphics.Display != "" {
+		return []contracts.InputDevice{{Type: InputTypeTablet, Bus: InputBusUSB}}
+	}
+	return nil
+}
+
+// ValidateWatchdog checks the model and action of a watchdog device
+func ValidateWatchdog(watchdog *contracts.WatchdogDevice) error {
+	if watchdog == nil {
+		return nil
+	}
+	switch watchdog.Model {
+	case "", WatchdogModelI6300ESB, WatchdogModelIB700:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported watchdog model %q (must be i6300esb or ib700)", watchdog.Model), nil)
+	}
+	switch watchdog.Action {
+	case "", WatchdogActionReset, WatchdogActionPoweroff, WatchdogActionPause, WatchdogActionNone:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported watchdog action %q (must be reset, poweroff, pause or none)", watchdog.Action), nil)
+	}
+	return nil
+}
+
+// ValidateOnCrash checks the action taken when the guest crashes
+func ValidateOnCrash(action string) error {
+	switch action {
+	case "", OnCrashDestroy, OnCrashRestart, OnCrashPreserve:
+		return nil
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported on-crash action %q (must be restart, preserve or destroy)", action), nil)
+	}
+}
+
+// ValidateClock checks the offset and tick policies of a clock configuration
+func ValidateClock(clock *contracts.ClockConfig) error {
+	if clock == nil {
+		return nil
+	}
+	switch clock.Offset {
+	case "", ClockOffsetUTC, ClockOffsetLocaltime:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported clock offset %q (must be utc or localtime)", clock.Offset), nil)
+	}
+	for _, timer := range []struct{ name, policy string }{{"rtc", clock.RTCTickPolicy}, {"pit", clock.PITTickPolicy}} {
+		if timer.policy != "" && !slices.Contains(TimerTickPolicies, timer.policy) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("unsupported %s tick policy %q (must be one of %s)",
+				timer.name, timer.policy, strings.Join(TimerTickPolicies, ", ")), nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Ranges libvirt accepts for the CPU and block I/O tuning of a VM
+const (
+	MinCPUShares   = 2
+	MaxCPUShares   = 262144
+	MinCPUPeriod   = 1000
+	MaxCPUPeriod   = 1000000
+	MinCPUQuota    = 1000
+	MaxCPUQuota    = 17592186044415
+	MinBlkioWeight = 100
+	MaxBlkioWeight = 1000
+)
+
+// Memory modes accepted in the NUMA tuning of a VM class
+const (
+	NUMAModeStrict      = "strict"
+	NUMAModePreferred   = "preferred"
+	NUMAModeInterleave  = "interleave"
+	NUMAModeRestrictive = "restrictive"
+)
+
+// ValidateCPUTune checks the scheduler parameters and vCPU pins of a VM class.
+// Whether the pinned host CPUs exist is checked against the host.
+func ValidateCPUTune(class contracts.VMClass) error {
+	tune := class.CPUTune
+	if tune == nil {
+		return nil
+	}
+
+	if tune.Shares != 0 && (tune.Shares < MinCPUShares || tune.Shares > MaxCPUShares) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"CPU shares %d out of range (%d-%d)", tune.Shares, MinCPUShares, MaxCPUShares), nil)
+	}
+	if tune.PeriodMicros != 0 && (tune.PeriodMicros < MinCPUPeriod || tune.PeriodMicros > MaxCPUPeriod) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"CPU period %d us out of range (%d-%d)", tune.PeriodMicros, MinCPUPeriod, MaxCPUPeriod), nil)
+	}
+	if tune.QuotaMicros != 0 && tune.QuotaMicros != -1 && (tune.QuotaMicros < MinCPUQuota || tune.QuotaMicros > MaxCPUQuota) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"CPU quota %d us out of range (%d-%d, or -1 for unlimited)", tune.QuotaMicros, MinCPUQuota, MaxCPUQuota), nil)
+	}
+
+	vcpus := VCPUCount(class)
+	pinned := make(map[int32]bool, len(tune.VCPUPins))
+	for _, pin := range tune.VCPUPins {
+		if pin.VCPU < 0 || pin.VCPU >= vcpus {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"vCPU pin references vCPU %d but the VM has %d vCPUs", pin.VCPU, vcpus), nil)
+		}
+		if pinned[pin.VCPU] {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("vCPU %d is pinned more than once", pin.VCPU), nil)
+		}
+		pinned[pin.VCPU] = true
+		if _, err := ParseCPUSet(pin.CPUSet); err != nil {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("vCPU %d: invalid host CPU set %q", pin.VCPU, pin.CPUSet), err)
+		}
+	}
+	if tune.EmulatorPin != "" {
+		if _, err := ParseCPUSet(tune.EmulatorPin); err != nil {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("invalid emulator host CPU set %q", tune.EmulatorPin), err)
+		}
+	}
+	return nil
+}
+
+// NUMAMode returns the effective memory mode of a NUMA tuning; unset means strict
+func NUMAMode(tune *contracts.NUMATune) string {
+	if tune == nil || tune.Mode == "" {
+		return NUMAModeStrict
+	}
+	return strings.ToLower(tune.Mode)
+}
+
+// ValidateNUMATune checks the memory mode and node set of a VM class.
+// Whether the nodes exist is checked against the host.
+func ValidateNUMATune(tune *contracts.NUMATune) error {
+	if tune == nil {
+		return nil
+	}
+
+	switch mode := NUMAMode(tune); mode {
+	case NUMAModeStrict, NUMAModePreferred, NUMAModeInterleave, NUMAModeRestrictive:
+	default:
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"unknown NUMA memory mode %q (supported: strict, preferred, interleave, restrictive)", mode), nil)
+	}
+
+	nodes, err := ParseCPUSet(tune.Nodeset)
+	if err != nil {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("invalid NUMA node set %q", tune.Nodeset), err)
+	}
+	if NUMAMode(tune) == NUMAModePreferred && len(nodes) != 1 {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"preferred NUMA memory mode takes a single node, got %q", tune.Nodeset), nil)
+	}
+	return nil
+}
+
+// ValidateBlkioTune checks the block I/O weight and per-device IOPS limits
+func ValidateBlkioTune(tune *contracts.BlkioTune) error {
+	if tune == nil {
+		return nil
+	}
+
+	if tune.Weight != 0 && (tune.Weight < MinBlkioWeight || tune.Weight > MaxBlkioWeight) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf(
+			"block I/O weight %d out of range (%d-%d)", tune.Weight, MinBlkioWeight, MaxBlkioWeight), nil)
+	}
+
+	seen := make(map[string]bool, len(tune.Devices))
+	for _, device := range tune.Devices {
+		if !strings.HasPrefix(device.Path, "/dev/") {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"block I/O device %q must be a host block device path under /dev", device.Path), nil)
+		}
+		if seen[device.Path] {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("block I/O device %s is listed more than once", device.Path), nil)
+		}
+		seen[device.Path] = true
+		if device.ReadIOPS < 0 || device.WriteIOPS < 0 {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("IOPS limits of %s must not be negative", device.Path), nil)
+		}
+	}
+	return nil
+}
+
+// ValidateDiskThrottles checks that every throttled target is listed once, that no limit is
+// negative and that a total limit is not combined with the read or write limit of its kind
+func ValidateDiskThrottles(throttles []contracts.DiskThrottle) error {
+	seen := make(map[string]bool, len(throttles))
+	for _, throttle := range throttles {
+		if throttle.Target == "" {
+			return contracts.NewInvalidSpecError("disk throttle target is required", nil)
+		}
+		if seen[throttle.Target] {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("disk %s is throttled more than once", throttle.Target), nil)
+		}
+		seen[throttle.Target] = true
+
+		for _, limit := range []int64{throttle.TotalBytesPerSec, throttle.ReadBytesPerSec, throttle.WriteBytesPerSec,
+			throttle.TotalIOPS, throttle.ReadIOPS, throttle.WriteIOPS} {
+			if limit < 0 {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("I/O limits of disk %s must not be negative", throttle.Target), nil)
+			}
+		}
+		if throttle.TotalBytesPerSec > 0 && (throttle.ReadBytesPerSec > 0 || throttle.WriteBytesPerSec > 0) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"disk %s cannot combine a total bytes/s limit with read or write bytes/s limits", throttle.Target), nil)
+		}
+		if throttle.TotalIOPS > 0 && (throttle.ReadIOPS > 0 || throttle.WriteIOPS > 0) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf(
+				"disk %s cannot combine a total IOPS limit with read or write IOPS limits", throttle.Target), nil)
+		}
+	}
+	return nil
+}
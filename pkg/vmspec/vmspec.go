@@ -0,0 +1,193 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vmspec holds the provider-independent defaulting and validation of VM create
+// requests, so that providers and admission webhooks apply the same rules.
+package vmspec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+// Check is a named validation of a create request
+type Check struct {
+	// Name identifies the check in findings (e.g. cpu, numa, graphics)
+	Name string
+	// Validate returns an InvalidSpec error when the request fails the check
+	Validate func(req contracts.CreateRequest) error
+}
+
+// Checks lists every validation of a create request that does not need a host, in the
+// order Validate runs them
+var Checks = []Check{
+	{Name: "uuid", Validate: ValidateUUID},
+	{Name: "metadata", Validate: func(req contracts.CreateRequest) error { return ValidateMetadata(req.VMMetadata) }},
+	{Name: "affinity", Validate: func(req contracts.CreateRequest) error {
+		return ValidateAffinityGroups(req.AffinityGroups, req.AntiAffinityGroups)
+	}},
+	{Name: "network-bandwidth", Validate: func(req contracts.CreateRequest) error { return ValidateNetworkBandwidth(req.Networks) }},
+	{Name: "cpu", Validate: func(req contracts.CreateRequest) error { return ValidateCPU(req.Class) }},
+	{Name: "numa", Validate: func(req contracts.CreateRequest) error { return ValidateNUMA(req.Class) }},
+	{Name: "cputune", Validate: func(req contracts.CreateRequest) error { return ValidateCPUTune(req.Class) }},
+	{Name: "blkiotune", Validate: func(req contracts.CreateRequest) error { return ValidateBlkioTune(req.Class.BlkioTune) }},
+	{Name: "numatune", Validate: func(req contracts.CreateRequest) error { return ValidateNUMATune(req.Class.NUMATune) }},
+	{Name: "disk-throttle", Validate: func(req contracts.CreateRequest) error { return ValidateDiskThrottles(req.DiskThrottles) }},
+	{Name: "firmware", Validate: func(req contracts.CreateRequest) error {
+		_, err := FirmwareType(req.Class)
+		return err
+	}},
+	{Name: "tpm", Validate: func(req contracts.CreateRequest) error { return ValidateTPM(req.Class) }},
+	{Name: "rng", Validate: func(req contracts.CreateRequest) error { return ValidateRNG(req.RNG) }},
+	{Name: "graphics", Validate: func(req contracts.CreateRequest) error { return ValidateGraphics(req.Graphics) }},
+	{Name: "inputs", Validate: func(req contracts.CreateRequest) error { return ValidateInputDevices(req.Inputs) }},
+	{Name: "watchdog", Validate: func(req contracts.CreateRequest) error { return ValidateWatchdog(req.Watchdog) }},
+	{Name: "on-crash", Validate: func(req contracts.CreateRequest) error { return ValidateOnCrash(req.OnCrash) }},
+	{Name: "clock", Validate: func(req contracts.CreateRequest) error { return ValidateClock(req.Clock) }},
+}
+
+// Validate runs every check and returns the first failure
+func Validate(req contracts.CreateRequest) error {
+	for _, check := range Checks {
+		if err := check.Validate(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uuidPattern matches a UUID in canonical 8-4-4-4-12 form
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidateUUID checks the client-supplied UUID of a create request
+func ValidateUUID(req contracts.CreateRequest) error {
+	if req.UUID != "" && !uuidPattern.MatchString(req.UUID) {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("VM UUID %q is not a valid UUID", req.UUID), nil)
+	}
+	return nil
+}
+
+const (
+	// maxMetadataBytes caps the combined size of all metadata keys and values
+	maxMetadataBytes = 16 * 1024
+
+	// maxMetadataKeyLength is the DNS label length limit
+	maxMetadataKeyLength = 63
+
+	// maxHostnameLength is the length limit of a fully qualified hostname
+	maxHostnameLength = 253
+)
+
+// metadataKeyPattern matches a DNS label (RFC 1123)
+var metadataKeyPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// hostnameLabelPattern matches a hostname label (RFC 1123), which may be mixed case
+var hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
+// ValidateMetadata checks that keys are DNS labels and the map fits the size cap
+func ValidateMetadata(metadata map[string]string) error {
+	total := 0
+	for key, value := range metadata {
+		if len(key) > maxMetadataKeyLength || !metadataKeyPattern.MatchString(key) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("metadata key %q must be a DNS label (lowercase alphanumerics and '-', at most %d characters)", key, maxMetadataKeyLength), nil)
+		}
+		total += len(key) + len(value)
+	}
+	if total > maxMetadataBytes {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("metadata is %d bytes, exceeding the %d byte limit", total, maxMetadataBytes), nil)
+	}
+	return nil
+}
+
+// ValidateAffinityGroups checks that group names are unique DNS labels and that no group is
+// both an affinity and an anti-affinity group
+func ValidateAffinityGroups(affinity, antiAffinity []string) error {
+	seen := make(map[string]string, len(affinity)+len(antiAffinity))
+	for _, list := range []struct {
+		kind   string
+		groups []string
+	}{
+		{"affinity", affinity},
+		{"anti-affinity", antiAffinity},
+	} {
+		for _, group := range list.groups {
+			if len(group) > maxMetadataKeyLength || !metadataKeyPattern.MatchString(group) {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("%s group %q must be a DNS label (lowercase alphanumerics and '-', at most %d characters)", list.kind, group, maxMetadataKeyLength), nil)
+			}
+			if kind, ok := seen[group]; ok {
+				if kind == list.kind {
+					return contracts.NewInvalidSpecError(fmt.Sprintf("%s group %q is listed twice", list.kind, group), nil)
+				}
+				return contracts.NewInvalidSpecError(fmt.Sprintf("group %q cannot be both an affinity and an anti-affinity group", group), nil)
+			}
+			seen[group] = list.kind
+		}
+	}
+	return nil
+}
+
+// ValidateHostname checks that a guest hostname is an RFC 1123 hostname: dot-separated
+// labels of at most 63 characters and at most 253 characters in total
+func ValidateHostname(hostname string) error {
+	if hostname == "" {
+		return contracts.NewInvalidSpecError("hostname is required", nil)
+	}
+	if len(hostname) > maxHostnameLength {
+		return contracts.NewInvalidSpecError(fmt.Sprintf("hostname is %d characters, exceeding the %d character limit", len(hostname), maxHostnameLength), nil)
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if len(label) > maxMetadataKeyLength || !hostnameLabelPattern.MatchString(label) {
+			return contracts.NewInvalidSpecError(fmt.Sprintf("hostname %q must be dot-separated labels of alphanumerics and '-' (at most %d characters each)", hostname, maxMetadataKeyLength), nil)
+		}
+	}
+	return nil
+}
+
+// ValidateNetworkBandwidth checks the shaping rules of every attachment.
+// All values are KiB/s (burst: KiB), the units libvirt uses for <bandwidth>.
+func ValidateNetworkBandwidth(networks []contracts.NetworkAttachment) error {
+	for _, network := range networks {
+		if network.Bandwidth == nil {
+			continue
+		}
+		for _, rule := range []struct {
+			direction string
+			limit     *contracts.BandwidthLimit
+		}{
+			{"inbound", network.Bandwidth.Inbound},
+			{"outbound", network.Bandwidth.Outbound},
+		} {
+			direction, limit := rule.direction, rule.limit
+			if limit == nil {
+				continue
+			}
+			if limit.AverageKiBps <= 0 {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: %s average must be a positive rate in KiB/s", network.Name, direction), nil)
+			}
+			if limit.PeakKiBps < 0 || limit.BurstKiB < 0 {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: %s peak and burst must not be negative", network.Name, direction), nil)
+			}
+			if limit.PeakKiBps > 0 && limit.PeakKiBps < limit.AverageKiBps {
+				return contracts.NewInvalidSpecError(fmt.Sprintf("network %q: %s peak (%d KiB/s) must be at least the average (%d KiB/s)",
+					network.Name, direction, limit.PeakKiBps, limit.AverageKiBps), nil)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmspec
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/projectbeskar/virtrigaud/internal/providers/contracts"
+)
+
+func TestValidate_InvalidSpecs(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         contracts.CreateRequest
+		errContains string
+	}{
+		{
+			name:        "malformed UUID",
+			req:         contracts.CreateRequest{UUID: "not-a-uuid"},
+			errContains: "is not a valid UUID",
+		},
+		{
+			name:        "metadata key is not a DNS label",
+			req:         contracts.CreateRequest{VMMetadata: map[string]string{"Team_Name": "x"}},
+			errContains: "must be a DNS label",
+		},
+		{
+			name:        "affinity group is not a DNS label",
+			req:         contracts.CreateRequest{AffinityGroups: []string{"Web Tier"}},
+			errContains: "affinity group \"Web Tier\" must be a DNS label",
+		},
+		{
+			name:        "group is both affinity and anti-affinity",
+			req:         contracts.CreateRequest{AffinityGroups: []string{"web"}, AntiAffinityGroups: []string{"db", "web"}},
+			errContains: "cannot be both an affinity and an anti-affinity group",
+		},
+		{
+			name:        "negative disk throttle",
+			req:         contracts.CreateRequest{DiskThrottles: []contracts.DiskThrottle{{Target: "vda", ReadIOPS: -1}}},
+			errContains: "I/O limits of disk vda must not be negative",
+		},
+		{
+			name:        "total and read IOPS limits",
+			req:         contracts.CreateRequest{DiskThrottles: []contracts.DiskThrottle{{Target: "vda", TotalIOPS: 500, ReadIOPS: 200}}},
+			errContains: "cannot combine a total IOPS limit",
+		},
+		{
+			name:        "unknown on-crash action",
+			req:         contracts.CreateRequest{OnCrash: "coredump"},
+			errContains: "unsupported on-crash action",
+		},
+		{
+			name: "bandwidth peak below average",
+			req: contracts.CreateRequest{Networks: []contracts.NetworkAttachment{{
+				Name:      "eth0",
+				Bandwidth: &contracts.NetworkBandwidth{Inbound: &contracts.BandwidthLimit{AverageKiBps: 1000, PeakKiBps: 500}},
+			}}},
+			errContains: "must be at least the average",
+		},
+		{
+			name: "CPU topology does not match vCPU count",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:         4,
+				CPUTopology: &contracts.CPUTopology{Sockets: 1, Cores: 2, Threads: 1},
+			}},
+			errContains: "does not match the vCPU count 4",
+		},
+		{
+			name:        "CPU model name with host-passthrough",
+			req:         contracts.CreateRequest{Class: contracts.VMClass{CPUModel: &contracts.CPUModel{Mode: "host-passthrough", Name: "EPYC"}}},
+			errContains: "can only be used with mode custom",
+		},
+		{
+			name: "NUMA cell memory does not sum to VM memory",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:       2,
+				MemoryMiB: 4096,
+				NUMACells: []contracts.NUMACell{{CPUs: "0", MemoryMiB: 1024}, {CPUs: "1", MemoryMiB: 1024}},
+			}},
+			errContains: "NUMA cell memory sums to 2048 MiB but the VM has 4096 MiB",
+		},
+		{
+			name: "NUMA cells leave a vCPU unassigned",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:       4,
+				MemoryMiB: 2048,
+				NUMACells: []contracts.NUMACell{{CPUs: "0-2", MemoryMiB: 2048}},
+			}},
+			errContains: "NUMA cells cover 3 of 4 vCPUs",
+		},
+		{
+			name: "vCPU in two NUMA cells",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:       2,
+				MemoryMiB: 2048,
+				NUMACells: []contracts.NUMACell{{CPUs: "0-1", MemoryMiB: 1024}, {CPUs: "1", MemoryMiB: 1024}},
+			}},
+			errContains: "vCPU 1 is assigned to both NUMA cells 0 and 1",
+		},
+		{
+			name:        "hugepage size not a power of two",
+			req:         contracts.CreateRequest{Class: contracts.VMClass{HugepageSizeKiB: 3000}},
+			errContains: "is not a power of two",
+		},
+		{
+			name:        "CPU shares out of range",
+			req:         contracts.CreateRequest{Class: contracts.VMClass{CPUTune: &contracts.CPUTune{Shares: 1}}},
+			errContains: "CPU shares 1 out of range",
+		},
+		{
+			name: "vCPU pin beyond vCPU count",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:     2,
+				CPUTune: &contracts.CPUTune{VCPUPins: []contracts.VCPUPin{{VCPU: 2, CPUSet: "0"}}},
+			}},
+			errContains: "references vCPU 2 but the VM has 2 vCPUs",
+		},
+		{
+			name:        "preferred NUMA mode with two nodes",
+			req:         contracts.CreateRequest{Class: contracts.VMClass{NUMATune: &contracts.NUMATune{Mode: "preferred", Nodeset: "0-1"}}},
+			errContains: "takes a single node",
+		},
+		{
+			name: "negative device IOPS",
+			req: contracts.CreateRequest{Class: contracts.VMClass{BlkioTune: &contracts.BlkioTune{
+				Devices: []contracts.BlkioDeviceTune{{Path: "/dev/sda", ReadIOPS: -1}},
+			}}},
+			errContains: "must not be negative",
+		},
+		{
+			name: "secure boot with BIOS firmware",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				Firmware:        "bios",
+				SecurityProfile: &contracts.SecurityProfile{SecureBoot: true},
+			}},
+			errContains: "secure boot requires firmware uefi-secure",
+		},
+		{
+			name:        "unsupported TPM version",
+			req:         contracts.CreateRequest{Class: contracts.VMClass{SecurityProfile: &contracts.SecurityProfile{TPMEnabled: true, TPMVersion: "1.2"}}},
+			errContains: "unsupported TPM version",
+		},
+		{
+			name:        "RNG period without rate bytes",
+			req:         contracts.CreateRequest{RNG: &contracts.RNGDevice{RatePeriodMs: 1000}},
+			errContains: "RNG rate period requires rate bytes",
+		},
+		{
+			name:        "VNC password too long",
+			req:         contracts.CreateRequest{Graphics: &contracts.GraphicsConfig{Display: "vnc", Password: "longer-than-eight"}},
+			errContains: "VNC passwords are at most 8 characters",
+		},
+		{
+			name:        "display without video adapter",
+			req:         contracts.CreateRequest{Graphics: &contracts.GraphicsConfig{Display: "spice", VideoModel: "none"}},
+			errContains: "requires a video model other than none",
+		},
+		{
+			name:        "unsupported input bus",
+			req:         contracts.CreateRequest{Inputs: []contracts.InputDevice{{Type: "tablet", Bus: "ps2"}}},
+			errContains: "unsupported bus",
+		},
+		{
+			name:        "unsupported watchdog action",
+			req:         contracts.CreateRequest{Watchdog: &contracts.WatchdogDevice{Action: "explode"}},
+			errContains: "unsupported watchdog action",
+		},
+		{
+			name:        "unsupported clock tick policy",
+			req:         contracts.CreateRequest{Clock: &contracts.ClockConfig{RTCTickPolicy: "skip"}},
+			errContains: "unsupported rtc tick policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.req)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.errContains)
+				var providerErr *contracts.ProviderError
+				if assert.True(t, errors.As(err, &providerErr)) {
+					assert.Equal(t, contracts.ErrorTypeInvalidSpec, providerErr.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_ValidSpecs(t *testing.T) {
+	tests := []struct {
+		name string
+		req  contracts.CreateRequest
+	}{
+		{
+			name: "empty request",
+			req:  contracts.CreateRequest{},
+		},
+		{
+			name: "NUMA cells covering all vCPUs and memory",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU:             4,
+				MemoryMiB:       4096,
+				HugepageSizeKiB: 2048,
+				NUMACells:       []contracts.NUMACell{{CPUs: "0-1", MemoryMiB: 2048}, {CPUs: "2,3", MemoryMiB: 2048}},
+			}},
+		},
+		{
+			name: "CPU and block I/O tuning",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				CPU: 2,
+				CPUTune: &contracts.CPUTune{
+					Shares: 512, PeriodMicros: 100000, QuotaMicros: -1,
+					VCPUPins:    []contracts.VCPUPin{{VCPU: 0, CPUSet: "2"}, {VCPU: 1, CPUSet: "3-4"}},
+					EmulatorPin: "0-1",
+				},
+				NUMATune: &contracts.NUMATune{Nodeset: "0"},
+				BlkioTune: &contracts.BlkioTune{
+					Weight:  500,
+					Devices: []contracts.BlkioDeviceTune{{Path: "/dev/sda", ReadIOPS: 1000, WriteIOPS: 500}},
+				},
+			}},
+		},
+		{
+			name: "secure boot without explicit firmware",
+			req: contracts.CreateRequest{Class: contracts.VMClass{
+				SecurityProfile: &contracts.SecurityProfile{SecureBoot: true, TPMEnabled: true, TPMVersion: "2.0"},
+			}},
+		},
+		{
+			name: "SPICE display with defaults",
+			req:  contracts.CreateRequest{Graphics: &contracts.GraphicsConfig{Display: "spice"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, Validate(tt.req))
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            contracts.CreateRequest
+		expectDiskBus  []string
+		expectNICModel []string
+		expectClock    *contracts.ClockConfig
+		expectVideo    string
+		expectWarnings int
+	}{
+		{
+			name: "windows variant gets emulated devices",
+			req: contracts.CreateRequest{
+				OSVariant: "win11",
+				Networks:  []contracts.NetworkAttachment{{Name: "lan"}},
+				Graphics:  &contracts.GraphicsConfig{Display: "vnc"},
+			},
+			expectDiskBus:  []string{DiskBusSATA},
+			expectNICModel: []string{"e1000e"},
+			expectClock:    &contracts.ClockConfig{Offset: ClockOffsetLocaltime, HyperVClock: true},
+			expectVideo:    VideoModelQXL,
+		},
+		{
+			name: "linux keeps explicit settings",
+			req: contracts.CreateRequest{
+				OSType:   "Linux",
+				Disks:    []contracts.DiskSpec{{SizeGiB: 10}, {SizeGiB: 20, Bus: DiskBusSCSI}},
+				Networks: []contracts.NetworkAttachment{{Name: "lan", Model: "e1000"}},
+			},
+			expectDiskBus:  []string{DiskBusVirtio, DiskBusSCSI},
+			expectNICModel: []string{"e1000"},
+		},
+		{
+			name:           "unknown variant falls back to generic with a warning",
+			req:            contracts.CreateRequest{OSVariant: "plan9"},
+			expectWarnings: 1,
+		},
+		{
+			name: "no hint leaves the request unchanged",
+			req:  contracts.CreateRequest{Graphics: &contracts.GraphicsConfig{Display: "vnc"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings := Default(tt.req)
+			assert.Len(t, warnings, tt.expectWarnings)
+
+			var buses []string
+			for _, disk := range got.Disks {
+				buses = append(buses, disk.Bus)
+			}
+			assert.Equal(t, tt.expectDiskBus, buses)
+
+			var models []string
+			for _, network := range got.Networks {
+				models = append(models, network.Model)
+			}
+			assert.Equal(t, tt.expectNICModel, models)
+
+			assert.Equal(t, tt.expectClock, got.Clock)
+			if got.Graphics != nil {
+				assert.Equal(t, tt.expectVideo, got.Graphics.VideoModel)
+			}
+		})
+	}
+}
+
+func TestDefault_DoesNotModifyInput(t *testing.T) {
+	req := contracts.CreateRequest{
+		OSType:   OSTypeWindows,
+		Disks:    []contracts.DiskSpec{{SizeGiB: 10}},
+		Networks: []contracts.NetworkAttachment{{Name: "lan"}},
+		Graphics: &contracts.GraphicsConfig{Display: "vnc"},
+	}
+
+	_, _ = Default(req)
+
+	assert.Empty(t, req.Disks[0].Bus)
+	assert.Empty(t, req.Networks[0].Model)
+	assert.Empty(t, req.Graphics.VideoModel)
+	assert.Nil(t, req.Clock)
+}
+
+func TestValidateHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		wantErr  bool
+	}{
+		{name: "short name", hostname: "web-01"},
+		{name: "fully qualified", hostname: "Web-01.example.com"},
+		{name: "empty", hostname: "", wantErr: true},
+		{name: "leading hyphen", hostname: "-web", wantErr: true},
+		{name: "empty label", hostname: "web..example.com", wantErr: true},
+		{name: "underscore", hostname: "web_01", wantErr: true},
+		{name: "label too long", hostname: strings.Repeat("a", 64), wantErr: true},
+		{name: "name too long", hostname: strings.Repeat("a.", 127) + "a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHostname(tt.hostname)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var providerErr *contracts.ProviderError
+			if assert.True(t, errors.As(err, &providerErr)) {
+				assert.Equal(t, contracts.ErrorTypeInvalidSpec, providerErr.Type)
+			}
+		})
+	}
+}
@@ -19,24 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Provider_Validate_FullMethodName        = "/provider.v1.Provider/Validate"
-	Provider_Create_FullMethodName          = "/provider.v1.Provider/Create"
-	Provider_Delete_FullMethodName          = "/provider.v1.Provider/Delete"
-	Provider_Power_FullMethodName           = "/provider.v1.Provider/Power"
-	Provider_Reconfigure_FullMethodName     = "/provider.v1.Provider/Reconfigure"
-	Provider_HardwareUpgrade_FullMethodName = "/provider.v1.Provider/HardwareUpgrade"
-	Provider_Describe_FullMethodName        = "/provider.v1.Provider/Describe"
-	Provider_TaskStatus_FullMethodName      = "/provider.v1.Provider/TaskStatus"
-	Provider_SnapshotCreate_FullMethodName  = "/provider.v1.Provider/SnapshotCreate"
-	Provider_SnapshotDelete_FullMethodName  = "/provider.v1.Provider/SnapshotDelete"
-	Provider_SnapshotRevert_FullMethodName  = "/provider.v1.Provider/SnapshotRevert"
-	Provider_Clone_FullMethodName           = "/provider.v1.Provider/Clone"
-	Provider_ImagePrepare_FullMethodName    = "/provider.v1.Provider/ImagePrepare"
-	Provider_GetCapabilities_FullMethodName = "/provider.v1.Provider/GetCapabilities"
-	Provider_ExportDisk_FullMethodName      = "/provider.v1.Provider/ExportDisk"
-	Provider_ImportDisk_FullMethodName      = "/provider.v1.Provider/ImportDisk"
-	Provider_GetDiskInfo_FullMethodName     = "/provider.v1.Provider/GetDiskInfo"
-	Provider_ListVMs_FullMethodName         = "/provider.v1.Provider/ListVMs"
+	Provider_Validate_FullMethodName             = "/provider.v1.Provider/Validate"
+	Provider_Create_FullMethodName               = "/provider.v1.Provider/Create"
+	Provider_EnsureVM_FullMethodName             = "/provider.v1.Provider/EnsureVM"
+	Provider_Delete_FullMethodName               = "/provider.v1.Provider/Delete"
+	Provider_Power_FullMethodName                = "/provider.v1.Provider/Power"
+	Provider_Reconfigure_FullMethodName          = "/provider.v1.Provider/Reconfigure"
+	Provider_HardwareUpgrade_FullMethodName      = "/provider.v1.Provider/HardwareUpgrade"
+	Provider_Describe_FullMethodName             = "/provider.v1.Provider/Describe"
+	Provider_TaskStatus_FullMethodName           = "/provider.v1.Provider/TaskStatus"
+	Provider_SnapshotCreate_FullMethodName       = "/provider.v1.Provider/SnapshotCreate"
+	Provider_SnapshotDelete_FullMethodName       = "/provider.v1.Provider/SnapshotDelete"
+	Provider_SnapshotRevert_FullMethodName       = "/provider.v1.Provider/SnapshotRevert"
+	Provider_ListSnapshots_FullMethodName        = "/provider.v1.Provider/ListSnapshots"
+	Provider_ConsolidateSnapshots_FullMethodName = "/provider.v1.Provider/ConsolidateSnapshots"
+	Provider_Clone_FullMethodName                = "/provider.v1.Provider/Clone"
+	Provider_ImagePrepare_FullMethodName         = "/provider.v1.Provider/ImagePrepare"
+	Provider_GetCapabilities_FullMethodName      = "/provider.v1.Provider/GetCapabilities"
+	Provider_ExportDisk_FullMethodName           = "/provider.v1.Provider/ExportDisk"
+	Provider_ImportDisk_FullMethodName           = "/provider.v1.Provider/ImportDisk"
+	Provider_GetDiskInfo_FullMethodName          = "/provider.v1.Provider/GetDiskInfo"
+	Provider_ListVMs_FullMethodName              = "/provider.v1.Provider/ListVMs"
+	Provider_Migrate_FullMethodName              = "/provider.v1.Provider/Migrate"
+	Provider_OpenConsole_FullMethodName          = "/provider.v1.Provider/OpenConsole"
+	Provider_GetCapacity_FullMethodName          = "/provider.v1.Provider/GetCapacity"
+	Provider_GetVMStats_FullMethodName           = "/provider.v1.Provider/GetVMStats"
+	Provider_AdoptVM_FullMethodName              = "/provider.v1.Provider/AdoptVM"
+	Provider_ExportSpec_FullMethodName           = "/provider.v1.Provider/ExportSpec"
+	Provider_RebootVM_FullMethodName             = "/provider.v1.Provider/RebootVM"
+	Provider_ExecInGuest_FullMethodName          = "/provider.v1.Provider/ExecInGuest"
+	Provider_GetConsoleLog_FullMethodName        = "/provider.v1.Provider/GetConsoleLog"
+	Provider_RenameVM_FullMethodName             = "/provider.v1.Provider/RenameVM"
+	Provider_InsertMedia_FullMethodName          = "/provider.v1.Provider/InsertMedia"
+	Provider_EjectMedia_FullMethodName           = "/provider.v1.Provider/EjectMedia"
+	Provider_SuspendVM_FullMethodName            = "/provider.v1.Provider/SuspendVM"
+	Provider_ResumeVM_FullMethodName             = "/provider.v1.Provider/ResumeVM"
+	Provider_SaveVM_FullMethodName               = "/provider.v1.Provider/SaveVM"
+	Provider_DiscardManagedSave_FullMethodName   = "/provider.v1.Provider/DiscardManagedSave"
+	Provider_ValidateSpec_FullMethodName         = "/provider.v1.Provider/ValidateSpec"
+	Provider_WatchEvents_FullMethodName          = "/provider.v1.Provider/WatchEvents"
+	Provider_CollectGarbage_FullMethodName       = "/provider.v1.Provider/CollectGarbage"
+	Provider_WatchOperation_FullMethodName       = "/provider.v1.Provider/WatchOperation"
+	Provider_GetGuestHostname_FullMethodName     = "/provider.v1.Provider/GetGuestHostname"
+	Provider_SetGuestHostname_FullMethodName     = "/provider.v1.Provider/SetGuestHostname"
+	Provider_GetRawXML_FullMethodName            = "/provider.v1.Provider/GetRawXML"
 )
 
 // ProviderClient is the client API for Provider service.
@@ -49,6 +75,8 @@ type ProviderClient interface {
 	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
 	// Create a new virtual machine
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	// Create, reconfigure and power a virtual machine until it matches the desired state
+	EnsureVM(ctx context.Context, in *EnsureVMRequest, opts ...grpc.CallOption) (*EnsureVMResponse, error)
 	// Delete a virtual machine
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*TaskResponse, error)
 	// Perform power operations on a virtual machine
@@ -65,6 +93,9 @@ type ProviderClient interface {
 	SnapshotCreate(ctx context.Context, in *SnapshotCreateRequest, opts ...grpc.CallOption) (*SnapshotCreateResponse, error)
 	SnapshotDelete(ctx context.Context, in *SnapshotDeleteRequest, opts ...grpc.CallOption) (*TaskResponse, error)
 	SnapshotRevert(ctx context.Context, in *SnapshotRevertRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// Commit external snapshot overlays into their base, streaming progress; cancel to abort
+	ConsolidateSnapshots(ctx context.Context, in *ConsolidateSnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConsolidateSnapshotsProgress], error)
 	// Clone operations
 	Clone(ctx context.Context, in *CloneRequest, opts ...grpc.CallOption) (*CloneResponse, error)
 	// Image preparation and import
@@ -75,8 +106,54 @@ type ProviderClient interface {
 	ExportDisk(ctx context.Context, in *ExportDiskRequest, opts ...grpc.CallOption) (*ExportDiskResponse, error)
 	ImportDisk(ctx context.Context, in *ImportDiskRequest, opts ...grpc.CallOption) (*ImportDiskResponse, error)
 	GetDiskInfo(ctx context.Context, in *GetDiskInfoRequest, opts ...grpc.CallOption) (*GetDiskInfoResponse, error)
-	// List all VMs managed by this provider
+	// List the VMs managed by this provider in pages, optionally filtered by a label selector over their metadata
 	ListVMs(ctx context.Context, in *ListVMsRequest, opts ...grpc.CallOption) (*ListVMsResponse, error)
+	// Migrate a virtual machine to another host
+	Migrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	// Open an interactive console session to a virtual machine
+	OpenConsole(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConsoleRequest, ConsoleResponse], error)
+	// Report host capacity and current resource allocation
+	GetCapacity(ctx context.Context, in *GetCapacityRequest, opts ...grpc.CallOption) (*GetCapacityResponse, error)
+	// Report per-VM CPU, memory, disk and network counters
+	GetVMStats(ctx context.Context, in *GetVMStatsRequest, opts ...grpc.CallOption) (*GetVMStatsResponse, error)
+	// Adopt a pre-existing VM, refusing VMs managed by another provider instance
+	AdoptVM(ctx context.Context, in *AdoptVMRequest, opts ...grpc.CallOption) (*AdoptVMResponse, error)
+	// Export a VM's effective configuration as VMClass and VirtualMachine manifests
+	ExportSpec(ctx context.Context, in *ExportSpecRequest, opts ...grpc.CallOption) (*ExportSpecResponse, error)
+	// Reboot a running VM through the guest agent or ACPI, or reset it when forced
+	RebootVM(ctx context.Context, in *RebootVMRequest, opts ...grpc.CallOption) (*RebootVMResponse, error)
+	// Run a command inside the guest; disabled unless the provider runs with --allow-guest-exec
+	ExecInGuest(ctx context.Context, in *ExecInGuestRequest, opts ...grpc.CallOption) (*ExecInGuestResponse, error)
+	// Return the end of a VM's serial console log
+	GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (*GetConsoleLogResponse, error)
+	// Rename a shut off VM, keeping its UUID, disks and metadata
+	RenameVM(ctx context.Context, in *RenameVMRequest, opts ...grpc.CallOption) (*RenameVMResponse, error)
+	// Insert or replace the media of a CD-ROM drive, live if the VM is running
+	InsertMedia(ctx context.Context, in *InsertMediaRequest, opts ...grpc.CallOption) (*InsertMediaResponse, error)
+	// Eject the media of a CD-ROM drive, live if the VM is running
+	EjectMedia(ctx context.Context, in *EjectMediaRequest, opts ...grpc.CallOption) (*EjectMediaResponse, error)
+	// Pause a running VM in memory without shutting it down
+	SuspendVM(ctx context.Context, in *SuspendVMRequest, opts ...grpc.CallOption) (*SuspendVMResponse, error)
+	// Continue a VM paused by SuspendVM
+	ResumeVM(ctx context.Context, in *ResumeVMRequest, opts ...grpc.CallOption) (*ResumeVMResponse, error)
+	// Save a VM's memory state to disk and stop it; the next power-on restores it
+	SaveVM(ctx context.Context, in *SaveVMRequest, opts ...grpc.CallOption) (*SaveVMResponse, error)
+	// Remove a VM's saved memory state so the next power-on boots it afresh
+	DiscardManagedSave(ctx context.Context, in *DiscardManagedSaveRequest, opts ...grpc.CallOption) (*DiscardManagedSaveResponse, error)
+	// Lint a VM spec against the host capabilities, returning every error and warning found
+	ValidateSpec(ctx context.Context, in *ValidateSpecRequest, opts ...grpc.CallOption) (*ValidateSpecResponse, error)
+	// Stream domain lifecycle events, starting with the current state of every watched VM
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DomainEvent], error)
+	// Remove volumes, NVRAM stores and seed images no domain refers to, left behind by VMs that no longer exist
+	CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error)
+	// Stream the progress of a clone, migration or snapshot consolidation until it finishes or is aborted
+	WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OperationProgress], error)
+	// Read the hostname the guest booted with; Unavailable while the guest agent is not connected
+	GetGuestHostname(ctx context.Context, in *GetGuestHostnameRequest, opts ...grpc.CallOption) (*GetGuestHostnameResponse, error)
+	// Set the hostname inside the guest; Unavailable while the guest agent is not connected
+	SetGuestHostname(ctx context.Context, in *SetGuestHostnameRequest, opts ...grpc.CallOption) (*SetGuestHostnameResponse, error)
+	// Return the raw domain XML with secrets redacted; disabled unless the provider runs with --allow-debug-rpcs
+	GetRawXML(ctx context.Context, in *GetRawXMLRequest, opts ...grpc.CallOption) (*GetRawXMLResponse, error)
 }
 
 type providerClient struct {
@@ -107,6 +184,16 @@ func (c *providerClient) Create(ctx context.Context, in *CreateRequest, opts ...
 	return out, nil
 }
 
+func (c *providerClient) EnsureVM(ctx context.Context, in *EnsureVMRequest, opts ...grpc.CallOption) (*EnsureVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnsureVMResponse)
+	err := c.cc.Invoke(ctx, Provider_EnsureVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TaskResponse)
@@ -197,6 +284,35 @@ func (c *providerClient) SnapshotRevert(ctx context.Context, in *SnapshotRevertR
 	return out, nil
 }
 
+func (c *providerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Provider_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ConsolidateSnapshots(ctx context.Context, in *ConsolidateSnapshotsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConsolidateSnapshotsProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[0], Provider_ConsolidateSnapshots_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConsolidateSnapshotsRequest, ConsolidateSnapshotsProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_ConsolidateSnapshotsClient = grpc.ServerStreamingClient[ConsolidateSnapshotsProgress]
+
 func (c *providerClient) Clone(ctx context.Context, in *CloneRequest, opts ...grpc.CallOption) (*CloneResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CloneResponse)
@@ -267,6 +383,257 @@ func (c *providerClient) ListVMs(ctx context.Context, in *ListVMsRequest, opts .
 	return out, nil
 }
 
+func (c *providerClient) Migrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, Provider_Migrate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) OpenConsole(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConsoleRequest, ConsoleResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[1], Provider_OpenConsole_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConsoleRequest, ConsoleResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_OpenConsoleClient = grpc.BidiStreamingClient[ConsoleRequest, ConsoleResponse]
+
+func (c *providerClient) GetCapacity(ctx context.Context, in *GetCapacityRequest, opts ...grpc.CallOption) (*GetCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapacityResponse)
+	err := c.cc.Invoke(ctx, Provider_GetCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetVMStats(ctx context.Context, in *GetVMStatsRequest, opts ...grpc.CallOption) (*GetVMStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVMStatsResponse)
+	err := c.cc.Invoke(ctx, Provider_GetVMStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) AdoptVM(ctx context.Context, in *AdoptVMRequest, opts ...grpc.CallOption) (*AdoptVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptVMResponse)
+	err := c.cc.Invoke(ctx, Provider_AdoptVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ExportSpec(ctx context.Context, in *ExportSpecRequest, opts ...grpc.CallOption) (*ExportSpecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSpecResponse)
+	err := c.cc.Invoke(ctx, Provider_ExportSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RebootVM(ctx context.Context, in *RebootVMRequest, opts ...grpc.CallOption) (*RebootVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebootVMResponse)
+	err := c.cc.Invoke(ctx, Provider_RebootVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ExecInGuest(ctx context.Context, in *ExecInGuestRequest, opts ...grpc.CallOption) (*ExecInGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecInGuestResponse)
+	err := c.cc.Invoke(ctx, Provider_ExecInGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (*GetConsoleLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsoleLogResponse)
+	err := c.cc.Invoke(ctx, Provider_GetConsoleLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RenameVM(ctx context.Context, in *RenameVMRequest, opts ...grpc.CallOption) (*RenameVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameVMResponse)
+	err := c.cc.Invoke(ctx, Provider_RenameVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) InsertMedia(ctx context.Context, in *InsertMediaRequest, opts ...grpc.CallOption) (*InsertMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertMediaResponse)
+	err := c.cc.Invoke(ctx, Provider_InsertMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) EjectMedia(ctx context.Context, in *EjectMediaRequest, opts ...grpc.CallOption) (*EjectMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EjectMediaResponse)
+	err := c.cc.Invoke(ctx, Provider_EjectMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) SuspendVM(ctx context.Context, in *SuspendVMRequest, opts ...grpc.CallOption) (*SuspendVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendVMResponse)
+	err := c.cc.Invoke(ctx, Provider_SuspendVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ResumeVM(ctx context.Context, in *ResumeVMRequest, opts ...grpc.CallOption) (*ResumeVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeVMResponse)
+	err := c.cc.Invoke(ctx, Provider_ResumeVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) SaveVM(ctx context.Context, in *SaveVMRequest, opts ...grpc.CallOption) (*SaveVMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveVMResponse)
+	err := c.cc.Invoke(ctx, Provider_SaveVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) DiscardManagedSave(ctx context.Context, in *DiscardManagedSaveRequest, opts ...grpc.CallOption) (*DiscardManagedSaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardManagedSaveResponse)
+	err := c.cc.Invoke(ctx, Provider_DiscardManagedSave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateSpec(ctx context.Context, in *ValidateSpecRequest, opts ...grpc.CallOption) (*ValidateSpecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateSpecResponse)
+	err := c.cc.Invoke(ctx, Provider_ValidateSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DomainEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[2], Provider_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, DomainEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_WatchEventsClient = grpc.ServerStreamingClient[DomainEvent]
+
+func (c *providerClient) CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectGarbageResponse)
+	err := c.cc.Invoke(ctx, Provider_CollectGarbage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OperationProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[3], Provider_WatchOperation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOperationRequest, OperationProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_WatchOperationClient = grpc.ServerStreamingClient[OperationProgress]
+
+func (c *providerClient) GetGuestHostname(ctx context.Context, in *GetGuestHostnameRequest, opts ...grpc.CallOption) (*GetGuestHostnameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuestHostnameResponse)
+	err := c.cc.Invoke(ctx, Provider_GetGuestHostname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) SetGuestHostname(ctx context.Context, in *SetGuestHostnameRequest, opts ...grpc.CallOption) (*SetGuestHostnameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGuestHostnameResponse)
+	err := c.cc.Invoke(ctx, Provider_SetGuestHostname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetRawXML(ctx context.Context, in *GetRawXMLRequest, opts ...grpc.CallOption) (*GetRawXMLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRawXMLResponse)
+	err := c.cc.Invoke(ctx, Provider_GetRawXML_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderServer is the server API for Provider service.
 // All implementations must embed UnimplementedProviderServer
 // for forward compatibility.
@@ -277,6 +644,8 @@ type ProviderServer interface {
 	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
 	// Create a new virtual machine
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	// Create, reconfigure and power a virtual machine until it matches the desired state
+	EnsureVM(context.Context, *EnsureVMRequest) (*EnsureVMResponse, error)
 	// Delete a virtual machine
 	Delete(context.Context, *DeleteRequest) (*TaskResponse, error)
 	// Perform power operations on a virtual machine
@@ -293,6 +662,9 @@ type ProviderServer interface {
 	SnapshotCreate(context.Context, *SnapshotCreateRequest) (*SnapshotCreateResponse, error)
 	SnapshotDelete(context.Context, *SnapshotDeleteRequest) (*TaskResponse, error)
 	SnapshotRevert(context.Context, *SnapshotRevertRequest) (*TaskResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// Commit external snapshot overlays into their base, streaming progress; cancel to abort
+	ConsolidateSnapshots(*ConsolidateSnapshotsRequest, grpc.ServerStreamingServer[ConsolidateSnapshotsProgress]) error
 	// Clone operations
 	Clone(context.Context, *CloneRequest) (*CloneResponse, error)
 	// Image preparation and import
@@ -303,8 +675,54 @@ type ProviderServer interface {
 	ExportDisk(context.Context, *ExportDiskRequest) (*ExportDiskResponse, error)
 	ImportDisk(context.Context, *ImportDiskRequest) (*ImportDiskResponse, error)
 	GetDiskInfo(context.Context, *GetDiskInfoRequest) (*GetDiskInfoResponse, error)
-	// List all VMs managed by this provider
+	// List the VMs managed by this provider in pages, optionally filtered by a label selector over their metadata
 	ListVMs(context.Context, *ListVMsRequest) (*ListVMsResponse, error)
+	// Migrate a virtual machine to another host
+	Migrate(context.Context, *MigrateRequest) (*TaskResponse, error)
+	// Open an interactive console session to a virtual machine
+	OpenConsole(grpc.BidiStreamingServer[ConsoleRequest, ConsoleResponse]) error
+	// Report host capacity and current resource allocation
+	GetCapacity(context.Context, *GetCapacityRequest) (*GetCapacityResponse, error)
+	// Report per-VM CPU, memory, disk and network counters
+	GetVMStats(context.Context, *GetVMStatsRequest) (*GetVMStatsResponse, error)
+	// Adopt a pre-existing VM, refusing VMs managed by another provider instance
+	AdoptVM(context.Context, *AdoptVMRequest) (*AdoptVMResponse, error)
+	// Export a VM's effective configuration as VMClass and VirtualMachine manifests
+	ExportSpec(context.Context, *ExportSpecRequest) (*ExportSpecResponse, error)
+	// Reboot a running VM through the guest agent or ACPI, or reset it when forced
+	RebootVM(context.Context, *RebootVMRequest) (*RebootVMResponse, error)
+	// Run a command inside the guest; disabled unless the provider runs with --allow-guest-exec
+	ExecInGuest(context.Context, *ExecInGuestRequest) (*ExecInGuestResponse, error)
+	// Return the end of a VM's serial console log
+	GetConsoleLog(context.Context, *GetConsoleLogRequest) (*GetConsoleLogResponse, error)
+	// Rename a shut off VM, keeping its UUID, disks and metadata
+	RenameVM(context.Context, *RenameVMRequest) (*RenameVMResponse, error)
+	// Insert or replace the media of a CD-ROM drive, live if the VM is running
+	InsertMedia(context.Context, *InsertMediaRequest) (*InsertMediaResponse, error)
+	// Eject the media of a CD-ROM drive, live if the VM is running
+	EjectMedia(context.Context, *EjectMediaRequest) (*EjectMediaResponse, error)
+	// Pause a running VM in memory without shutting it down
+	SuspendVM(context.Context, *SuspendVMRequest) (*SuspendVMResponse, error)
+	// Continue a VM paused by SuspendVM
+	ResumeVM(context.Context, *ResumeVMRequest) (*ResumeVMResponse, error)
+	// Save a VM's memory state to disk and stop it; the next power-on restores it
+	SaveVM(context.Context, *SaveVMRequest) (*SaveVMResponse, error)
+	// Remove a VM's saved memory state so the next power-on boots it afresh
+	DiscardManagedSave(context.Context, *DiscardManagedSaveRequest) (*DiscardManagedSaveResponse, error)
+	// Lint a VM spec against the host capabilities, returning every error and warning found
+	ValidateSpec(context.Context, *ValidateSpecRequest) (*ValidateSpecResponse, error)
+	// Stream domain lifecycle events, starting with the current state of every watched VM
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[DomainEvent]) error
+	// Remove volumes, NVRAM stores and seed images no domain refers to, left behind by VMs that no longer exist
+	CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error)
+	// Stream the progress of a clone, migration or snapshot consolidation until it finishes or is aborted
+	WatchOperation(*WatchOperationRequest, grpc.ServerStreamingServer[OperationProgress]) error
+	// Read the hostname the guest booted with; Unavailable while the guest agent is not connected
+	GetGuestHostname(context.Context, *GetGuestHostnameRequest) (*GetGuestHostnameResponse, error)
+	// Set the hostname inside the guest; Unavailable while the guest agent is not connected
+	SetGuestHostname(context.Context, *SetGuestHostnameRequest) (*SetGuestHostnameResponse, error)
+	// Return the raw domain XML with secrets redacted; disabled unless the provider runs with --allow-debug-rpcs
+	GetRawXML(context.Context, *GetRawXMLRequest) (*GetRawXMLResponse, error)
 	mustEmbedUnimplementedProviderServer()
 }
 
@@ -321,6 +739,9 @@ func (UnimplementedProviderServer) Validate(context.Context, *ValidateRequest) (
 func (UnimplementedProviderServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedProviderServer) EnsureVM(context.Context, *EnsureVMRequest) (*EnsureVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnsureVM not implemented")
+}
 func (UnimplementedProviderServer) Delete(context.Context, *DeleteRequest) (*TaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -348,6 +769,12 @@ func (UnimplementedProviderServer) SnapshotDelete(context.Context, *SnapshotDele
 func (UnimplementedProviderServer) SnapshotRevert(context.Context, *SnapshotRevertRequest) (*TaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SnapshotRevert not implemented")
 }
+func (UnimplementedProviderServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedProviderServer) ConsolidateSnapshots(*ConsolidateSnapshotsRequest, grpc.ServerStreamingServer[ConsolidateSnapshotsProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ConsolidateSnapshots not implemented")
+}
 func (UnimplementedProviderServer) Clone(context.Context, *CloneRequest) (*CloneResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clone not implemented")
 }
@@ -369,6 +796,75 @@ func (UnimplementedProviderServer) GetDiskInfo(context.Context, *GetDiskInfoRequ
 func (UnimplementedProviderServer) ListVMs(context.Context, *ListVMsRequest) (*ListVMsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVMs not implemented")
 }
+func (UnimplementedProviderServer) Migrate(context.Context, *MigrateRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedProviderServer) OpenConsole(grpc.BidiStreamingServer[ConsoleRequest, ConsoleResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method OpenConsole not implemented")
+}
+func (UnimplementedProviderServer) GetCapacity(context.Context, *GetCapacityRequest) (*GetCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacity not implemented")
+}
+func (UnimplementedProviderServer) GetVMStats(context.Context, *GetVMStatsRequest) (*GetVMStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMStats not implemented")
+}
+func (UnimplementedProviderServer) AdoptVM(context.Context, *AdoptVMRequest) (*AdoptVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptVM not implemented")
+}
+func (UnimplementedProviderServer) ExportSpec(context.Context, *ExportSpecRequest) (*ExportSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSpec not implemented")
+}
+func (UnimplementedProviderServer) RebootVM(context.Context, *RebootVMRequest) (*RebootVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootVM not implemented")
+}
+func (UnimplementedProviderServer) ExecInGuest(context.Context, *ExecInGuestRequest) (*ExecInGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecInGuest not implemented")
+}
+func (UnimplementedProviderServer) GetConsoleLog(context.Context, *GetConsoleLogRequest) (*GetConsoleLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsoleLog not implemented")
+}
+func (UnimplementedProviderServer) RenameVM(context.Context, *RenameVMRequest) (*RenameVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameVM not implemented")
+}
+func (UnimplementedProviderServer) InsertMedia(context.Context, *InsertMediaRequest) (*InsertMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertMedia not implemented")
+}
+func (UnimplementedProviderServer) EjectMedia(context.Context, *EjectMediaRequest) (*EjectMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EjectMedia not implemented")
+}
+func (UnimplementedProviderServer) SuspendVM(context.Context, *SuspendVMRequest) (*SuspendVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendVM not implemented")
+}
+func (UnimplementedProviderServer) ResumeVM(context.Context, *ResumeVMRequest) (*ResumeVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeVM not implemented")
+}
+func (UnimplementedProviderServer) SaveVM(context.Context, *SaveVMRequest) (*SaveVMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveVM not implemented")
+}
+func (UnimplementedProviderServer) DiscardManagedSave(context.Context, *DiscardManagedSaveRequest) (*DiscardManagedSaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardManagedSave not implemented")
+}
+func (UnimplementedProviderServer) ValidateSpec(context.Context, *ValidateSpecRequest) (*ValidateSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSpec not implemented")
+}
+func (UnimplementedProviderServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[DomainEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedProviderServer) CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedProviderServer) WatchOperation(*WatchOperationRequest, grpc.ServerStreamingServer[OperationProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOperation not implemented")
+}
+func (UnimplementedProviderServer) GetGuestHostname(context.Context, *GetGuestHostnameRequest) (*GetGuestHostnameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestHostname not implemented")
+}
+func (UnimplementedProviderServer) SetGuestHostname(context.Context, *SetGuestHostnameRequest) (*SetGuestHostnameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuestHostname not implemented")
+}
+func (UnimplementedProviderServer) GetRawXML(context.Context, *GetRawXMLRequest) (*GetRawXMLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawXML not implemented")
+}
 func (UnimplementedProviderServer) mustEmbedUnimplementedProviderServer() {}
 func (UnimplementedProviderServer) testEmbeddedByValue()                  {}
 
@@ -426,6 +922,24 @@ func _Provider_Create_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_EnsureVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsureVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).EnsureVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_EnsureVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).EnsureVM(ctx, req.(*EnsureVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +1102,35 @@ func _Provider_SnapshotRevert_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ConsolidateSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConsolidateSnapshotsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).ConsolidateSnapshots(m, &grpc.GenericServerStream[ConsolidateSnapshotsRequest, ConsolidateSnapshotsProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_ConsolidateSnapshotsServer = grpc.ServerStreamingServer[ConsolidateSnapshotsProgress]
+
 func _Provider_Clone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloneRequest)
 	if err := dec(in); err != nil {
@@ -714,11 +1257,400 @@ func _Provider_ListVMs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-// Provider_ServiceDesc is the grpc.ServiceDesc for Provider service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Provider_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "provider.v1.Provider",
+func _Provider_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_Migrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).Migrate(ctx, req.(*MigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_OpenConsole_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProviderServer).OpenConsole(&grpc.GenericServerStream[ConsoleRequest, ConsoleResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_OpenConsoleServer = grpc.BidiStreamingServer[ConsoleRequest, ConsoleResponse]
+
+func _Provider_GetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetCapacity(ctx, req.(*GetCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetVMStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVMStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetVMStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetVMStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetVMStats(ctx, req.(*GetVMStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_AdoptVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).AdoptVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_AdoptVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).AdoptVM(ctx, req.(*AdoptVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ExportSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ExportSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ExportSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ExportSpec(ctx, req.(*ExportSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RebootVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RebootVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_RebootVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RebootVM(ctx, req.(*RebootVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ExecInGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecInGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ExecInGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ExecInGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ExecInGuest(ctx, req.(*ExecInGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetConsoleLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsoleLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetConsoleLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetConsoleLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetConsoleLog(ctx, req.(*GetConsoleLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RenameVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RenameVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_RenameVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RenameVM(ctx, req.(*RenameVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_InsertMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).InsertMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_InsertMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).InsertMedia(ctx, req.(*InsertMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_EjectMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EjectMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).EjectMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_EjectMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).EjectMedia(ctx, req.(*EjectMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_SuspendVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).SuspendVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_SuspendVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).SuspendVM(ctx, req.(*SuspendVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ResumeVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ResumeVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ResumeVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ResumeVM(ctx, req.(*ResumeVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_SaveVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveVMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).SaveVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_SaveVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).SaveVM(ctx, req.(*SaveVMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_DiscardManagedSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardManagedSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).DiscardManagedSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_DiscardManagedSave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).DiscardManagedSave(ctx, req.(*DiscardManagedSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ValidateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ValidateSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateSpec(ctx, req.(*ValidateSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, DomainEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_WatchEventsServer = grpc.ServerStreamingServer[DomainEvent]
+
+func _Provider_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_CollectGarbage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CollectGarbage(ctx, req.(*CollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_WatchOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOperationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).WatchOperation(m, &grpc.GenericServerStream[WatchOperationRequest, OperationProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Provider_WatchOperationServer = grpc.ServerStreamingServer[OperationProgress]
+
+func _Provider_GetGuestHostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestHostnameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetGuestHostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetGuestHostname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetGuestHostname(ctx, req.(*GetGuestHostnameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_SetGuestHostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuestHostnameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).SetGuestHostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_SetGuestHostname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).SetGuestHostname(ctx, req.(*SetGuestHostnameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetRawXML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawXMLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetRawXML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetRawXML_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetRawXML(ctx, req.(*GetRawXMLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Provider_ServiceDesc is the grpc.ServiceDesc for Provider service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Provider_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "provider.v1.Provider",
 	HandlerType: (*ProviderServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -729,6 +1661,10 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _Provider_Create_Handler,
 		},
+		{
+			MethodName: "EnsureVM",
+			Handler:    _Provider_EnsureVM_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Provider_Delete_Handler,
@@ -765,6 +1701,10 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SnapshotRevert",
 			Handler:    _Provider_SnapshotRevert_Handler,
 		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Provider_ListSnapshots_Handler,
+		},
 		{
 			MethodName: "Clone",
 			Handler:    _Provider_Clone_Handler,
@@ -793,7 +1733,109 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVMs",
 			Handler:    _Provider_ListVMs_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Provider_Migrate_Handler,
+		},
+		{
+			MethodName: "GetCapacity",
+			Handler:    _Provider_GetCapacity_Handler,
+		},
+		{
+			MethodName: "GetVMStats",
+			Handler:    _Provider_GetVMStats_Handler,
+		},
+		{
+			MethodName: "AdoptVM",
+			Handler:    _Provider_AdoptVM_Handler,
+		},
+		{
+			MethodName: "ExportSpec",
+			Handler:    _Provider_ExportSpec_Handler,
+		},
+		{
+			MethodName: "RebootVM",
+			Handler:    _Provider_RebootVM_Handler,
+		},
+		{
+			MethodName: "ExecInGuest",
+			Handler:    _Provider_ExecInGuest_Handler,
+		},
+		{
+			MethodName: "GetConsoleLog",
+			Handler:    _Provider_GetConsoleLog_Handler,
+		},
+		{
+			MethodName: "RenameVM",
+			Handler:    _Provider_RenameVM_Handler,
+		},
+		{
+			MethodName: "InsertMedia",
+			Handler:    _Provider_InsertMedia_Handler,
+		},
+		{
+			MethodName: "EjectMedia",
+			Handler:    _Provider_EjectMedia_Handler,
+		},
+		{
+			MethodName: "SuspendVM",
+			Handler:    _Provider_SuspendVM_Handler,
+		},
+		{
+			MethodName: "ResumeVM",
+			Handler:    _Provider_ResumeVM_Handler,
+		},
+		{
+			MethodName: "SaveVM",
+			Handler:    _Provider_SaveVM_Handler,
+		},
+		{
+			MethodName: "DiscardManagedSave",
+			Handler:    _Provider_DiscardManagedSave_Handler,
+		},
+		{
+			MethodName: "ValidateSpec",
+			Handler:    _Provider_ValidateSpec_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _Provider_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "GetGuestHostname",
+			Handler:    _Provider_GetGuestHostname_Handler,
+		},
+		{
+			MethodName: "SetGuestHostname",
+			Handler:    _Provider_SetGuestHostname_Handler,
+		},
+		{
+			MethodName: "GetRawXML",
+			Handler:    _Provider_GetRawXML_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ConsolidateSnapshots",
+			Handler:       _Provider_ConsolidateSnapshots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "OpenConsole",
+			Handler:       _Provider_OpenConsole_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Provider_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOperation",
+			Handler:       _Provider_WatchOperation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "provider/v1/provider.proto",
 }